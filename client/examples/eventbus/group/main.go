@@ -0,0 +1,51 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	// standard libraries.
+	"context"
+	"log"
+
+	// third-party project.
+
+	// this project.
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/option"
+)
+
+func main() {
+	ctx := context.Background()
+
+	c := client.Connect([]string{"localhost:2048"})
+	eb := c.Eventbus(ctx, "quick-start")
+	// run more processes with the same group, the eventlogs are shared among them.
+	r := eb.GroupReader("quick-start-group", option.WithGroupBatchSize(16),
+		option.WithConsumeFrom(api.ConsumeFromWhereEarliest))
+	defer r.Close(ctx)
+	for i := 0; i < 10; i++ {
+		events, offset, eventlogID, err := r.Read(ctx)
+		if err != nil {
+			log.Print(err.Error())
+			continue
+		}
+		log.Printf("read %d events from eventlog %d at offset %d, assignment: %v\n",
+			len(events), eventlogID, offset, r.Assignment())
+		if err = r.Commit(ctx, eventlogID, offset+int64(len(events))); err != nil {
+			log.Print(err.Error())
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	// standard libraries
+	"context"
+
+	"github.com/linkall-labs/vanus/observability/tracing"
+	"github.com/linkall-labs/vanus/pkg/cluster"
+	"github.com/linkall-labs/vanus/pkg/util/tlsconfig"
+	"go.opentelemetry.io/otel/trace"
+
+	// first-party libraries
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+)
+
+func NewGroupService(endpoints []string) *GroupService {
+	return &GroupService{
+		client: cluster.NewClusterController(endpoints, tlsconfig.ClientCredentials()).EventbusService().RawClient(),
+		tracer: tracing.NewTracer("internal.coordinator.group", trace.SpanKindClient),
+	}
+}
+
+// GroupService coordinates the members of consumer group through controller.
+type GroupService struct {
+	client ctrlpb.EventBusControllerClient
+	tracer *tracing.Tracer
+}
+
+func (gs *GroupService) Join(ctx context.Context, eventbus, group string) (*ctrlpb.ConsumerGroupAssignment, error) {
+	ctx, span := gs.tracer.Start(ctx, "Join")
+	defer span.End()
+
+	return gs.client.JoinConsumerGroup(ctx, &ctrlpb.JoinConsumerGroupRequest{
+		Eventbus: eventbus,
+		Group:    group,
+	})
+}
+
+func (gs *GroupService) Heartbeat(ctx context.Context, eventbus, group, memberID string,
+	generation uint64) (*ctrlpb.ConsumerGroupAssignment, error) {
+	ctx, span := gs.tracer.Start(ctx, "Heartbeat")
+	defer span.End()
+
+	return gs.client.ConsumerGroupHeartbeat(ctx, &ctrlpb.ConsumerGroupHeartbeatRequest{
+		Eventbus:   eventbus,
+		Group:      group,
+		MemberId:   memberID,
+		Generation: generation,
+	})
+}
+
+func (gs *GroupService) Leave(ctx context.Context, eventbus, group, memberID string) error {
+	ctx, span := gs.tracer.Start(ctx, "Leave")
+	defer span.End()
+
+	_, err := gs.client.LeaveConsumerGroup(ctx, &ctrlpb.LeaveConsumerGroupRequest{
+		Eventbus: eventbus,
+		Group:    group,
+		MemberId: memberID,
+	})
+	return err
+}
+
+func (gs *GroupService) Commit(ctx context.Context, eventbus, group, memberID string, generation uint64,
+	offsets map[uint64]int64) error {
+	ctx, span := gs.tracer.Start(ctx, "Commit")
+	defer span.End()
+
+	req := &ctrlpb.CommitConsumerGroupOffsetRequest{
+		Eventbus:   eventbus,
+		Group:      group,
+		MemberId:   memberID,
+		Generation: generation,
+		Offsets:    make([]*metapb.OffsetInfo, 0, len(offsets)),
+	}
+	for logID, offset := range offsets {
+		req.Offsets = append(req.Offsets, &metapb.OffsetInfo{
+			EventLogId: logID,
+			Offset:     uint64(offset),
+		})
+	}
+	_, err := gs.client.CommitConsumerGroupOffset(ctx, req)
+	return err
+}
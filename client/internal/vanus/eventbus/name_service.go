@@ -18,12 +18,11 @@ import (
 	// standard libraries
 	"context"
 	"github.com/linkall-labs/vanus/pkg/cluster"
+	"github.com/linkall-labs/vanus/pkg/util/tlsconfig"
 
 	"github.com/linkall-labs/vanus/observability/tracing"
 	"go.opentelemetry.io/otel/trace"
 
-	"google.golang.org/grpc/credentials/insecure"
-
 	// first-party libraries
 	"github.com/linkall-labs/vanus/client/pkg/record"
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
@@ -32,7 +31,7 @@ import (
 
 func NewNameService(endpoints []string) *NameService {
 	return &NameService{
-		client: cluster.NewClusterController(endpoints, insecure.NewCredentials()).EventbusService().RawClient(),
+		client: cluster.NewClusterController(endpoints, tlsconfig.ClientCredentials()).EventbusService().RawClient(),
 		tracer: tracing.NewTracer("internal.discovery.eventbus", trace.SpanKindClient),
 	}
 }
@@ -22,12 +22,12 @@ import (
 
 	// third-party libraries.
 	"go.opentelemetry.io/otel/trace"
-	"google.golang.org/grpc/credentials/insecure"
 
 	// first-party libraries.
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/observability/tracing"
 	"github.com/linkall-labs/vanus/pkg/cluster"
+	"github.com/linkall-labs/vanus/pkg/util/tlsconfig"
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
 
@@ -38,7 +38,7 @@ import (
 
 func NewNameService(endpoints []string) *NameService {
 	return &NameService{
-		client: cluster.NewClusterController(endpoints, insecure.NewCredentials()).EventlogService().RawClient(),
+		client: cluster.NewClusterController(endpoints, tlsconfig.ClientCredentials()).EventlogService().RawClient(),
 		tracer: tracing.NewTracer("internal.discovery.eventlog", trace.SpanKindClient),
 	}
 }
@@ -35,6 +35,7 @@ import (
 	"github.com/linkall-labs/vanus/client/internal/vanus/net/rpc"
 	"github.com/linkall-labs/vanus/client/internal/vanus/net/rpc/bare"
 	"github.com/linkall-labs/vanus/client/pkg/primitive"
+	"github.com/linkall-labs/vanus/pkg/errors"
 )
 
 func newBlockStore(endpoint string) (*BlockStore, error) {
@@ -68,31 +69,47 @@ func (s *BlockStore) Close() {
 }
 
 func (s *BlockStore) Append(ctx context.Context, block uint64, event *ce.Event) (int64, error) {
-	_ctx, span := s.tracer.Start(ctx, "Append")
-	defer span.End()
-
-	eventpb, err := codec.ToProto(event)
+	offs, err := s.AppendMany(ctx, block, []*ce.Event{event})
 	if err != nil {
 		return -1, err
 	}
+	return offs[0], nil
+}
+
+// AppendMany appends events to the block by one request, all events are stored or none of them.
+func (s *BlockStore) AppendMany(ctx context.Context, block uint64, events []*ce.Event) ([]int64, error) {
+	_ctx, span := s.tracer.Start(ctx, "AppendMany")
+	defer span.End()
+
+	eventpbs := make([]*cepb.CloudEvent, len(events))
+	for idx := range events {
+		eventpb, err := codec.ToProto(events[idx])
+		if err != nil {
+			return nil, err
+		}
+		eventpbs[idx] = eventpb
+	}
 	req := &segpb.AppendToBlockRequest{
 		BlockId: block,
 		Events: &cepb.CloudEventBatch{
-			Events: []*cepb.CloudEvent{eventpb},
+			Events: eventpbs,
 		},
+		Idempotent: isIdempotent(ctx),
 	}
 
 	client, err := s.client.Get(_ctx)
 	if err != nil {
-		return -1, err
+		return nil, err
 	}
 
 	res, err := client.(segpb.SegmentServerClient).AppendToBlock(_ctx, req)
 	if err != nil {
-		return -1, err
+		return nil, err
+	}
+	if len(res.GetOffsets()) != len(events) {
+		return nil, errors.ErrInternal.WithMessage("the number of offsets doesn't match events")
 	}
-	// TODO(Y. F. Zhang): batch events
-	return res.GetOffsets()[0], nil
+	return res.GetOffsets(), nil
 }
 
 func (s *BlockStore) Read(
@@ -17,6 +17,8 @@ package api
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	ce "github.com/cloudevents/sdk-go/v2"
 )
@@ -24,6 +26,9 @@ import (
 type Eventbus interface {
 	Writer(opts ...WriteOption) BusWriter
 	Reader(opts ...ReadOption) BusReader
+	// GroupReader joins the consumer group of eventbus, the eventlogs are assigned to
+	// the members of group, and rebalanced when members join or leave.
+	GroupReader(group string, opts ...GroupOption) GroupReader
 
 	GetLog(ctx context.Context, logID uint64, opts ...LogOption) (Eventlog, error)
 	ListLog(ctx context.Context, opts ...LogOption) ([]Eventlog, error)
@@ -32,13 +37,42 @@ type Eventbus interface {
 
 type BusWriter interface {
 	AppendOne(ctx context.Context, event *ce.Event, opts ...WriteOption) (eid string, err error)
-	AppendMany(ctx context.Context, events []*ce.Event, opts ...WriteOption) (eid string, err error)
+	// AppendMany appends events in batches, one batch for each picked eventlog. eids[i] is the ID
+	// of events[i], if some of events failed, their IDs are empty and err is a *BatchAppendError.
+	AppendMany(ctx context.Context, events []*ce.Event, opts ...WriteOption) (eids []string, err error)
+}
+
+// BatchAppendError reports the failed events of AppendMany, the key of Errors is the index of event.
+type BatchAppendError struct {
+	Total  int
+	Errors map[int]error
+}
+
+func (e *BatchAppendError) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for idx, err := range e.Errors {
+		msgs = append(msgs, fmt.Sprintf("%d: %s", idx, err))
+	}
+	return fmt.Sprintf("%d of %d events failed to append, [%s]", len(e.Errors), e.Total, strings.Join(msgs, "; "))
 }
 
 type BusReader interface {
 	Read(ctx context.Context, opts ...ReadOption) ([]*ce.Event, int64, uint64, error)
 }
 
+type GroupReader interface {
+	// Read reads events from one of the eventlogs assigned to this member, returns the events,
+	// the offset of first event and the ID of eventlog. The next Read continues from the events.
+	Read(ctx context.Context) ([]*ce.Event, int64, uint64, error)
+	// Commit commits the offset of eventlog for group, which is the offset of next event to
+	// consume. It fails if the eventlog has been reassigned to other member.
+	Commit(ctx context.Context, logID uint64, offset int64) error
+	// Assignment returns the IDs of eventlogs which are assigned to this member.
+	Assignment() []uint64
+	// Close leaves the consumer group, the eventlogs are reassigned to other members.
+	Close(ctx context.Context)
+}
+
 type Eventlog interface {
 	ID() uint64
 	EarliestOffset(ctx context.Context) (int64, error)
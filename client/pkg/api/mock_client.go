@@ -67,6 +67,25 @@ func (mr *MockEventbusMockRecorder) GetLog(ctx, logID interface{}, opts ...inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLog", reflect.TypeOf((*MockEventbus)(nil).GetLog), varargs...)
 }
 
+// GroupReader mocks base method.
+func (m *MockEventbus) GroupReader(group string, opts ...GroupOption) GroupReader {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{group}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GroupReader", varargs...)
+	ret0, _ := ret[0].(GroupReader)
+	return ret0
+}
+
+// GroupReader indicates an expected call of GroupReader.
+func (mr *MockEventbusMockRecorder) GroupReader(group interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{group}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupReader", reflect.TypeOf((*MockEventbus)(nil).GroupReader), varargs...)
+}
+
 // ListLog mocks base method.
 func (m *MockEventbus) ListLog(ctx context.Context, opts ...LogOption) ([]Eventlog, error) {
 	m.ctrl.T.Helper()
@@ -147,14 +166,14 @@ func (m *MockBusWriter) EXPECT() *MockBusWriterMockRecorder {
 }
 
 // AppendMany mocks base method.
-func (m *MockBusWriter) AppendMany(ctx context.Context, events []*v2.Event, opts ...WriteOption) (string, error) {
+func (m *MockBusWriter) AppendMany(ctx context.Context, events []*v2.Event, opts ...WriteOption) ([]string, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, events}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "AppendMany", varargs...)
-	ret0, _ := ret[0].(string)
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -231,6 +250,86 @@ func (mr *MockBusReaderMockRecorder) Read(ctx interface{}, opts ...interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockBusReader)(nil).Read), varargs...)
 }
 
+// MockGroupReader is a mock of GroupReader interface.
+type MockGroupReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockGroupReaderMockRecorder
+}
+
+// MockGroupReaderMockRecorder is the mock recorder for MockGroupReader.
+type MockGroupReaderMockRecorder struct {
+	mock *MockGroupReader
+}
+
+// NewMockGroupReader creates a new mock instance.
+func NewMockGroupReader(ctrl *gomock.Controller) *MockGroupReader {
+	mock := &MockGroupReader{ctrl: ctrl}
+	mock.recorder = &MockGroupReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGroupReader) EXPECT() *MockGroupReaderMockRecorder {
+	return m.recorder
+}
+
+// Assignment mocks base method.
+func (m *MockGroupReader) Assignment() []uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assignment")
+	ret0, _ := ret[0].([]uint64)
+	return ret0
+}
+
+// Assignment indicates an expected call of Assignment.
+func (mr *MockGroupReaderMockRecorder) Assignment() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assignment", reflect.TypeOf((*MockGroupReader)(nil).Assignment))
+}
+
+// Close mocks base method.
+func (m *MockGroupReader) Close(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close", ctx)
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockGroupReaderMockRecorder) Close(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockGroupReader)(nil).Close), ctx)
+}
+
+// Commit mocks base method.
+func (m *MockGroupReader) Commit(ctx context.Context, logID uint64, offset int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Commit", ctx, logID, offset)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Commit indicates an expected call of Commit.
+func (mr *MockGroupReaderMockRecorder) Commit(ctx, logID, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockGroupReader)(nil).Commit), ctx, logID, offset)
+}
+
+// Read mocks base method.
+func (m *MockGroupReader) Read(ctx context.Context) ([]*v2.Event, int64, uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", ctx)
+	ret0, _ := ret[0].([]*v2.Event)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(uint64)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockGroupReaderMockRecorder) Read(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockGroupReader)(nil).Read), ctx)
+}
+
 // MockEventlog is a mock of Eventlog interface.
 type MockEventlog struct {
 	ctrl     *gomock.Controller
@@ -23,6 +23,8 @@ type WriteOption func(*WriteOptions)
 type WriteOptions struct {
 	Policy WritePolicy
 	Oneway bool
+	// Idempotent makes retried appends of the same event be stored only once.
+	Idempotent bool
 }
 
 func (wo *WriteOptions) Apply(opts ...WriteOption) {
@@ -33,8 +35,9 @@ func (wo *WriteOptions) Apply(opts ...WriteOption) {
 
 func (wo *WriteOptions) Copy() *WriteOptions {
 	return &WriteOptions{
-		Oneway: wo.Oneway,
-		Policy: wo.Policy,
+		Oneway:     wo.Oneway,
+		Policy:     wo.Policy,
+		Idempotent: wo.Idempotent,
 	}
 }
 
@@ -60,6 +63,15 @@ func (ro *ReadOptions) Copy() *ReadOptions {
 	}
 }
 
+type GroupOption func(*GroupOptions)
+
+type GroupOptions struct {
+	BatchSize      int
+	PollingTimeout int64
+	// ConsumeFrom is where to read if the group hasn't committed offset of eventlog.
+	ConsumeFrom ConsumeFromWhere
+}
+
 type LogOption func(*LogOptions)
 
 type LogOptions struct {
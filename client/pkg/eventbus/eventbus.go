@@ -20,7 +20,10 @@ import (
 	"encoding/base64"
 	"encoding/binary"
 	stderrors "errors"
+	"fmt"
+	"hash/fnv"
 	"io"
+	"sort"
 	"sync"
 
 	"github.com/linkall-labs/vanus/observability/tracing"
@@ -41,6 +44,13 @@ import (
 
 	eb "github.com/linkall-labs/vanus/client/internal/vanus/eventbus"
 	el "github.com/linkall-labs/vanus/client/internal/vanus/eventlog"
+	"github.com/linkall-labs/vanus/client/internal/vanus/store"
+)
+
+const (
+	// extensions identify the event for idempotent appends, see XVanusProducerID in server.
+	extProducerID  = "xvanusproducerid"
+	extProducerSeq = "xvanusproducerseq"
 )
 
 func NewEventbus(cfg *eb.Config) *eventbus {
@@ -115,8 +125,10 @@ type eventbus struct {
 	writableWatcher *WritableLogsWatcher
 	writableLogSet  *u64set.Set
 	writableLogs    map[uint64]eventlog.Eventlog
-	writableMu      sync.RWMutex
-	writableState   error
+	// writableLogIDs is the sorted IDs of writableLogs, which is used to pick log by key.
+	writableLogIDs []uint64
+	writableMu     sync.RWMutex
+	writableState  error
 
 	readableWatcher *ReadableLogsWatcher
 	readableLogSet  *u64set.Set
@@ -322,6 +334,13 @@ func (b *eventbus) setWritableLogs(s *u64set.Set, lws map[uint64]eventlog.Eventl
 	defer b.writableMu.Unlock()
 	b.writableLogSet = s
 	b.writableLogs = lws
+	b.writableLogIDs = make([]uint64, 0, len(lws))
+	for id := range lws {
+		b.writableLogIDs = append(b.writableLogIDs, id)
+	}
+	sort.Slice(b.writableLogIDs, func(i, j int) bool {
+		return b.writableLogIDs[i] < b.writableLogIDs[j]
+	})
 }
 
 func (b *eventbus) getWritableLog(ctx context.Context, logID uint64) eventlog.Eventlog {
@@ -339,6 +358,21 @@ func (b *eventbus) getWritableLog(ctx context.Context, logID uint64) eventlog.Ev
 	return b.writableLogs[logID]
 }
 
+func (b *eventbus) getWritableLogIDs(ctx context.Context) []uint64 {
+	b.writableMu.RLock()
+	defer b.writableMu.RUnlock()
+
+	if len(b.writableLogIDs) == 0 {
+		func() {
+			b.writableMu.RUnlock()
+			defer b.writableMu.RLock()
+			b.refreshWritableLogs(ctx)
+		}()
+	}
+
+	return b.writableLogIDs
+}
+
 func (b *eventbus) refreshWritableLogs(ctx context.Context) {
 	_ctx, span := b.tracer.Start(ctx, "refreshWritableLogs")
 	defer span.End()
@@ -459,8 +493,12 @@ func (w *busWriter) AppendOne(ctx context.Context, event *ce.Event, opts ...api.
 		}
 	}
 
+	if writeOpts.Idempotent {
+		_ctx = store.WithIdempotence(_ctx)
+	}
+
 	// 1. pick a writer of eventlog
-	lw, err := w.pickWritableLog(_ctx, writeOpts)
+	lw, err := w.pickWritableLog(_ctx, writeOpts, event)
 	if err != nil {
 		return "", err
 	}
@@ -472,33 +510,113 @@ func (w *busWriter) AppendOne(ctx context.Context, event *ce.Event, opts ...api.
 	}
 
 	// 3. generate event ID
-	var buf [16]byte
-	binary.BigEndian.PutUint64(buf[0:8], lw.Log().ID())
-	binary.BigEndian.PutUint64(buf[8:16], uint64(off))
-	encoded := base64.StdEncoding.EncodeToString(buf[:])
+	return genEventID(lw.Log().ID(), off), nil
+}
 
-	return encoded, nil
+func (w *busWriter) AppendMany(
+	ctx context.Context, events []*ce.Event, opts ...api.WriteOption,
+) (eids []string, err error) {
+	_ctx, span := w.tracer.Start(ctx, "AppendMany")
+	defer span.End()
+
+	var writeOpts *api.WriteOptions = w.opts
+	if len(opts) > 0 {
+		writeOpts = w.opts.Copy()
+		for _, opt := range opts {
+			opt(writeOpts)
+		}
+	}
+
+	if writeOpts.Idempotent {
+		_ctx = store.WithIdempotence(_ctx)
+	}
+
+	// 1. group events by the picked eventlog
+	type batch struct {
+		lw      eventlog.LogWriter
+		indexes []int
+		events  []*ce.Event
+	}
+	eids = make([]string, len(events))
+	batchErr := &api.BatchAppendError{Total: len(events), Errors: map[int]error{}}
+	batches := make(map[uint64]*batch)
+	for idx, event := range events {
+		lw, err := w.pickWritableLog(_ctx, writeOpts, event)
+		if err != nil {
+			batchErr.Errors[idx] = err
+			continue
+		}
+		b, ok := batches[lw.Log().ID()]
+		if !ok {
+			b = &batch{lw: lw}
+			batches[lw.Log().ID()] = b
+		}
+		b.indexes = append(b.indexes, idx)
+		b.events = append(b.events, event)
+	}
+
+	// 2. append each batch to its eventlog
+	mu := sync.Mutex{}
+	wg := sync.WaitGroup{}
+	for _, b := range batches {
+		wg.Add(1)
+		go func(b *batch) {
+			defer wg.Done()
+			offs, err := b.lw.AppendMany(_ctx, b.events)
+			mu.Lock()
+			defer mu.Unlock()
+			for i, idx := range b.indexes {
+				if err != nil {
+					batchErr.Errors[idx] = err
+					continue
+				}
+				// 3. generate event ID
+				eids[idx] = genEventID(b.lw.Log().ID(), offs[i])
+			}
+		}(b)
+	}
+	wg.Wait()
+
+	if len(batchErr.Errors) > 0 {
+		return eids, batchErr
+	}
+	return eids, nil
 }
 
-func (w *busWriter) AppendMany(ctx context.Context, events []*ce.Event, opts ...api.WriteOption) (eid string, err error) {
-	// TODO(jiangkai): implement this method, by jiangkai, 2022.10.24
-	return "", nil
+func genEventID(logID uint64, off int64) string {
+	var buf [16]byte
+	binary.BigEndian.PutUint64(buf[0:8], logID)
+	binary.BigEndian.PutUint64(buf[8:16], uint64(off))
+	return base64.StdEncoding.EncodeToString(buf[:])
 }
 
 func (w *busWriter) Bus() api.Eventbus {
 	return w.ebus
 }
 
-func (w *busWriter) pickWritableLog(ctx context.Context, opts *api.WriteOptions) (eventlog.LogWriter, error) {
+func (w *busWriter) pickWritableLog(
+	ctx context.Context, opts *api.WriteOptions, event *ce.Event,
+) (eventlog.LogWriter, error) {
 	_ctx, span := w.tracer.Start(ctx, "pickWritableLog")
 	defer span.End()
 
-	log, err := opts.Policy.NextLog(ctx)
-	if err != nil {
-		return nil, err
+	var logID uint64
+	if opts.Idempotent {
+		// Retries of the same event must be appended to the same eventlog.
+		id, err := w.pickLogByKey(ctx, idempotenceKey(event))
+		if err != nil {
+			return nil, err
+		}
+		logID = id
+	} else {
+		log, err := opts.Policy.NextLog(ctx)
+		if err != nil {
+			return nil, err
+		}
+		logID = log.ID()
 	}
 
-	l := w.ebus.getWritableLog(_ctx, log.ID())
+	l := w.ebus.getWritableLog(_ctx, logID)
 	if l == nil {
 		return nil, stderrors.New("can not pick writable log")
 	}
@@ -506,6 +624,24 @@ func (w *busWriter) pickWritableLog(ctx context.Context, opts *api.WriteOptions)
 	return l.Writer(), nil
 }
 
+func (w *busWriter) pickLogByKey(ctx context.Context, key string) (uint64, error) {
+	ids := w.ebus.getWritableLogIDs(ctx)
+	if len(ids) == 0 {
+		return 0, stderrors.New("can not pick writable log")
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return ids[h.Sum32()%uint32(len(ids))], nil
+}
+
+func idempotenceKey(event *ce.Event) string {
+	if producer, ok := event.Extensions()[extProducerID]; ok {
+		return fmt.Sprintf("%v\x00%v", producer, event.Extensions()[extProducerSeq])
+	}
+	return event.Source() + "\x00" + event.ID()
+}
+
 type busReader struct {
 	ebus   *eventbus
 	opts   *api.ReadOptions
@@ -0,0 +1,300 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	// standard libraries.
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	// third-party libraries.
+	ce "github.com/cloudevents/sdk-go/v2"
+	"go.opentelemetry.io/otel/trace"
+
+	// this project.
+	eb "github.com/linkall-labs/vanus/client/internal/vanus/eventbus"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/eventlog"
+	vlog "github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/observability/tracing"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+)
+
+const (
+	defaultGroupHeartbeatInterval = 5 * time.Second
+	groupRejoinInterval           = time.Second
+	// noCommittedOffset is the offset of eventlog which the group hasn't committed.
+	noCommittedOffset = int64(-1)
+)
+
+func (b *eventbus) defaultGroupOptions() *api.GroupOptions {
+	return &api.GroupOptions{
+		BatchSize:      1,
+		PollingTimeout: api.DefaultPollingTimeout,
+		ConsumeFrom:    api.ConsumeFromWhereEarliest,
+	}
+}
+
+func (b *eventbus) GroupReader(group string, opts ...api.GroupOption) api.GroupReader {
+	groupOpts := b.defaultGroupOptions()
+	for _, opt := range opts {
+		opt(groupOpts)
+	}
+
+	r := &groupReader{
+		ebus:     b,
+		group:    group,
+		opts:     groupOpts,
+		service:  eb.NewGroupService(b.cfg.Endpoints),
+		offsets:  make(map[uint64]int64),
+		joinedCh: make(chan struct{}),
+		tracer:   tracing.NewTracer("pkg.eventbus.groupreader", trace.SpanKindClient),
+	}
+	r.ctx, r.cancel = context.WithCancel(context.Background())
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		r.run()
+	}()
+	return r
+}
+
+// groupReader is a member of consumer group, it keeps the membership by heartbeat, and
+// reads the assigned eventlogs in turn.
+type groupReader struct {
+	ebus    *eventbus
+	group   string
+	opts    *api.GroupOptions
+	service *eb.GroupService
+
+	mu         sync.RWMutex
+	memberID   string
+	generation uint64
+	assigned   []uint64
+	// the offset of next event to read, noCommittedOffset means it is decided by ConsumeFrom.
+	offsets  map[uint64]int64
+	next     int
+	joined   bool
+	joinedCh chan struct{}
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	tracer *tracing.Tracer
+}
+
+var _ api.GroupReader = (*groupReader)(nil)
+
+func (r *groupReader) run() {
+	interval := defaultGroupHeartbeatInterval
+	for {
+		var assignment *ctrlpb.ConsumerGroupAssignment
+		var err error
+		memberID, generation := r.membership()
+		if memberID == "" {
+			assignment, err = r.service.Join(r.ctx, r.ebus.cfg.Name, r.group)
+		} else {
+			assignment, err = r.service.Heartbeat(r.ctx, r.ebus.cfg.Name, r.group, memberID, generation)
+			if errors.Is(err, errors.ErrResourceNotFound) {
+				// the member has been expired, join again.
+				r.reset()
+			}
+		}
+
+		wait := interval
+		if err != nil {
+			vlog.Warning(r.ctx, "consumer group heartbeat failed", map[string]interface{}{
+				vlog.KeyError: err,
+				"eventbus":    r.ebus.cfg.Name,
+				"group":       r.group,
+			})
+			wait = groupRejoinInterval
+		} else {
+			r.apply(assignment)
+			if assignment.SessionTimeout > 0 {
+				interval = time.Duration(assignment.SessionTimeout) * time.Millisecond / 3
+			}
+			wait = interval
+		}
+
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+func (r *groupReader) membership() (string, uint64) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.memberID, r.generation
+}
+
+func (r *groupReader) reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.memberID = ""
+	r.generation = 0
+	r.assigned = nil
+	r.offsets = make(map[uint64]int64)
+}
+
+func (r *groupReader) apply(assignment *ctrlpb.ConsumerGroupAssignment) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.joined {
+		r.joined = true
+		close(r.joinedCh)
+	}
+	if r.memberID == assignment.MemberId && r.generation == assignment.Generation {
+		return
+	}
+	offsets := make(map[uint64]int64, len(assignment.Eventlogs))
+	assigned := make([]uint64, 0, len(assignment.Eventlogs))
+	for _, el := range assignment.Eventlogs {
+		offset := el.Offset
+		// keep the progress which hasn't been committed, unless others committed more.
+		if cur, exist := r.offsets[el.EventLogId]; exist && cur > offset {
+			offset = cur
+		}
+		offsets[el.EventLogId] = offset
+		assigned = append(assigned, el.EventLogId)
+	}
+	vlog.Info(r.ctx, "the assignment of consumer group changed", map[string]interface{}{
+		"eventbus":   r.ebus.cfg.Name,
+		"group":      r.group,
+		"member_id":  assignment.MemberId,
+		"generation": assignment.Generation,
+		"eventlogs":  assigned,
+	})
+	r.memberID = assignment.MemberId
+	r.generation = assignment.Generation
+	r.assigned = assigned
+	r.offsets = offsets
+}
+
+func (r *groupReader) Read(ctx context.Context) ([]*ce.Event, int64, uint64, error) {
+	_ctx, span := r.tracer.Start(ctx, "Read")
+	defer span.End()
+
+	select {
+	case <-r.joinedCh:
+	case <-ctx.Done():
+		return []*ce.Event{}, 0, 0, ctx.Err()
+	case <-r.ctx.Done():
+		return []*ce.Event{}, 0, 0, errors.ErrClosed
+	}
+
+	logID, offset, ok := r.pick()
+	if !ok {
+		// more members than eventlogs, wait for rebalance.
+		select {
+		case <-time.After(time.Duration(r.opts.PollingTimeout) * time.Millisecond):
+		case <-ctx.Done():
+		}
+		return []*ce.Event{}, 0, 0, errors.ErrTryAgain.WithMessage("no eventlog is assigned")
+	}
+
+	el := r.ebus.getReadableLog(_ctx, logID)
+	if el == nil {
+		return []*ce.Event{}, 0, 0, errors.ErrEventLogNotFound
+	}
+	if offset == noCommittedOffset {
+		var err error
+		if r.opts.ConsumeFrom == api.ConsumeFromWhereLatest {
+			offset, err = el.LatestOffset(_ctx)
+		} else {
+			offset, err = el.EarliestOffset(_ctx)
+		}
+		if err != nil {
+			return []*ce.Event{}, 0, 0, err
+		}
+	}
+
+	lr := el.Reader(eventlog.ReaderConfig{PollingTimeout: r.opts.PollingTimeout})
+	off, err := lr.Seek(_ctx, offset, io.SeekStart)
+	if err != nil {
+		return []*ce.Event{}, 0, 0, err
+	}
+	events, err := lr.Read(_ctx, int16(r.opts.BatchSize))
+	if err != nil {
+		return []*ce.Event{}, 0, 0, err
+	}
+	r.forward(logID, off+int64(len(events)))
+	return events, off, logID, nil
+}
+
+// pick picks the next assigned eventlog in turn.
+func (r *groupReader) pick() (uint64, int64, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.assigned) == 0 {
+		return 0, 0, false
+	}
+	r.next = (r.next + 1) % len(r.assigned)
+	logID := r.assigned[r.next]
+	return logID, r.offsets[logID], true
+}
+
+func (r *groupReader) forward(logID uint64, offset int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// the eventlog may be reassigned during reading.
+	if _, exist := r.offsets[logID]; exist {
+		r.offsets[logID] = offset
+	}
+}
+
+func (r *groupReader) Commit(ctx context.Context, logID uint64, offset int64) error {
+	_ctx, span := r.tracer.Start(ctx, "Commit")
+	defer span.End()
+
+	r.mu.RLock()
+	memberID, generation := r.memberID, r.generation
+	_, assigned := r.offsets[logID]
+	r.mu.RUnlock()
+	if !assigned {
+		return errors.ErrInvalidRequest.WithMessage("the eventlog isn't assigned to this member")
+	}
+	return r.service.Commit(_ctx, r.ebus.cfg.Name, r.group, memberID, generation, map[uint64]int64{logID: offset})
+}
+
+func (r *groupReader) Assignment() []uint64 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	assigned := make([]uint64, len(r.assigned))
+	copy(assigned, r.assigned)
+	return assigned
+}
+
+func (r *groupReader) Close(ctx context.Context) {
+	r.cancel()
+	r.wg.Wait()
+	memberID, _ := r.membership()
+	if memberID == "" {
+		return
+	}
+	if err := r.service.Leave(ctx, r.ebus.cfg.Name, r.group, memberID); err != nil {
+		vlog.Warning(ctx, "leave consumer group failed", map[string]interface{}{
+			vlog.KeyError: err,
+			"eventbus":    r.ebus.cfg.Name,
+			"group":       r.group,
+		})
+	}
+}
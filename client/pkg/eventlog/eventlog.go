@@ -50,6 +50,10 @@ type LogWriter interface {
 	Close(ctx context.Context)
 
 	Append(ctx context.Context, event *ce.Event) (off int64, err error)
+
+	// AppendMany appends events to the current writable segment in one batch, the offsets
+	// are in the same order as events.
+	AppendMany(ctx context.Context, events []*ce.Event) (offs []int64, err error)
 }
 
 type LogReader interface {
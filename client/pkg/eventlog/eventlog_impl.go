@@ -385,6 +385,47 @@ func (w *logWriter) doAppend(ctx context.Context, event *ce.Event) (int64, error
 	return offset, nil
 }
 
+func (w *logWriter) AppendMany(ctx context.Context, events []*ce.Event) ([]int64, error) {
+	if len(events) == 0 {
+		return []int64{}, nil
+	}
+
+	retryTimes := defaultRetryTimes
+	for i := 1; i <= retryTimes; i++ {
+		offs, err := w.doAppendMany(ctx, events)
+		if err == nil {
+			return offs, nil
+		}
+		vlog.Warning(ctx, "failed to AppendMany", map[string]interface{}{
+			vlog.KeyError: err,
+			"number":      len(events),
+		})
+		if errors.Is(err, errors.ErrSegmentFull) {
+			if i < retryTimes {
+				continue
+			}
+		}
+		return nil, err
+	}
+
+	return nil, errors.ErrUnknown
+}
+
+func (w *logWriter) doAppendMany(ctx context.Context, events []*ce.Event) ([]int64, error) {
+	segment, err := w.selectWritableSegment(ctx)
+	if err != nil {
+		return nil, err
+	}
+	offs, err := segment.AppendMany(ctx, events)
+	if err != nil {
+		if errors.Is(err, errors.ErrSegmentFull) {
+			segment.SetNotWritable()
+		}
+		return nil, err
+	}
+	return offs, nil
+}
+
 func (w *logWriter) selectWritableSegment(ctx context.Context) (*segment, error) {
 	segment := func() *segment {
 		w.mu.RLock()
@@ -171,6 +171,24 @@ func (s *segment) Append(ctx context.Context, event *ce.Event) (int64, error) {
 	return off + s.startOffset, nil
 }
 
+func (s *segment) AppendMany(ctx context.Context, events []*ce.Event) ([]int64, error) {
+	_ctx, span := s.tracer.Start(ctx, "AppendMany")
+	defer span.End()
+
+	b := s.preferSegmentBlock()
+	if b == nil {
+		return nil, errors.ErrNotLeader
+	}
+	offs, err := b.AppendMany(_ctx, events)
+	if err != nil {
+		return nil, err
+	}
+	for idx := range offs {
+		offs[idx] += s.startOffset
+	}
+	return offs, nil
+}
+
 func (s *segment) Read(ctx context.Context, from int64, size int16, pollingTimeout uint32) ([]*ce.Event, error) {
 	if from < s.startOffset {
 		return nil, errors.ErrOffsetUnderflow
@@ -56,6 +56,10 @@ func (s *block) Append(ctx context.Context, event *ce.Event) (int64, error) {
 	return s.store.Append(ctx, s.id, event)
 }
 
+func (s *block) AppendMany(ctx context.Context, events []*ce.Event) ([]int64, error) {
+	return s.store.AppendMany(ctx, s.id, events)
+}
+
 func (s *block) Read(ctx context.Context, offset int64, size int16, pollingTimeout uint32) ([]*ce.Event, error) {
 	if offset < 0 {
 		return nil, errors.ErrOffsetUnderflow
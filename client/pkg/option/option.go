@@ -32,6 +32,15 @@ func WithOneway() api.WriteOption {
 	}
 }
 
+// WithIdempotence makes the appends idempotent. The events with the same producer id and
+// producer sequence, or the same source and id, are routed to the same eventlog and stored only
+// once if they are appended again recently.
+func WithIdempotence() api.WriteOption {
+	return func(options *api.WriteOptions) {
+		options.Idempotent = true
+	}
+}
+
 func WithBatchSize(size int) api.ReadOption {
 	return func(options *api.ReadOptions) {
 		options.BatchSize = size
@@ -65,3 +74,25 @@ func WithLogPolicy(policy api.LogPolicy) api.LogOption {
 		options.Policy = policy
 	}
 }
+
+func WithGroupBatchSize(size int) api.GroupOption {
+	return func(options *api.GroupOptions) {
+		options.BatchSize = size
+	}
+}
+
+func WithGroupPollingTimeout(d time.Duration) api.GroupOption {
+	return func(options *api.GroupOptions) {
+		if d <= 0 {
+			options.PollingTimeout = 0
+		} else {
+			options.PollingTimeout = d.Milliseconds()
+		}
+	}
+}
+
+func WithConsumeFrom(where api.ConsumeFromWhere) api.GroupOption {
+	return func(options *api.GroupOptions) {
+		options.ConsumeFrom = where
+	}
+}
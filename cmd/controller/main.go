@@ -36,6 +36,7 @@ import (
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/observability/metrics"
 	"github.com/linkall-labs/vanus/pkg/util/signal"
+	"github.com/linkall-labs/vanus/pkg/util/tlsconfig"
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
@@ -58,6 +59,12 @@ func main() {
 		})
 		os.Exit(-1)
 	}
+	if err = tlsconfig.Init(cfg.TLS); err != nil {
+		log.Error(context.Background(), "init TLS failed", map[string]interface{}{
+			log.KeyError: err,
+		})
+		os.Exit(-1)
+	}
 	listen, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Port))
 	if err != nil {
 		log.Error(context.Background(), "failed to listen", map[string]interface{}{
@@ -121,6 +128,7 @@ func main() {
 	)
 
 	grpcServer := grpc.NewServer(
+		grpc.Creds(tlsconfig.ServerCredentials()),
 		grpc.ChainStreamInterceptor(
 			errinterceptor.StreamServerInterceptor(),
 			recovery.StreamServerInterceptor(recoveryOpt),
@@ -23,6 +23,7 @@ import (
 	"github.com/linkall-labs/vanus/observability"
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/pkg/util/signal"
+	"github.com/linkall-labs/vanus/pkg/util/tlsconfig"
 )
 
 var (
@@ -39,6 +40,12 @@ func main() {
 		})
 		os.Exit(-1)
 	}
+	if err = tlsconfig.Init(cfg.TLS); err != nil {
+		log.Error(context.Background(), "init TLS failed", map[string]interface{}{
+			log.KeyError: err,
+		})
+		os.Exit(-1)
+	}
 
 	ctx := signal.SetupSignalContext()
 	ga := gateway.NewGateway(*cfg)
@@ -49,7 +56,7 @@ func main() {
 		})
 		os.Exit(-1)
 	}
-	
+
 	cfg.Observability.T.ServerName = "Vanus Gateway"
 	_ = observability.Initialize(cfg.Observability, nil)
 	log.Info(ctx, "Gateway has started", nil)
@@ -26,6 +26,7 @@ import (
 	"github.com/linkall-labs/vanus/observability"
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/observability/metrics"
+	"github.com/linkall-labs/vanus/pkg/util/tlsconfig"
 	// this project.
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/internal/store"
@@ -44,6 +45,12 @@ func main() {
 		})
 		os.Exit(-1)
 	}
+	if err = tlsconfig.Init(cfg.TLS); err != nil {
+		log.Error(context.Background(), "init TLS failed", map[string]interface{}{
+			log.KeyError: err,
+		})
+		os.Exit(-1)
+	}
 
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Port))
 	if err != nil {
@@ -26,6 +26,7 @@ import (
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/observability/metrics"
 	"github.com/linkall-labs/vanus/pkg/util/signal"
+	"github.com/linkall-labs/vanus/pkg/util/tlsconfig"
 )
 
 var (
@@ -47,6 +48,12 @@ func main() {
 		})
 		os.Exit(-1)
 	}
+	if err = tlsconfig.Init(cfg.TLS); err != nil {
+		log.Error(ctx, "init TLS failed", map[string]interface{}{
+			log.KeyError: err,
+		})
+		os.Exit(-1)
+	}
 
 	_ = observability.Initialize(cfg.Observability, metrics.RegisterTimerMetrics)
 
@@ -28,6 +28,7 @@ import (
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/observability/metrics"
 	"github.com/linkall-labs/vanus/pkg/util/signal"
+	"github.com/linkall-labs/vanus/pkg/util/tlsconfig"
 	pbtrigger "github.com/linkall-labs/vanus/proto/pkg/trigger"
 	"google.golang.org/grpc"
 )
@@ -46,6 +47,12 @@ func main() {
 		})
 		os.Exit(-1)
 	}
+	if err = tlsconfig.Init(cfg.TLS); err != nil {
+		log.Error(context.Background(), "init TLS failed", map[string]interface{}{
+			log.KeyError: err,
+		})
+		os.Exit(-1)
+	}
 	listen, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Port))
 	if err != nil {
 		log.Error(context.Background(), "failed to listen", map[string]interface{}{
@@ -55,7 +62,7 @@ func main() {
 	}
 	ctx := signal.SetupSignalContext()
 	_ = observability.Initialize(cfg.Observability, metrics.RegisterTriggerMetrics)
-	var opts []grpc.ServerOption
+	opts := []grpc.ServerOption{grpc.Creds(tlsconfig.ServerCredentials())}
 	grpcServer := grpc.NewServer(opts...)
 	srv := trigger.NewTriggerServer(*cfg)
 	pbtrigger.RegisterTriggerWorkerServer(grpcServer, srv)
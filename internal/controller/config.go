@@ -19,27 +19,33 @@ import (
 
 	embedetcd "github.com/linkall-labs/embed-etcd"
 	"github.com/linkall-labs/vanus/internal/controller/eventbus"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/block"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/eventlog"
 	"github.com/linkall-labs/vanus/internal/controller/snowflake"
 	"github.com/linkall-labs/vanus/internal/controller/trigger"
 	"github.com/linkall-labs/vanus/internal/primitive"
 	"github.com/linkall-labs/vanus/observability"
+	"github.com/linkall-labs/vanus/pkg/util/tlsconfig"
 )
 
 type Config struct {
-	NodeID               uint16               `yaml:"node_id"`
-	Name                 string               `yaml:"name"`
-	IP                   string               `yaml:"ip"`
-	Port                 int                  `yaml:"port"`
-	GRPCReflectionEnable bool                 `yaml:"grpc_reflection_enable"`
-	EtcdEndpoints        []string             `yaml:"etcd"`
-	DataDir              string               `yaml:"data_dir"`
-	MetadataConfig       MetadataConfig       `yaml:"metadata"`
-	EtcdConfig           embedetcd.Config     `yaml:"embed_etcd"`
-	Topology             map[string]string    `yaml:"topology"`
-	Replicas             uint                 `yaml:"replicas"`
-	SecretEncryptionSalt string               `yaml:"secret_encryption_salt"`
-	SegmentCapacity      int64                `yaml:"segment_capacity"`
-	Observability        observability.Config `yaml:"observability"`
+	NodeID               uint16                   `yaml:"node_id"`
+	Name                 string                   `yaml:"name"`
+	IP                   string                   `yaml:"ip"`
+	Port                 int                      `yaml:"port"`
+	GRPCReflectionEnable bool                     `yaml:"grpc_reflection_enable"`
+	EtcdEndpoints        []string                 `yaml:"etcd"`
+	DataDir              string                   `yaml:"data_dir"`
+	MetadataConfig       MetadataConfig           `yaml:"metadata"`
+	EtcdConfig           embedetcd.Config         `yaml:"embed_etcd"`
+	Topology             map[string]string        `yaml:"topology"`
+	Replicas             uint                     `yaml:"replicas"`
+	SecretEncryptionSalt string                   `yaml:"secret_encryption_salt"`
+	SegmentCapacity      int64                    `yaml:"segment_capacity"`
+	Placement            block.PlacementConfig    `yaml:"placement"`
+	Rebalance            eventlog.RebalanceConfig `yaml:"rebalance"`
+	Observability        observability.Config     `yaml:"observability"`
+	TLS                  tlsconfig.Config         `yaml:"tls"`
 }
 
 func (c *Config) GetEtcdConfig() embedetcd.Config {
@@ -57,6 +63,8 @@ func (c *Config) GetEventbusCtrlConfig() eventbus.Config {
 		Replicas:         c.Replicas,
 		Topology:         c.Topology,
 		SegmentCapacity:  c.SegmentCapacity,
+		Placement:        c.Placement,
+		Rebalance:        c.Rebalance,
 	}
 }
 
@@ -23,10 +23,10 @@ import (
 
 	"github.com/huandu/skiplist"
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/server"
 	"github.com/linkall-labs/vanus/internal/kv"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/observability/log"
-	"github.com/linkall-labs/vanus/pkg/errors"
 )
 
 const (
@@ -38,6 +38,9 @@ const (
 type Allocator interface {
 	Run(ctx context.Context, kvCli kv.Client, dynamicAllocate bool) error
 	Pick(ctx context.Context, num int) ([]*metadata.Block, error)
+	// PickExcluding picks a block on the volume which isn't in peers or excluded, it's used to
+	// replace a replica of a segment, the other replicas of which are on the volumes in peers.
+	PickExcluding(ctx context.Context, peers, excluded []vanus.ID) (*metadata.Block, error)
 	Stop()
 }
 
@@ -101,39 +104,63 @@ func (al *allocator) Pick(ctx context.Context, num int) ([]*metadata.Block, erro
 	defer al.mutex.Unlock()
 	blockArr := make([]*metadata.Block, num)
 
-	instances := al.selector.Select(num, al.blockCapacity)
-	if len(instances) == 0 {
-		return nil, errors.ErrVolumeInstanceNotFound
+	instances, err := al.selector.Select(num, al.blockCapacity)
+	if err != nil {
+		return nil, err
 	}
 	for idx := 0; idx < num; idx++ {
-		ins := instances[idx]
-		var skipList *skiplist.SkipList
-		v, exist := al.volumeBlockBuffer.Load(ins.GetMeta().ID.Key())
-		var err error
-		var block *metadata.Block
-		if exist {
-			skipList, _ = v.(*skiplist.SkipList)
+		block, err := al.pickFromVolume(ctx, instances[idx])
+		if err != nil {
+			return nil, err
 		}
+		blockArr[idx] = block
+	}
+	return blockArr, nil
+}
 
-		if !exist || skipList.Len() == 0 {
-			block, err = ins.CreateBlock(ctx, al.blockCapacity)
-			if err != nil {
-				return nil, err
-			}
-			if err = al.updateBlockInKV(ctx, block); err != nil {
-				log.Error(ctx, "save block metadata to kv failed after creating", map[string]interface{}{
-					log.KeyError: err,
-					"block":      block,
-				})
-				return nil, err
-			}
-		} else {
+func (al *allocator) PickExcluding(ctx context.Context, peers, excluded []vanus.ID) (*metadata.Block, error) {
+	al.mutex.Lock()
+	defer al.mutex.Unlock()
+
+	ins, err := al.selector.SelectExcluding(al.blockCapacity, peers, excluded)
+	if err != nil {
+		return nil, err
+	}
+	return al.pickFromVolume(ctx, ins)
+}
+
+func (al *allocator) pickFromVolume(ctx context.Context, ins server.Instance) (*metadata.Block, error) {
+	v, exist := al.volumeBlockBuffer.Load(ins.GetMeta().ID.Key())
+	if exist {
+		skipList, _ := v.(*skiplist.SkipList)
+		if skipList.Len() != 0 {
 			val := skipList.RemoveFront()
-			block, _ = val.Value.(*metadata.Block)
+			block, _ := val.Value.(*metadata.Block)
+			return block, nil
 		}
-		blockArr[idx] = block
 	}
-	return blockArr, nil
+
+	block, err := ins.CreateBlock(ctx, al.blockCapacity)
+	if err != nil {
+		return nil, err
+	}
+	if err = al.updateBlockInKV(ctx, block); err != nil {
+		log.Error(ctx, "save block metadata to kv failed after creating", map[string]interface{}{
+			log.KeyError: err,
+			"block":      block,
+		})
+		return nil, err
+	}
+	return block, nil
+}
+
+func containsID(ids []vanus.ID, id vanus.ID) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
 }
 
 func (al *allocator) Stop() {
@@ -27,6 +27,7 @@ import (
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
 	"github.com/linkall-labs/vanus/internal/kv"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/pkg/errors"
 
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/server"
 	. "github.com/smartystreets/goconvey/convey"
@@ -53,6 +54,46 @@ func TestAllocator_Pick(t *testing.T) {
 	})
 }
 
+func TestAllocator_PickExcluding(t *testing.T) {
+	Convey("test pick method of allocator.PickExcluding", t, func() {
+		ctrl := gomock.NewController(t)
+		alloc := getAllocator(ctrl)
+		kvMock := kv.NewMockClient(ctrl)
+		alloc.kvClient = kvMock
+		kvMock.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+		ctx := stdCtx.Background()
+
+		Convey("pick a block on the volume not excluded", func() {
+			for i := 0; i < 3; i++ {
+				block, err := alloc.PickExcluding(ctx, []vanus.ID{vanus.NewIDFromUint64(1)},
+					[]vanus.ID{vanus.NewIDFromUint64(3)})
+				So(err, ShouldBeNil)
+				So(block.VolumeID, ShouldEqual, vanus.NewIDFromUint64(2))
+			}
+		})
+
+		Convey("pick a block from buffer", func() {
+			l := skiplist.New(skiplist.String)
+			buffered := &metadata.Block{ID: vanus.NewTestID(), VolumeID: vanus.NewIDFromUint64(2)}
+			l.Set(buffered.ID.Key(), buffered)
+			alloc.volumeBlockBuffer.Store(vanus.NewIDFromUint64(2).Key(), l)
+			block, err := alloc.PickExcluding(ctx, []vanus.ID{
+				vanus.NewIDFromUint64(1), vanus.NewIDFromUint64(3),
+			}, nil)
+			So(err, ShouldBeNil)
+			So(block, ShouldEqual, buffered)
+			So(l.Len(), ShouldBeZeroValue)
+		})
+
+		Convey("all volumes are excluded", func() {
+			_, err := alloc.PickExcluding(ctx, []vanus.ID{
+				vanus.NewIDFromUint64(1), vanus.NewIDFromUint64(2), vanus.NewIDFromUint64(3),
+			}, nil)
+			So(errors.Is(err, errors.ErrVolumeInstanceNotFound), ShouldBeTrue)
+		})
+	})
+}
+
 func TestAllocator_RunWithoutDynamic(t *testing.T) {
 	Convey("test run without dynamic allocate block", t, func() {
 		ctrl := gomock.NewController(t)
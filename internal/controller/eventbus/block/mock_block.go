@@ -11,6 +11,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	metadata "github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
 	kv "github.com/linkall-labs/vanus/internal/kv"
+	vanus "github.com/linkall-labs/vanus/internal/primitive/vanus"
 )
 
 // MockAllocator is a mock of Allocator interface.
@@ -51,6 +52,21 @@ func (mr *MockAllocatorMockRecorder) Pick(ctx, num interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pick", reflect.TypeOf((*MockAllocator)(nil).Pick), ctx, num)
 }
 
+// PickExcluding mocks base method.
+func (m *MockAllocator) PickExcluding(ctx context.Context, peers, excluded []vanus.ID) (*metadata.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PickExcluding", ctx, peers, excluded)
+	ret0, _ := ret[0].(*metadata.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PickExcluding indicates an expected call of PickExcluding.
+func (mr *MockAllocatorMockRecorder) PickExcluding(ctx, peers, excluded interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PickExcluding", reflect.TypeOf((*MockAllocator)(nil).PickExcluding), ctx, peers, excluded)
+}
+
 // Run mocks base method.
 func (m *MockAllocator) Run(ctx context.Context, kvCli kv.Client, dynamicAllocate bool) error {
 	m.ctrl.T.Helper()
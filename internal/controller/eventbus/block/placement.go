@@ -0,0 +1,267 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package block
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/server"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/pkg/errors"
+)
+
+const (
+	StrategyRoundRobin    = "round_robin"
+	StrategyTopologyAware = "topology_aware"
+
+	// NodeLabel is the label of volume to tell which node it's on, the host of segment server's
+	// address is used if it's absent.
+	NodeLabel = "node"
+
+	defaultHighWatermark = 0.9
+	// the volumes whose usage ratios are in the same bucket are regarded as equally loaded, and
+	// are selected in turn. Otherwise, the preallocated blocks, which don't change the usage, would
+	// always be placed on the same volumes.
+	usageBuckets = 20
+)
+
+var defaultDomainLabels = []string{"zone", "rack"}
+
+type PlacementConfig struct {
+	// Strategy is the algorithm to place replicas of a segment, round_robin or topology_aware,
+	// topology_aware is used by default.
+	Strategy string `yaml:"strategy"`
+	// DomainLabels are the labels of volume which define failure domains, from the largest to the
+	// smallest, replicas of a segment are spread across them in order.
+	DomainLabels []string `yaml:"domain_labels"`
+	// HighWatermark is the usage ratio of volume, above which the volume is overloaded and no new
+	// block is placed on it.
+	HighWatermark float64 `yaml:"high_watermark"`
+}
+
+// NewVolumeSelector creates the VolumeSelector of the strategy in cfg.
+func NewVolumeSelector(cfg PlacementConfig, f func() []server.Instance) VolumeSelector {
+	if cfg.Strategy == StrategyRoundRobin {
+		return NewVolumeRoundRobin(f)
+	}
+	return NewVolumeTopologyAware(cfg, f)
+}
+
+// NewVolumeTopologyAware an implementation which places replicas by capacity, load and failure
+// domains of volumes. The capacity, used bytes and labels are reported by heartbeats of segment
+// servers.
+func NewVolumeTopologyAware(cfg PlacementConfig, f func() []server.Instance) VolumeSelector {
+	if cfg.DomainLabels == nil {
+		cfg.DomainLabels = defaultDomainLabels
+	}
+	if cfg.HighWatermark <= 0 || cfg.HighWatermark > 1 {
+		cfg.HighWatermark = defaultHighWatermark
+	}
+	return &volumeTopologyAwareSelector{
+		domainLabels:  cfg.DomainLabels,
+		highWatermark: cfg.HighWatermark,
+		getVolumes:    f,
+	}
+}
+
+type volumeTopologyAwareSelector struct {
+	domainLabels  []string
+	highWatermark float64
+	count         int
+	getVolumes    func() []server.Instance
+	mutex         sync.Mutex
+}
+
+type placementCandidate struct {
+	ins     server.Instance
+	node    string
+	domains []string
+	bucket  int
+}
+
+// Select picks #{num} volumes on distinct nodes. A volume is skipped if it's overloaded or hasn't
+// enough free space for the Block. Each next replica goes to the volume sharing the fewest failure
+// domains with the picked ones, the larger domain is considered first, and the less loaded volume
+// is preferred between equals. An error is returned rather than placing two replicas on one node.
+func (s *volumeTopologyAwareSelector) Select(num int, size int64) ([]server.Instance, error) {
+	instances := make([]server.Instance, 0)
+	if num == 0 || size == 0 {
+		return instances, nil
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	candidates, overloaded := s.candidates(size, nil)
+	picked := make([]*placementCandidate, 0, num)
+	for len(picked) < num {
+		c := s.next(candidates, picked)
+		if c == nil {
+			return nil, errors.ErrVolumeInstanceNotFound.WithMessage(fmt.Sprintf(
+				"can't place %d replicas on distinct nodes, only %d available, %d volumes are overloaded",
+				num, len(picked), overloaded))
+		}
+		picked = append(picked, c)
+		instances = append(instances, c.ins)
+	}
+	s.count++
+	return instances, nil
+}
+
+// SelectExcluding picks a volume for a new replica, the volumes in #{peers} are regarded as
+// picked, so that the new replica is placed in the same way as Select does.
+func (s *volumeTopologyAwareSelector) SelectExcluding(
+	size int64, peers, excluded []vanus.ID,
+) (server.Instance, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	candidates, overloaded := s.candidates(size, append(append([]vanus.ID{}, peers...), excluded...))
+	picked := make([]*placementCandidate, 0, len(peers))
+	for _, ins := range s.getVolumes() {
+		if containsID(peers, ins.ID()) {
+			picked = append(picked, s.newCandidate(ins))
+		}
+	}
+	c := s.next(candidates, picked)
+	if c == nil {
+		return nil, errors.ErrVolumeInstanceNotFound.WithMessage(fmt.Sprintf(
+			"can't place replica on a node without other replicas, %d volumes are overloaded", overloaded))
+	}
+	s.count++
+	return c.ins, nil
+}
+
+func (s *volumeTopologyAwareSelector) SelectByID(id vanus.ID) server.Instance {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	volumes := s.getVolumes()
+	for idx := range volumes {
+		if volumes[idx].ID() == id {
+			return volumes[idx]
+		}
+	}
+	return nil
+}
+
+func (s *volumeTopologyAwareSelector) GetAllVolume() []server.Instance {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.getVolumes()
+}
+
+// candidates returns the volumes which can hold a Block of #{size}, ordered by load, and the
+// number of overloaded volumes.
+func (s *volumeTopologyAwareSelector) candidates(size int64, excluded []vanus.ID) ([]*placementCandidate, int) {
+	volumes := append([]server.Instance{}, s.getVolumes()...)
+	sort.Slice(volumes, func(i, j int) bool {
+		return volumes[i].ID().Key() < volumes[j].ID().Key()
+	})
+
+	candidates := make([]*placementCandidate, 0, len(volumes))
+	overloaded := 0
+	for idx := range volumes {
+		ins := volumes[(s.count+idx)%len(volumes)]
+		if containsID(excluded, ins.ID()) {
+			continue
+		}
+		c := s.newCandidate(ins)
+		// the capacity is unknown before the first heartbeat.
+		if capacity, used := ins.Usage(); capacity > 0 {
+			if float64(used+size) > float64(capacity)*s.highWatermark {
+				overloaded++
+				continue
+			}
+			c.bucket = int(float64(used) / float64(capacity) * usageBuckets)
+		}
+		candidates = append(candidates, c)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].bucket < candidates[j].bucket
+	})
+	return candidates, overloaded
+}
+
+func (s *volumeTopologyAwareSelector) newCandidate(ins server.Instance) *placementCandidate {
+	labels := ins.Labels()
+	c := &placementCandidate{
+		ins:     ins,
+		node:    labels[NodeLabel],
+		domains: make([]string, len(s.domainLabels)),
+	}
+	if c.node == "" {
+		// the servers on the same node listen on different ports.
+		c.node = ins.Address()
+		if host, _, err := net.SplitHostPort(c.node); err == nil {
+			c.node = host
+		}
+	}
+	if c.node == "" {
+		c.node = ins.ID().Key()
+	}
+	for i, label := range s.domainLabels {
+		c.domains[i] = labels[label]
+	}
+	return c
+}
+
+// next returns the candidate on a node without picked ones, which shares the fewest failure
+// domains with them. The first one wins between equals since candidates are ordered by load.
+func (s *volumeTopologyAwareSelector) next(candidates, picked []*placementCandidate) *placementCandidate {
+	var best *placementCandidate
+	var bestShared []int
+	for _, c := range candidates {
+		if onSameNode(c, picked) {
+			continue
+		}
+		shared := make([]int, len(s.domainLabels))
+		for i, domain := range c.domains {
+			// the unlabeled volume is in an unknown domain.
+			if domain == "" {
+				continue
+			}
+			for _, p := range picked {
+				if p.domains[i] == domain {
+					shared[i]++
+				}
+			}
+		}
+		if best == nil || lessShared(shared, bestShared) {
+			best, bestShared = c, shared
+		}
+	}
+	return best
+}
+
+func onSameNode(c *placementCandidate, picked []*placementCandidate) bool {
+	for _, p := range picked {
+		if p.ins.ID() == c.ins.ID() || p.node == c.node {
+			return true
+		}
+	}
+	return false
+}
+
+func lessShared(a, b []int) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return false
+}
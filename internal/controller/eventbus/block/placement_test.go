@@ -0,0 +1,143 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package block
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/server"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func newPlacementInstance(ctrl *gomock.Controller, id uint64, used int64, labels map[string]string) server.Instance {
+	return newAddressedPlacementInstance(ctrl, id, "", used, labels)
+}
+
+func newAddressedPlacementInstance(ctrl *gomock.Controller, id uint64, addr string, used int64,
+	labels map[string]string,
+) server.Instance {
+	ins := server.NewMockInstance(ctrl)
+	ins.EXPECT().ID().Return(vanus.NewIDFromUint64(id)).AnyTimes()
+	ins.EXPECT().Address().Return(addr).AnyTimes()
+	ins.EXPECT().Usage().Return(int64(1024*1024*1024), used).AnyTimes()
+	ins.EXPECT().Labels().Return(labels).AnyTimes()
+	return ins
+}
+
+func instanceIDs(instances []server.Instance) []uint64 {
+	ids := make([]uint64, 0, len(instances))
+	for _, ins := range instances {
+		ids = append(ids, ins.ID().Uint64())
+	}
+	return ids
+}
+
+func TestVolumeTopologyAware_Select(t *testing.T) {
+	Convey("test topology-aware selector", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		size := int64(64 * 1024 * 1024)
+		srv1 := newPlacementInstance(ctrl, 1, 0, map[string]string{"zone": "a", "rack": "r1", "node": "n1"})
+		srv2 := newPlacementInstance(ctrl, 2, 0, map[string]string{"zone": "a", "rack": "r2", "node": "n2"})
+		srv3 := newPlacementInstance(ctrl, 3, 0, map[string]string{"zone": "b", "rack": "r3", "node": "n3"})
+		// overloaded
+		srv4 := newPlacementInstance(ctrl, 4, 950*1024*1024, map[string]string{"zone": "c", "rack": "r4", "node": "n4"})
+		srvs := []server.Instance{srv1, srv2, srv3, srv4}
+		selector := NewVolumeSelector(PlacementConfig{}, func() []server.Instance {
+			return srvs
+		})
+
+		Convey("test spread across zones", func() {
+			instances, err := selector.Select(2, size)
+			So(err, ShouldBeNil)
+			ids := instanceIDs(instances)
+			So(ids, ShouldHaveLength, 2)
+			So(ids, ShouldContain, uint64(3))
+			So(ids, ShouldNotContain, uint64(4))
+
+			instances, err = selector.Select(3, size)
+			So(err, ShouldBeNil)
+			ids = instanceIDs(instances)
+			So(ids, ShouldHaveLength, 3)
+			So(ids, ShouldContain, uint64(1))
+			So(ids, ShouldContain, uint64(2))
+			So(ids, ShouldContain, uint64(3))
+		})
+
+		Convey("test not enough nodes", func() {
+			_, err := selector.Select(4, size)
+			So(errors.Is(err, errors.ErrVolumeInstanceNotFound), ShouldBeTrue)
+
+			srvs = []server.Instance{
+				srv1,
+				newPlacementInstance(ctrl, 5, 0, map[string]string{"zone": "b", "node": "n1"}),
+			}
+			_, err = selector.Select(2, size)
+			So(errors.Is(err, errors.ErrVolumeInstanceNotFound), ShouldBeTrue)
+		})
+
+		Convey("test unlabeled volumes on the same host", func() {
+			srvs = []server.Instance{
+				newAddressedPlacementInstance(ctrl, 5, "10.0.0.1:11811", 0, nil),
+				newAddressedPlacementInstance(ctrl, 6, "10.0.0.1:11812", 0, nil),
+				newAddressedPlacementInstance(ctrl, 7, "10.0.0.2:11811", 0, nil),
+			}
+			for i := 0; i < 3; i++ {
+				instances, err := selector.Select(2, size)
+				So(err, ShouldBeNil)
+				So(instanceIDs(instances), ShouldContain, uint64(7))
+			}
+			_, err := selector.Select(3, size)
+			So(errors.Is(err, errors.ErrVolumeInstanceNotFound), ShouldBeTrue)
+		})
+
+		Convey("test prefer less loaded volume", func() {
+			srv5 := newPlacementInstance(ctrl, 5, 0, map[string]string{"zone": "b", "rack": "r3", "node": "n5"})
+			srv3 = newPlacementInstance(ctrl, 3, 512*1024*1024, map[string]string{"zone": "b", "rack": "r3", "node": "n3"})
+			srvs = []server.Instance{srv1, srv3, srv5}
+			for i := 0; i < 3; i++ {
+				instances, err := selector.Select(2, size)
+				So(err, ShouldBeNil)
+				ids := instanceIDs(instances)
+				So(ids, ShouldContain, uint64(1))
+				So(ids, ShouldContain, uint64(5))
+			}
+		})
+
+		Convey("test select excluding", func() {
+			ins, err := selector.SelectExcluding(size, []vanus.ID{vanus.NewIDFromUint64(1)}, nil)
+			So(err, ShouldBeNil)
+			So(ins.ID().Uint64(), ShouldEqual, 3)
+
+			ins, err = selector.SelectExcluding(size, []vanus.ID{vanus.NewIDFromUint64(1), vanus.NewIDFromUint64(3)}, nil)
+			So(err, ShouldBeNil)
+			So(ins.ID().Uint64(), ShouldEqual, 2)
+
+			ins, err = selector.SelectExcluding(size, []vanus.ID{vanus.NewIDFromUint64(1)},
+				[]vanus.ID{vanus.NewIDFromUint64(3)})
+			So(err, ShouldBeNil)
+			So(ins.ID().Uint64(), ShouldEqual, 2)
+
+			_, err = selector.SelectExcluding(size, []vanus.ID{
+				vanus.NewIDFromUint64(1), vanus.NewIDFromUint64(2), vanus.NewIDFromUint64(3),
+			}, nil)
+			So(errors.Is(err, errors.ErrVolumeInstanceNotFound), ShouldBeTrue)
+		})
+	})
+}
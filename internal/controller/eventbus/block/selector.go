@@ -20,6 +20,7 @@ import (
 
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/server"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/pkg/errors"
 )
 
 // VolumeSelector selector for Block creating. The implementation based on different algorithm, typical
@@ -27,8 +28,14 @@ import (
 type VolumeSelector interface {
 	// Select return #{num} server.Instance as an array, #{size} tell selector how large Block
 	// will be created. The same server.Instance maybe placed in different index of returned array
-	// in order to make sure that length of returned array equals with #{num}
-	Select(num int, size int64) []server.Instance
+	// in order to make sure that length of returned array equals with #{num} if the selector allows,
+	// otherwise an error is returned.
+	Select(num int, size int64) ([]server.Instance, error)
+
+	// SelectExcluding return a server.Instance for a new replica of an existing Block group, the
+	// volumes in #{peers} hold the other replicas, and the volumes in #{excluded} mustn't be selected
+	// either.
+	SelectExcluding(size int64, peers, excluded []vanus.ID) (server.Instance, error)
 
 	// SelectByID return a specified server.Instance with ServerID
 	SelectByID(id vanus.ID) server.Instance
@@ -59,17 +66,17 @@ type volumeRoundRobinSelector struct {
 // round-rubin is a naive algorithm, so that it can't guarantee completely balancing in the cluster, it
 // just does best effort of it. There is another advanced algorithm implementation such as
 // runtime-statistic-based-algorithm in the future.
-func (s *volumeRoundRobinSelector) Select(num int, size int64) []server.Instance {
+func (s *volumeRoundRobinSelector) Select(num int, size int64) ([]server.Instance, error) {
 	instances := make([]server.Instance, 0)
 	if num == 0 || size == 0 {
-		return instances
+		return instances, nil
 	}
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
 	volumes := s.getVolumes()
 	if len(volumes) == 0 {
-		return instances
+		return instances, errors.ErrVolumeInstanceNotFound
 	}
 	keys := make([]string, 0)
 	m := make(map[string]server.Instance)
@@ -84,7 +91,24 @@ func (s *volumeRoundRobinSelector) Select(num int, size int64) []server.Instance
 		instances = append(instances, m[keys[(s.count+int64(idx))%int64(len(keys))]])
 	}
 	s.count++
-	return instances
+	return instances, nil
+}
+
+// SelectExcluding returns the first volume not excluded in rotated order, so that the new
+// replicas are spread across the cluster.
+func (s *volumeRoundRobinSelector) SelectExcluding(
+	size int64, peers, excluded []vanus.ID,
+) (server.Instance, error) {
+	instances, err := s.Select(len(s.GetAllVolume()), size)
+	if err != nil {
+		return nil, err
+	}
+	for _, ins := range instances {
+		if ins != nil && !containsID(peers, ins.GetMeta().ID) && !containsID(excluded, ins.GetMeta().ID) {
+			return ins, nil
+		}
+	}
+	return nil, errors.ErrVolumeInstanceNotFound
 }
 
 func (s *volumeRoundRobinSelector) SelectByID(id vanus.ID) server.Instance {
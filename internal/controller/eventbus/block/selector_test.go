@@ -21,6 +21,7 @@ import (
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/server"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/pkg/errors"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -42,20 +43,20 @@ func TestNewVolumeRoundRobin(t *testing.T) {
 			return srvs
 		})
 		Convey("test select", func() {
-			instances := selector.Select(1, 64*1024*1024)
+			instances, _ := selector.Select(1, 64*1024*1024)
 			So(instances, ShouldHaveLength, 1)
 			So(instances[0].GetMeta().ID.Uint64(), ShouldEqual, uint64(1))
 
-			instances = selector.Select(1, 640*1024*1024)
+			instances, _ = selector.Select(1, 640*1024*1024)
 			So(instances[0].GetMeta().ID.Uint64(), ShouldEqual, uint64(2))
 			So(selector.(*volumeRoundRobinSelector).count, ShouldEqual, 2)
 
-			instances = selector.Select(2, 64*1024*1024)
+			instances, _ = selector.Select(2, 64*1024*1024)
 			So(instances, ShouldHaveLength, 2)
 			So(instances[0].GetMeta().ID.Uint64(), ShouldEqual, uint64(1))
 			So(selector.(*volumeRoundRobinSelector).count, ShouldEqual, 3)
 
-			instances = selector.Select(3, 64*1024*1024)
+			instances, _ = selector.Select(3, 64*1024*1024)
 			So(instances, ShouldHaveLength, 3)
 
 			So(instances[0].GetMeta().ID.Uint64(), ShouldEqual, uint64(2))
@@ -80,11 +81,11 @@ func TestNewVolumeRoundRobin(t *testing.T) {
 		})
 
 		Convey("test invalid arguments", func() {
-			instances := selector.Select(0, 64*1024*1024)
+			instances, _ := selector.Select(0, 64*1024*1024)
 			So(instances, ShouldNotBeNil)
 			So(instances, ShouldHaveLength, 0)
 
-			instances = selector.Select(2, 0)
+			instances, _ = selector.Select(2, 0)
 			So(instances, ShouldNotBeNil)
 			So(instances, ShouldHaveLength, 0)
 		})
@@ -96,8 +97,8 @@ func TestNewVolumeRoundRobinAbnormal(t *testing.T) {
 		selector := NewVolumeRoundRobin(func() []server.Instance {
 			return []server.Instance{}
 		})
-		instances := selector.Select(3, 64*1024*1024)
-		So(instances, ShouldNotBeNil)
+		instances, err := selector.Select(3, 64*1024*1024)
+		So(err, ShouldEqual, errors.ErrVolumeInstanceNotFound)
 		So(instances, ShouldHaveLength, 0)
 	})
 }
@@ -14,15 +14,21 @@
 
 package eventbus
 
-import embedetcd "github.com/linkall-labs/embed-etcd"
+import (
+	embedetcd "github.com/linkall-labs/embed-etcd"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/block"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/eventlog"
+)
 
 type Config struct {
-	IP               string            `yaml:"ip"`
-	Port             int               `yaml:"port"`
-	KVStoreEndpoints []string          `yaml:"kv_store_endpoints"`
-	KVKeyPrefix      string            `yaml:"kv_key_prefix"`
-	EtcdConfig       embedetcd.Config  `yaml:"etcd"`
-	Replicas         uint              `yaml:"replicas"`
-	Topology         map[string]string `yaml:"topology"`
-	SegmentCapacity  int64             `yaml:"segment_capacity"`
+	IP               string                   `yaml:"ip"`
+	Port             int                      `yaml:"port"`
+	KVStoreEndpoints []string                 `yaml:"kv_store_endpoints"`
+	KVKeyPrefix      string                   `yaml:"kv_key_prefix"`
+	EtcdConfig       embedetcd.Config         `yaml:"etcd"`
+	Replicas         uint                     `yaml:"replicas"`
+	Topology         map[string]string        `yaml:"topology"`
+	SegmentCapacity  int64                    `yaml:"segment_capacity"`
+	Placement        block.PlacementConfig    `yaml:"placement"`
+	Rebalance        eventlog.RebalanceConfig `yaml:"rebalance"`
 }
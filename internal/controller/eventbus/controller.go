@@ -27,6 +27,7 @@ import (
 
 	embedetcd "github.com/linkall-labs/embed-etcd"
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/eventlog"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/group"
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/server"
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/volume"
@@ -68,7 +69,9 @@ func NewController(cfg Config, member embedetcd.Member) *controller {
 		stopNotify:  make(chan error, 1),
 	}
 	c.volumeMgr = volume.NewVolumeManager(c.ssMgr)
-	c.eventLogMgr = eventlog.NewManager(c.volumeMgr, cfg.Replicas, cfg.SegmentCapacity)
+	c.eventLogMgr = eventlog.NewManager(c.volumeMgr, cfg.Replicas, cfg.SegmentCapacity, cfg.Placement,
+		cfg.Rebalance)
+	c.groupMgr = group.NewManager()
 	return c
 }
 
@@ -78,6 +81,7 @@ type controller struct {
 	volumeMgr       volume.Manager
 	eventLogMgr     eventlog.Manager
 	ssMgr           server.Manager
+	groupMgr        group.Manager
 	eventBusMap     map[string]*metadata.Eventbus
 	member          embedetcd.Member
 	cancelCtx       context.Context
@@ -87,6 +91,8 @@ type controller struct {
 	readyNotify     chan error
 	stopNotify      chan error
 	mutex           sync.Mutex
+	// schemaMutex serializes the registration of schemas to allocate versions.
+	schemaMutex sync.Mutex
 }
 
 func (ctrl *controller) Start(_ context.Context) error {
@@ -163,6 +169,12 @@ func (ctrl *controller) createEventBus(ctx context.Context,
 		return nil, errors.ErrInvalidRequest.WithMessage(fmt.Sprintf("the number of eventlog exceeded,"+
 			" maximum is %d", maximumEventlogNum))
 	}
+	if err := isValidRetention(req.Retention); err != nil {
+		return nil, err
+	}
+	if err := isValidCompression(req.Compression); err != nil {
+		return nil, err
+	}
 
 	id, err := vanus.NewID()
 	if err != nil {
@@ -177,6 +189,8 @@ func (ctrl *controller) createEventBus(ctx context.Context,
 		LogNumber:   int(logNum),
 		EventLogs:   make([]*metadata.Eventlog, int(logNum)),
 		Description: req.Description,
+		Retention:   metadata.NewRetentionFromProto(req.Retention),
+		Compression: req.Compression,
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 	}
@@ -195,6 +209,8 @@ func (ctrl *controller) createEventBus(ctx context.Context,
 		eb.EventLogs[idx] = el
 	}
 	ctrl.eventBusMap[eb.Name] = eb
+	ctrl.eventLogMgr.SetRetention(eb.ID, eb.Retention)
+	ctrl.eventLogMgr.SetCompression(eb.ID, eb.Compression)
 
 	{
 		data, _ := json.Marshal(eb)
@@ -221,6 +237,26 @@ func (ctrl *controller) DeleteEventBus(ctx context.Context, eb *metapb.EventBus)
 
 	// TODO(wenfeng.wang) notify gateway to cut flow
 	delete(ctrl.eventBusMap, eb.Name)
+	if err = ctrl.groupMgr.DeleteGroups(ctx, eb.Name); err != nil {
+		log.Warning(ctx, "delete consumer groups of eventbus failed", map[string]interface{}{
+			log.KeyError: err,
+			"eventbus":   eb.Name,
+		})
+	}
+	if err = ctrl.deleteScheduledEvents(ctx, eb.Name); err != nil {
+		log.Warning(ctx, "delete scheduled events of eventbus failed", map[string]interface{}{
+			log.KeyError: err,
+			"eventbus":   eb.Name,
+		})
+	}
+	if err = ctrl.deleteSchemas(ctx, eb.Name); err != nil {
+		log.Warning(ctx, "delete schemas of eventbus failed", map[string]interface{}{
+			log.KeyError: err,
+			"eventbus":   eb.Name,
+		})
+	}
+	ctrl.eventLogMgr.SetRetention(bus.ID, nil)
+	ctrl.eventLogMgr.SetCompression(bus.ID, metapb.CompressAlgorithm_NONE)
 	wg := sync.WaitGroup{}
 
 	for _, v := range bus.EventLogs {
@@ -271,7 +307,133 @@ func (ctrl *controller) ListEventBus(ctx context.Context, _ *emptypb.Empty) (*ct
 
 func (ctrl *controller) UpdateEventBus(ctx context.Context,
 	req *ctrlpb.UpdateEventBusRequest) (*metapb.EventBus, error) {
-	return &metapb.EventBus{}, nil
+	ctrl.mutex.Lock()
+	defer ctrl.mutex.Unlock()
+
+	bus, exist := ctrl.eventBusMap[req.Name]
+	if !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("the eventbus doesn't exist")
+	}
+	if err := isValidRetention(req.Retention); err != nil {
+		return nil, err
+	}
+	if req.Compression != nil {
+		if err := isValidCompression(*req.Compression); err != nil {
+			return nil, err
+		}
+	}
+
+	updated := *bus
+	if req.Description != "" {
+		updated.Description = req.Description
+	}
+	if req.Retention != nil {
+		updated.Retention = metadata.NewRetentionFromProto(req.Retention)
+	}
+	if req.Compression != nil {
+		updated.Compression = *req.Compression
+	}
+	updated.UpdatedAt = time.Now()
+	data, _ := json.Marshal(&updated)
+	if err := ctrl.kvStore.Set(ctx, metadata.GetEventbusMetadataKey(updated.Name), data); err != nil {
+		return nil, errors.ErrInternal.WithMessage("update eventbus metadata in kv failed").Wrap(err)
+	}
+	ctrl.eventBusMap[updated.Name] = &updated
+	ctrl.eventLogMgr.SetRetention(updated.ID, updated.Retention)
+	ctrl.eventLogMgr.SetCompression(updated.ID, updated.Compression)
+	return ctrl.getEventbus(updated.Name)
+}
+
+func (ctrl *controller) JoinConsumerGroup(ctx context.Context,
+	req *ctrlpb.JoinConsumerGroupRequest) (*ctrlpb.ConsumerGroupAssignment, error) {
+	eventlogs, err := ctrl.getEventlogIDs(req.Eventbus)
+	if err != nil {
+		return nil, err
+	}
+	assignment, err := ctrl.groupMgr.Join(ctx, req.Eventbus, req.Group, eventlogs)
+	if err != nil {
+		return nil, err
+	}
+	return toPbConsumerGroupAssignment(assignment), nil
+}
+
+func (ctrl *controller) ConsumerGroupHeartbeat(ctx context.Context,
+	req *ctrlpb.ConsumerGroupHeartbeatRequest) (*ctrlpb.ConsumerGroupAssignment, error) {
+	eventlogs, err := ctrl.getEventlogIDs(req.Eventbus)
+	if err != nil {
+		return nil, err
+	}
+	assignment, err := ctrl.groupMgr.Heartbeat(ctx, req.Eventbus, req.Group, req.MemberId, eventlogs)
+	if err != nil {
+		return nil, err
+	}
+	return toPbConsumerGroupAssignment(assignment), nil
+}
+
+func (ctrl *controller) LeaveConsumerGroup(ctx context.Context,
+	req *ctrlpb.LeaveConsumerGroupRequest) (*emptypb.Empty, error) {
+	ctrl.groupMgr.Leave(ctx, req.Eventbus, req.Group, req.MemberId)
+	return &emptypb.Empty{}, nil
+}
+
+func (ctrl *controller) CommitConsumerGroupOffset(ctx context.Context,
+	req *ctrlpb.CommitConsumerGroupOffsetRequest) (*emptypb.Empty, error) {
+	offsets := make(map[vanus.ID]uint64, len(req.Offsets))
+	for _, o := range req.Offsets {
+		offsets[vanus.NewIDFromUint64(o.EventLogId)] = o.Offset
+	}
+	err := ctrl.groupMgr.CommitOffset(ctx, req.Eventbus, req.Group, req.MemberId, req.Generation, offsets)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (ctrl *controller) getEventlogIDs(eventbus string) ([]vanus.ID, error) {
+	ctrl.mutex.Lock()
+	defer ctrl.mutex.Unlock()
+	bus, exist := ctrl.eventBusMap[eventbus]
+	if !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("the eventbus doesn't exist")
+	}
+	ids := make([]vanus.ID, 0, len(bus.EventLogs))
+	for _, el := range bus.EventLogs {
+		ids = append(ids, el.ID)
+	}
+	return ids, nil
+}
+
+func toPbConsumerGroupAssignment(assignment *group.Assignment) *ctrlpb.ConsumerGroupAssignment {
+	pb := &ctrlpb.ConsumerGroupAssignment{
+		MemberId:       assignment.MemberID,
+		Generation:     assignment.Generation,
+		Eventlogs:      make([]*ctrlpb.EventlogAssignment, 0, len(assignment.Eventlogs)),
+		SessionTimeout: uint32(assignment.SessionTimeout.Milliseconds()),
+	}
+	for _, el := range assignment.Eventlogs {
+		pb.Eventlogs = append(pb.Eventlogs, &ctrlpb.EventlogAssignment{
+			EventLogId: el.ID.Uint64(),
+			Offset:     el.Offset,
+		})
+	}
+	return pb
+}
+
+func isValidRetention(retention *metapb.RetentionPolicy) error {
+	if retention == nil {
+		return nil
+	}
+	if retention.MaxAge < 0 || retention.MaxBytes < 0 || retention.MaxEvents < 0 {
+		return errors.ErrInvalidRequest.WithMessage("the limits of retention policy can't be negative")
+	}
+	return nil
+}
+
+func isValidCompression(algorithm metapb.CompressAlgorithm) error {
+	if _, ok := metapb.CompressAlgorithm_name[int32(algorithm)]; !ok {
+		return errors.ErrInvalidRequest.WithMessage("unknown compress algorithm")
+	}
+	return nil
 }
 
 func (ctrl *controller) ListSegment(ctx context.Context,
@@ -311,6 +473,9 @@ func (ctrl *controller) RegisterSegmentServer(ctx context.Context,
 		}
 		volInstance = _volInstance
 	}
+	_, used := volInstance.Usage()
+	volInstance.UpdateUsage(int64(req.Capacity), used)
+	volInstance.SetLabels(req.Labels)
 
 	segments := make(map[uint64]*metapb.Segment)
 	blocks, err := ctrl.volumeMgr.GetBlocksOfVolume(ctx, volInstance)
@@ -430,6 +595,16 @@ func (ctrl *controller) processHeartbeat(ctx context.Context, req *ctrlpb.Segmen
 	} else {
 		srv.Polish()
 	}
+	if ins := ctrl.volumeMgr.GetVolumeInstanceByID(vanus.NewIDFromUint64(req.VolumeId)); ins != nil {
+		ins.UpdateUsage(int64(req.VolumeCapacity), int64(req.VolumeUsed))
+		ins.SetLabels(req.VolumeLabels)
+	}
+	aliveBlocks := make([]vanus.ID, 0, len(req.HealthInfo))
+	for _, info := range req.HealthInfo {
+		aliveBlocks = append(aliveBlocks, vanus.NewIDFromUint64(info.Id))
+	}
+	ctrl.eventLogMgr.MarkBlocksAlive(aliveBlocks)
+
 	segments := make(map[string][]eventlog.Segment)
 	for _, info := range req.HealthInfo {
 		blockID := vanus.NewIDFromUint64(info.Id)
@@ -455,6 +630,10 @@ func (ctrl *controller) processHeartbeat(ctx context.Context, req *ctrlpb.Segmen
 			FirstEventBornTime: time.UnixMilli(info.FirstEventBornTime),
 			LastEventBornTime:  time.UnixMilli(info.LastEventBornTime),
 		}
+		// The replicas are offloaded one by one, the tier of segment follows its leader.
+		if info.Leader == info.Id {
+			seg.Tier = info.Tier
+		}
 		if info.IsFull {
 			seg.State = eventlog.StateFrozen
 		}
@@ -516,6 +695,46 @@ func (ctrl *controller) ReportSegmentLeader(ctx context.Context,
 	return &emptypb.Empty{}, nil
 }
 
+func (ctrl *controller) DrainVolume(ctx context.Context,
+	req *ctrlpb.DrainVolumeRequest) (*ctrlpb.VolumeDrainStatus, error) {
+	if !ctrl.member.IsLeader() {
+		return nil, errors.ErrNotLeader
+	}
+	drain, err := ctrl.eventLogMgr.DrainVolume(ctx, vanus.NewIDFromUint64(req.VolumeId))
+	if err != nil {
+		return nil, err
+	}
+	return convertVolumeDrain(drain), nil
+}
+
+func (ctrl *controller) GetVolumeDrainStatus(_ context.Context,
+	req *ctrlpb.GetVolumeDrainStatusRequest) (*ctrlpb.VolumeDrainStatus, error) {
+	if !ctrl.member.IsLeader() {
+		return nil, errors.ErrNotLeader
+	}
+	drain := ctrl.eventLogMgr.GetVolumeDrain(vanus.NewIDFromUint64(req.VolumeId))
+	if drain == nil {
+		return nil, errors.ErrResourceNotFound.WithMessage("the volume isn't drained")
+	}
+	return convertVolumeDrain(drain), nil
+}
+
+func convertVolumeDrain(drain *metadata.VolumeDrain) *ctrlpb.VolumeDrainStatus {
+	status := &ctrlpb.VolumeDrainStatus{
+		VolumeId:        drain.VolumeID.Uint64(),
+		State:           ctrlpb.VolumeDrainStatus_DRAINING,
+		TotalBlocks:     uint32(drain.TotalBlocks),
+		RemainingBlocks: uint32(drain.RemainingBlocks),
+		MigratedBytes:   uint64(drain.MigratedBytes),
+		StartTime:       util.FormatTime(drain.StartTime),
+	}
+	if drain.State == metadata.VolumeDrained {
+		status.State = ctrlpb.VolumeDrainStatus_DRAINED
+		status.FinishTime = util.FormatTime(drain.FinishTime)
+	}
+	return status
+}
+
 func (ctrl *controller) membershipChangedProcessor(ctx context.Context, event embedetcd.MembershipChangedEvent) error {
 	ctrl.membershipMutex.Lock()
 	defer ctrl.membershipMutex.Unlock()
@@ -545,6 +764,7 @@ func (ctrl *controller) membershipChangedProcessor(ctx context.Context, event em
 			ctrl.stop(ctx, err)
 			return err
 		}
+		ctrl.groupMgr.Run(ctx, ctrl.kvStore)
 	case embedetcd.EventBecomeFollower:
 		if !ctrl.isLeader {
 			return nil
@@ -552,6 +772,7 @@ func (ctrl *controller) membershipChangedProcessor(ctx context.Context, event em
 		ctrl.isLeader = false
 		ctrl.eventLogMgr.Stop()
 		ctrl.ssMgr.Stop(ctx)
+		ctrl.groupMgr.Stop()
 	}
 	return nil
 }
@@ -570,6 +791,8 @@ func (ctrl *controller) loadEventbus(ctx context.Context) error {
 			return err
 		}
 		ctrl.eventBusMap[filepath.Base(pair.Key)] = busInfo
+		ctrl.eventLogMgr.SetRetention(busInfo.ID, busInfo.Retention)
+		ctrl.eventLogMgr.SetCompression(busInfo.ID, busInfo.Compression)
 	}
 	metrics.EventbusGauge.Set(float64(len(ctrl.eventBusMap)))
 	return nil
@@ -19,14 +19,19 @@ import (
 	"fmt"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	embedetcd "github.com/linkall-labs/embed-etcd"
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/eventlog"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/group"
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/server"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/volume"
 	"github.com/linkall-labs/vanus/internal/kv"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/pkg/errors"
+	"github.com/linkall-labs/vanus/pkg/util"
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
 	. "github.com/smartystreets/goconvey/convey"
@@ -63,14 +68,18 @@ func TestController_CreateEventBus(t *testing.T) {
 				el.SegmentNumber = 2
 				return el, nil
 			})
+			elMgr.EXPECT().SetRetention(gomock.Any(), gomock.Nil()).Times(1)
+			elMgr.EXPECT().SetCompression(gomock.Any(), metapb.CompressAlgorithm_ZSTD).Times(1)
 
 			vanus.InitFakeSnowflake()
 			res, err := ctrl.CreateEventBus(ctx, &ctrlpb.CreateEventBusRequest{
-				Name:      "test-1",
-				LogNumber: 0,
+				Name:        "test-1",
+				LogNumber:   0,
+				Compression: metapb.CompressAlgorithm_ZSTD,
 			})
 			So(err, ShouldBeNil)
 			So(res.Name, ShouldEqual, "test-1")
+			So(res.Compression, ShouldEqual, metapb.CompressAlgorithm_ZSTD)
 			So(res.Id, ShouldNotEqual, 0)
 			So(res.Logs, ShouldHaveLength, 1)
 			So(res.LogNumber, ShouldEqual, 1)
@@ -83,6 +92,18 @@ func TestController_CreateEventBus(t *testing.T) {
 			So(exist, ShouldBeTrue)
 		})
 
+		Convey("test create a eventbus with unknown compression", func() {
+			res, err := ctrl.CreateEventBus(ctx, &ctrlpb.CreateEventBusRequest{
+				Name:        "test-1",
+				Compression: metapb.CompressAlgorithm(10),
+			})
+			So(err, ShouldNotBeNil)
+			So(res, ShouldBeNil)
+			et, ok := err.(*errors.ErrorType)
+			So(ok, ShouldBeTrue)
+			So(et.Code, ShouldEqual, errors.ErrorCode_INVALID_REQUEST)
+		})
+
 		Convey("test create a eventbus but exist", func() {
 			kvCli.EXPECT().Exists(ctx, metadata.GetEventbusMetadataKey("test-1")).Times(1).Return(true, nil)
 
@@ -111,6 +132,8 @@ func TestController_DeleteEventBus(t *testing.T) {
 		ctrl.kvStore = kvCli
 		elMgr := eventlog.NewMockManager(mockCtrl)
 		ctrl.eventLogMgr = elMgr
+		groupMgr := group.NewMockManager(mockCtrl)
+		ctrl.groupMgr = groupMgr
 		ctx := stdCtx.Background()
 
 		Convey("deleting a doesn't exist eventbus", func() {
@@ -159,6 +182,11 @@ func TestController_DeleteEventBus(t *testing.T) {
 
 			elMgr.EXPECT().DeleteEventlog(ctx, md.EventLogs[0].ID).Times(1)
 			elMgr.EXPECT().DeleteEventlog(ctx, md.EventLogs[1].ID).Times(1)
+			elMgr.EXPECT().SetRetention(md.ID, gomock.Nil()).Times(1)
+			elMgr.EXPECT().SetCompression(md.ID, metapb.CompressAlgorithm_NONE).Times(1)
+			groupMgr.EXPECT().DeleteGroups(ctx, "test-1").Times(1).Return(nil)
+			kvCli.EXPECT().List(ctx, metadata.GetScheduledEventsKey("test-1")).Times(1).Return(nil, nil)
+			kvCli.EXPECT().List(ctx, metadata.GetSchemasKey("test-1")).Times(1).Return(nil, nil)
 
 			ctrl.eventBusMap["test-1"] = md
 			_, err := ctrl.DeleteEventBus(stdCtx.Background(), &metapb.EventBus{Name: "test-1"})
@@ -169,3 +197,152 @@ func TestController_DeleteEventBus(t *testing.T) {
 		})
 	})
 }
+
+func TestController_UpdateEventBus(t *testing.T) {
+	Convey("test update a eventbus", t, func() {
+		cfg := Config{}
+		ctrl := NewController(cfg, nil)
+		mockCtrl := gomock.NewController(t)
+		kvCli := kv.NewMockClient(mockCtrl)
+		ctrl.kvStore = kvCli
+		elMgr := eventlog.NewMockManager(mockCtrl)
+		ctrl.eventLogMgr = elMgr
+		ctx := stdCtx.Background()
+
+		md := &metadata.Eventbus{
+			ID:          vanus.NewTestID(),
+			Name:        "test-1",
+			Description: "desc",
+			EventLogs:   []*metadata.Eventlog{},
+		}
+		ctrl.eventBusMap["test-1"] = md
+
+		Convey("updating a doesn't exist eventbus", func() {
+			res, err := ctrl.UpdateEventBus(ctx, &ctrlpb.UpdateEventBusRequest{Name: "test-2"})
+			So(err, ShouldNotBeNil)
+			So(res, ShouldBeNil)
+			et, ok := err.(*errors.ErrorType)
+			So(ok, ShouldBeTrue)
+			So(et.Code, ShouldEqual, errors.ErrorCode_RESOURCE_NOT_FOUND)
+		})
+
+		Convey("updating with invalid retention", func() {
+			res, err := ctrl.UpdateEventBus(ctx, &ctrlpb.UpdateEventBusRequest{
+				Name:      "test-1",
+				Retention: &metapb.RetentionPolicy{MaxBytes: -1},
+			})
+			So(err, ShouldNotBeNil)
+			So(res, ShouldBeNil)
+			et, ok := err.(*errors.ErrorType)
+			So(ok, ShouldBeTrue)
+			So(et.Code, ShouldEqual, errors.ErrorCode_INVALID_REQUEST)
+		})
+
+		Convey("updating retention success", func() {
+			kvCli.EXPECT().Set(ctx, metadata.GetEventbusMetadataKey("test-1"), gomock.Any()).
+				Times(1).Return(nil)
+			elMgr.EXPECT().SetRetention(md.ID, gomock.Any()).Times(1).DoAndReturn(
+				func(_ vanus.ID, retention *metadata.Retention) {
+					So(retention.MaxAge, ShouldEqual, time.Hour)
+					So(retention.MaxEvents, ShouldEqual, 1000)
+				})
+			elMgr.EXPECT().SetCompression(md.ID, metapb.CompressAlgorithm_NONE).Times(1)
+			res, err := ctrl.UpdateEventBus(ctx, &ctrlpb.UpdateEventBusRequest{
+				Name: "test-1",
+				Retention: &metapb.RetentionPolicy{
+					MaxAge:    3600,
+					MaxEvents: 1000,
+				},
+			})
+			So(err, ShouldBeNil)
+			So(res.Description, ShouldEqual, "desc")
+			So(res.Retention.MaxAge, ShouldEqual, 3600)
+			So(res.Retention.MaxEvents, ShouldEqual, 1000)
+			So(ctrl.eventBusMap["test-1"].Retention.MaxAge, ShouldEqual, time.Hour)
+		})
+
+		Convey("updating compression success", func() {
+			kvCli.EXPECT().Set(ctx, metadata.GetEventbusMetadataKey("test-1"), gomock.Any()).
+				Times(1).Return(nil)
+			elMgr.EXPECT().SetRetention(md.ID, gomock.Nil()).Times(1)
+			elMgr.EXPECT().SetCompression(md.ID, metapb.CompressAlgorithm_LZ4).Times(1)
+			algorithm := metapb.CompressAlgorithm_LZ4
+			res, err := ctrl.UpdateEventBus(ctx, &ctrlpb.UpdateEventBusRequest{
+				Name:        "test-1",
+				Compression: &algorithm,
+			})
+			So(err, ShouldBeNil)
+			So(res.Compression, ShouldEqual, metapb.CompressAlgorithm_LZ4)
+			So(ctrl.eventBusMap["test-1"].Compression, ShouldEqual, metapb.CompressAlgorithm_LZ4)
+		})
+	})
+}
+
+func TestController_SegmentHeartbeat(t *testing.T) {
+	Convey("test the heartbeat of segment server", t, func() {
+		ctrl := NewController(Config{}, nil)
+		mockCtrl := gomock.NewController(t)
+		elMgr := eventlog.NewMockManager(mockCtrl)
+		ctrl.eventLogMgr = elMgr
+		ssMgr := server.NewMockManager(mockCtrl)
+		ctrl.ssMgr = ssMgr
+		volMgr := volume.NewMockManager(mockCtrl)
+		ctrl.volumeMgr = volMgr
+		ctx := stdCtx.Background()
+
+		mockMember := embedetcd.NewMockMember(mockCtrl)
+		ctrl.member = mockMember
+		mockMember.EXPECT().IsLeader().AnyTimes().Return(true)
+
+		ssMgr.EXPECT().GetServerByServerID(gomock.Any()).AnyTimes().Return(nil)
+		volMgr.EXPECT().GetVolumeInstanceByID(gomock.Any()).AnyTimes().Return(nil)
+		elMgr.EXPECT().MarkBlocksAlive(gomock.Any()).AnyTimes()
+
+		elID := vanus.NewTestID()
+		segID := vanus.NewTestID()
+		blocks := make([]*metadata.Block, 3)
+		for i := range blocks {
+			blocks[i] = &metadata.Block{ID: vanus.NewTestID(), EventlogID: elID, SegmentID: segID}
+			elMgr.EXPECT().GetBlock(blocks[i].ID).AnyTimes().Return(blocks[i])
+		}
+		leader := blocks[0].ID.Uint64()
+		heartbeat := func(tiers ...metapb.StorageTier) []eventlog.Segment {
+			req := &ctrlpb.SegmentHeartbeatRequest{
+				ServerId:   vanus.NewTestID().Uint64(),
+				VolumeId:   vanus.NewTestID().Uint64(),
+				ReportTime: util.FormatTime(time.Now()),
+			}
+			for i, blk := range blocks {
+				req.HealthInfo = append(req.HealthInfo, &metapb.SegmentHealthInfo{
+					Id:     blk.ID.Uint64(),
+					Leader: leader,
+					Size:   1024,
+					IsFull: true,
+					Tier:   tiers[i],
+				})
+			}
+			var segments []eventlog.Segment
+			elMgr.EXPECT().UpdateSegment(ctx, gomock.Any()).Times(1).Do(
+				func(ctx stdCtx.Context, m map[string][]eventlog.Segment) {
+					segments = m[elID.Key()]
+				})
+			So(ctrl.processHeartbeat(ctx, req), ShouldBeNil)
+			So(segments, ShouldHaveLength, len(blocks))
+			return segments
+		}
+
+		Convey("the follower has been offloaded before the leader", func() {
+			segments := heartbeat(metapb.StorageTier_SSD, metapb.StorageTier_S3, metapb.StorageTier_SSD)
+			So(segments[0].Tier, ShouldEqual, metapb.StorageTier_SSD)
+			So(segments[1].Tier, ShouldEqual, metapb.StorageTier_MEMORY)
+			So(segments[2].Tier, ShouldEqual, metapb.StorageTier_MEMORY)
+		})
+
+		Convey("the leader has been offloaded before the followers", func() {
+			segments := heartbeat(metapb.StorageTier_S3, metapb.StorageTier_SSD, metapb.StorageTier_SSD)
+			So(segments[0].Tier, ShouldEqual, metapb.StorageTier_S3)
+			So(segments[1].Tier, ShouldEqual, metapb.StorageTier_MEMORY)
+			So(segments[2].Tier, ShouldEqual, metapb.StorageTier_MEMORY)
+		})
+	})
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/linkall-labs/vanus/observability/metrics"
 	"github.com/linkall-labs/vanus/pkg/errors"
 	"github.com/linkall-labs/vanus/pkg/util"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
 	"github.com/linkall-labs/vanus/proto/pkg/segment"
 )
 
@@ -59,6 +60,11 @@ type Manager interface {
 	GetBlock(id vanus.ID) *metadata.Block
 	GetSegment(id vanus.ID) *Segment
 	UpdateSegmentReplicas(ctx context.Context, segID vanus.ID, term uint64) error
+	SetRetention(eventbusID vanus.ID, retention *metadata.Retention)
+	SetCompression(eventbusID vanus.ID, algorithm metapb.CompressAlgorithm)
+	MarkBlocksAlive(ids []vanus.ID)
+	DrainVolume(ctx context.Context, id vanus.ID) (*metadata.VolumeDrain, error)
+	GetVolumeDrain(id vanus.ID) *metadata.VolumeDrain
 }
 
 var mgr = &eventlogManager{
@@ -67,6 +73,12 @@ var mgr = &eventlogManager{
 	cleanInterval:               defaultCleanInterval,
 	checkSegmentExpiredInterval: defaultCheckExpiredSegmentInterval,
 	segmentExpiredTime:          defaultSegmentExpiredTime,
+	repairInterval:              defaultRepairInterval,
+	replicaLostTimeout:          defaultReplicaLostTimeout,
+	rebalance: RebalanceConfig{
+		Tolerance:     defaultRebalanceTolerance,
+		MaxMigrations: defaultMaxMigrations,
+	},
 }
 
 type eventlogManager struct {
@@ -92,14 +104,35 @@ type eventlogManager struct {
 	cleanInterval               time.Duration
 	checkSegmentExpiredInterval time.Duration
 	segmentExpiredTime          time.Duration
+	// eventbusID, *metadata.Retention
+	retentionMap sync.Map
+	// eventbusID, metapb.CompressAlgorithm
+	compressionMap sync.Map
+	offsetQuerier  OffsetQuerier
+	// blockID, time.Time
+	blockAliveTime     sync.Map
+	repairInterval     time.Duration
+	replicaLostTimeout time.Duration
+	// volumeID, *metadata.VolumeDrain
+	volumeDrains      sync.Map
+	rebalance         RebalanceConfig
+	nextMigrationTime time.Time
 }
 
-func NewManager(volMgr volume.Manager, replicaNum uint, defaultBlockSize int64) Manager {
+func NewManager(volMgr volume.Manager, replicaNum uint, defaultBlockSize int64,
+	placement block.PlacementConfig, rebalance RebalanceConfig) Manager {
 	mgr.volMgr = volMgr
 	if replicaNum > 0 {
 		mgr.segmentReplicaNum = replicaNum
 	}
-	mgr.allocator = block.NewAllocator(defaultBlockSize, block.NewVolumeRoundRobin(mgr.volMgr.GetAllActiveVolumes))
+	if rebalance.Tolerance <= 0 {
+		rebalance.Tolerance = defaultRebalanceTolerance
+	}
+	if rebalance.MaxMigrations <= 0 {
+		rebalance.MaxMigrations = defaultMaxMigrations
+	}
+	mgr.rebalance = rebalance
+	mgr.allocator = block.NewAllocator(defaultBlockSize, block.NewVolumeSelector(placement, mgr.placeableVolumes))
 	return mgr
 }
 
@@ -113,7 +146,19 @@ func (mgr *eventlogManager) Run(ctx context.Context, kvClient kv.Client, startTa
 	if mgr.cleanInterval == 0 {
 		mgr.cleanInterval = defaultCleanInterval
 	}
+	if mgr.repairInterval == 0 {
+		mgr.repairInterval = defaultRepairInterval
+	}
+	if mgr.replicaLostTimeout == 0 {
+		mgr.replicaLostTimeout = defaultReplicaLostTimeout
+	}
 	mgr.kvClient = kvClient
+	if mgr.offsetQuerier == nil {
+		mgr.offsetQuerier = newKVOffsetQuerier(kvClient)
+	}
+	if err := mgr.loadVolumeDrains(ctx); err != nil {
+		return err
+	}
 	if err := mgr.allocator.Run(ctx, mgr.kvClient, true); err != nil {
 		return err
 	}
@@ -142,6 +187,9 @@ func (mgr *eventlogManager) Run(ctx context.Context, kvClient kv.Client, startTa
 				for _, v := range seg.Replicas.Peers {
 					mgr.globalBlockMap.Store(v.ID.Key(), v)
 				}
+				for _, v := range seg.Replicas.Learners {
+					mgr.globalBlockMap.Store(v.ID.Key(), v)
+				}
 				seg = el.nextOf(seg)
 			}
 		}
@@ -153,6 +201,7 @@ func (mgr *eventlogManager) Run(ctx context.Context, kvClient kv.Client, startTa
 		go mgr.dynamicScaleUpEventLog(cancelCtx)
 		go mgr.cleanAbnormalSegment(cancelCtx)
 		go mgr.checkSegmentExpired(cancelCtx)
+		go mgr.repairReplicas(cancelCtx)
 	}
 	return nil
 }
@@ -524,7 +573,14 @@ func (mgr *eventlogManager) cleanAbnormalSegment(ctx context.Context) {
 					return true
 				}
 
+				blocks := make([]*metadata.Block, 0, len(v.Replicas.Peers)+len(v.Replicas.Learners))
 				for _, blk := range v.Replicas.Peers {
+					blocks = append(blocks, blk)
+				}
+				for _, blk := range v.Replicas.Learners {
+					blocks = append(blocks, blk)
+				}
+				for _, blk := range blocks {
 					ins := mgr.volMgr.GetVolumeInstanceByID(blk.VolumeID)
 					infos := map[string]interface{}{
 						"segment_id":   v.ID.Key(),
@@ -540,6 +596,7 @@ func (mgr *eventlogManager) cleanAbnormalSegment(ctx context.Context) {
 						continue
 					}
 					mgr.globalBlockMap.Delete(blk.ID.Key())
+					mgr.blockAliveTime.Delete(blk.ID.Key())
 					err = mgr.kvClient.Delete(ctx, metadata.GetBlockMetadataKey(blk.VolumeID, blk.ID))
 					if err != nil {
 						infos[log.KeyError] = err
@@ -585,15 +642,17 @@ func (mgr *eventlogManager) checkSegmentExpired(ctx context.Context) {
 		case <-ticker.C:
 			count := 0
 			executionID := uuid.NewString()
+			// loaded lazily, only when there is a segment need to be deleted
+			var committed *ConsumedOffsets
 			mgr.eventLogMap.Range(func(key, value interface{}) bool {
 				elog, _ := value.(*eventlog)
+				retention := mgr.getRetention(elog.md.EventbusID)
 				head := elog.head()
 				checkCtx := context.Background()
 				for head != nil {
-					switch {
-					case head.LastEventBornTime.Second() == 0:
+					if head.LastEventBornTime.Second() == 0 {
 						// TODO(wenfeng.wang) fix if set
-						head.LastEventBornTime = time.Now().Add(mgr.segmentExpiredTime)
+						head.LastEventBornTime = time.Now().Add(retention.MaxAge)
 						elog.lock()
 						if err := elog.updateSegment(checkCtx, head); err != nil {
 							log.Warning(ctx, "update segment's metadata failed", map[string]interface{}{
@@ -605,36 +664,62 @@ func (mgr *eventlogManager) checkSegmentExpired(ctx context.Context) {
 						}
 						elog.unlock()
 						return true
-					case !head.isFull():
+					}
+					if !head.isFull() {
+						return true
+					}
+					reason := elog.retentionExceeded(head, retention)
+					if reason == "" {
 						return true
-					case time.Since(head.LastEventBornTime.Add(mgr.segmentExpiredTime)) > 0:
-						err := elog.deleteHead(ctx)
-						if err != nil {
-							log.Warning(ctx, "delete segment error", map[string]interface{}{
-								log.KeyError:       err,
+					}
+					if !retention.AllowDeleteUnconsumed && mgr.offsetQuerier != nil {
+						if committed == nil {
+							var err error
+							if committed, err = mgr.offsetQuerier(checkCtx); err != nil {
+								log.Warning(ctx, "query committed offsets of subscriptions failed", map[string]interface{}{
+									log.KeyError:   err,
+									"execution_id": executionID,
+								})
+								return false
+							}
+						}
+						off, exist := committed.Min(elog.md)
+						if exist && off < uint64(head.StartOffsetInLog+int64(head.Number)) {
+							log.Debug(ctx, "the segment holds uncommitted events, skip deleting", map[string]interface{}{
 								"execution_id":     executionID,
-								"last_event_time":  head.LastEventBornTime,
-								"first_event_time": head.FirstEventBornTime,
-								"time":             time.Now(),
+								"log_id":           elog.md.ID.String(),
+								"segment_id":       head.ID.String(),
+								"committed_offset": off,
+								"reason":           reason,
 							})
 							return true
 						}
-						count++
-						log.Info(ctx, "delete segment success", map[string]interface{}{
+					}
+					err := elog.deleteHead(ctx)
+					if err != nil {
+						log.Warning(ctx, "delete segment error", map[string]interface{}{
+							log.KeyError:       err,
 							"execution_id":     executionID,
-							"log_id":           elog.md.ID.String(),
 							"last_event_time":  head.LastEventBornTime,
 							"first_event_time": head.FirstEventBornTime,
 							"time":             time.Now(),
-							"number":           head.Number,
 						})
-						_, ok := mgr.segmentNeedBeClean.LoadOrStore(head.ID.Key(), head)
-						if !ok {
-							metrics.SegmentDeletedCounterVec.WithLabelValues(metrics.LabelSegmentDeletedBecauseExpired).Add(1)
-						}
-					default:
 						return true
 					}
+					count++
+					log.Info(ctx, "delete segment success", map[string]interface{}{
+						"execution_id":     executionID,
+						"log_id":           elog.md.ID.String(),
+						"last_event_time":  head.LastEventBornTime,
+						"first_event_time": head.FirstEventBornTime,
+						"time":             time.Now(),
+						"number":           head.Number,
+						"reason":           reason,
+					})
+					_, ok := mgr.segmentNeedBeClean.LoadOrStore(head.ID.Key(), head)
+					if !ok {
+						metrics.SegmentDeletedCounterVec.WithLabelValues(reason).Add(1)
+					}
 					head = elog.head()
 				}
 				return true
@@ -647,6 +732,67 @@ func (mgr *eventlogManager) checkSegmentExpired(ctx context.Context) {
 	}
 }
 
+// SetCompression sets the algorithm of the segments which are created for the eventbus later.
+func (mgr *eventlogManager) SetCompression(eventbusID vanus.ID, algorithm metapb.CompressAlgorithm) {
+	if algorithm == metapb.CompressAlgorithm_NONE {
+		mgr.compressionMap.Delete(eventbusID.Key())
+		return
+	}
+	mgr.compressionMap.Store(eventbusID.Key(), algorithm)
+}
+
+func (mgr *eventlogManager) getCompression(eventbusID vanus.ID) metapb.CompressAlgorithm {
+	if v, exist := mgr.compressionMap.Load(eventbusID.Key()); exist {
+		return v.(metapb.CompressAlgorithm)
+	}
+	return metapb.CompressAlgorithm_NONE
+}
+
+// setBlockCompression tells the replica the algorithm of segment, any replica may become leader later
+// and compress the entries appended to it.
+func (mgr *eventlogManager) setBlockCompression(
+	ctx context.Context, blk *metadata.Block, algorithm metapb.CompressAlgorithm,
+) error {
+	if algorithm == metapb.CompressAlgorithm_NONE {
+		return nil
+	}
+	cli, err := mgr.blockServerClient(blk)
+	if err != nil {
+		return err
+	}
+	_, err = cli.SetBlockCompression(ctx, &segment.SetBlockCompressionRequest{
+		BlockId:     blk.ID.Uint64(),
+		Compression: algorithm,
+	})
+	return err
+}
+
+// setBlockSegment binds the replica to segment, the replicas of segment share one object after
+// they are offloaded to tiered storage.
+func (mgr *eventlogManager) setBlockSegment(ctx context.Context, blk *metadata.Block, segID vanus.ID) error {
+	cli, err := mgr.blockServerClient(blk)
+	if err != nil {
+		return err
+	}
+	_, err = cli.SetBlockSegment(ctx, &segment.SetBlockSegmentRequest{
+		BlockId:   blk.ID.Uint64(),
+		SegmentId: segID.Uint64(),
+	})
+	return err
+}
+
+func (mgr *eventlogManager) blockServerClient(blk *metadata.Block) (segment.SegmentServerClient, error) {
+	ins := mgr.volMgr.GetVolumeInstanceByID(blk.VolumeID)
+	if ins == nil {
+		return nil, errors.ErrVolumeInstanceNotFound
+	}
+	srv := ins.GetServer()
+	if srv == nil {
+		return nil, errors.ErrVolumeInstanceNoServer
+	}
+	return srv.GetClient(), nil
+}
+
 func (mgr *eventlogManager) createSegment(ctx context.Context, el *eventlog) (*Segment, error) {
 	seg, err := mgr.generateSegment(ctx)
 	defer func() {
@@ -664,6 +810,15 @@ func (mgr *eventlogManager) createSegment(ctx context.Context, el *eventlog) (*S
 		return nil, err
 	}
 	seg.EventLogID = el.md.ID
+	seg.Compression = mgr.getCompression(el.md.EventbusID)
+	for _, blk := range seg.Replicas.Peers {
+		if err = mgr.setBlockSegment(ctx, blk, seg.ID); err != nil {
+			return nil, err
+		}
+		if err = mgr.setBlockCompression(ctx, blk, seg.Compression); err != nil {
+			return nil, err
+		}
+	}
 
 	for i := range seg.Replicas.Peers {
 		seg.Replicas.Leader = i
@@ -676,6 +831,7 @@ func (mgr *eventlogManager) createSegment(ctx context.Context, el *eventlog) (*S
 			EventLogId:     seg.EventLogID.Uint64(),
 			ReplicaGroupId: seg.Replicas.ID.Uint64(),
 			Replicas:       mgr.getSegmentTopology(ctx, seg),
+			Compression:    seg.Compression,
 		})
 		if err == nil {
 			break
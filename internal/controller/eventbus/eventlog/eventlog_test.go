@@ -27,12 +27,16 @@ import (
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/server"
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/volume"
+	trigger "github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
 	"github.com/linkall-labs/vanus/internal/kv"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/pkg/errors"
 	"github.com/linkall-labs/vanus/pkg/util"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
 	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
 	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func TestEventlogManager_RunWithoutTask(t *testing.T) {
@@ -112,7 +116,7 @@ func TestEventlogManager_RunWithoutTask(t *testing.T) {
 				},
 			}
 
-			kvCli.EXPECT().List(gomock.Any(), gomock.Any()).Times(4).DoAndReturn(func(
+			kvCli.EXPECT().List(gomock.Any(), gomock.Any()).Times(5).DoAndReturn(func(
 				ctx stdCtx.Context, path string) ([]kv.Pair, error) {
 				if path == metadata.EventlogKeyPrefixInKVStore {
 					return elPairs, nil
@@ -215,12 +219,13 @@ func TestEventlogManager_ScaleSegmentTask(t *testing.T) {
 		grpcCli := segpb.NewMockSegmentServerClient(ctrl)
 		srv.EXPECT().GetClient().AnyTimes().Return(grpcCli)
 		grpcCli.EXPECT().ActivateSegment(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
+		grpcCli.EXPECT().SetBlockSegment(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
 
 		utMgr.scaleInterval = 5 * time.Millisecond
 		// suspend those tasks
 		utMgr.cleanInterval = time.Hour
 		utMgr.checkSegmentExpiredInterval = time.Hour
-		kvCli.EXPECT().List(gomock.Any(), gomock.Any()).Times(1).Return([]kv.Pair{}, nil)
+		kvCli.EXPECT().List(gomock.Any(), gomock.Any()).Times(2).Return([]kv.Pair{}, nil)
 		err := utMgr.Run(ctx, kvCli, true)
 		So(err, ShouldBeNil)
 		md1 := &metadata.Eventlog{
@@ -323,11 +328,12 @@ func TestEventlogManager_CleanSegmentTask(t *testing.T) {
 		grpcCli := segpb.NewMockSegmentServerClient(ctrl)
 		srv.EXPECT().GetClient().AnyTimes().Return(grpcCli)
 		grpcCli.EXPECT().ActivateSegment(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
+		grpcCli.EXPECT().SetBlockSegment(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
 
 		utMgr.scaleInterval = 5 * time.Millisecond
 		utMgr.cleanInterval = 5 * time.Millisecond
 		utMgr.checkSegmentExpiredInterval = time.Hour
-		kvCli.EXPECT().List(gomock.Any(), gomock.Any()).Times(1).Return([]kv.Pair{}, nil)
+		kvCli.EXPECT().List(gomock.Any(), gomock.Any()).Times(2).Return([]kv.Pair{}, nil)
 		err := utMgr.Run(ctx, kvCli, true)
 		So(err, ShouldBeNil)
 		md1 := &metadata.Eventlog{
@@ -409,13 +415,14 @@ func TestEventlogManager_CreateAndGetEventlog(t *testing.T) {
 		})
 
 		volIns := server.NewMockInstance(ctrl)
-		volMgr.EXPECT().GetVolumeInstanceByID(vol1.ID).Times(8).Return(volIns)
+		volMgr.EXPECT().GetVolumeInstanceByID(vol1.ID).Times(14).Return(volIns)
 		srv := server.NewMockServer(ctrl)
-		volIns.EXPECT().GetServer().Times(2).Return(srv)
+		volIns.EXPECT().GetServer().Times(8).Return(srv)
 		volIns.EXPECT().Address().Times(6).Return("127.0.0.1:10001")
 		grpcCli := segpb.NewMockSegmentServerClient(ctrl)
-		srv.EXPECT().GetClient().Times(2).Return(grpcCli)
+		srv.EXPECT().GetClient().Times(8).Return(grpcCli)
 		grpcCli.EXPECT().ActivateSegment(ctx, gomock.Any()).Times(2).Return(nil, nil)
+		grpcCli.EXPECT().SetBlockSegment(ctx, gomock.Any()).Times(6).Return(&emptypb.Empty{}, nil)
 
 		eventbusID := vanus.NewTestID()
 		logMD, err := utMgr.AcquireEventLog(ctx, eventbusID)
@@ -556,19 +563,38 @@ func TestEventlogManager_GetAppendableSegment(t *testing.T) {
 		})
 
 		volIns := server.NewMockInstance(ctrl)
-		volMgr.EXPECT().GetVolumeInstanceByID(vol1.ID).Times(4).Return(volIns)
+		volMgr.EXPECT().GetVolumeInstanceByID(vol1.ID).Times(10).Return(volIns)
 		srv := server.NewMockServer(ctrl)
-		volIns.EXPECT().GetServer().Times(1).Return(srv)
+		volIns.EXPECT().GetServer().Times(7).Return(srv)
 		volIns.EXPECT().Address().Times(3).Return("127.0.0.1:10001")
 		grpcCli := segpb.NewMockSegmentServerClient(ctrl)
-		srv.EXPECT().GetClient().Times(1).Return(grpcCli)
-		grpcCli.EXPECT().ActivateSegment(ctx, gomock.Any()).Times(1).Return(nil, nil)
+		srv.EXPECT().GetClient().Times(7).Return(grpcCli)
+		bound := map[uint64]uint64{}
+		grpcCli.EXPECT().SetBlockSegment(ctx, gomock.Any()).Times(3).DoAndReturn(func(ctx stdCtx.Context,
+			req *segpb.SetBlockSegmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+			bound[req.BlockId] = req.SegmentId
+			return &emptypb.Empty{}, nil
+		})
+		compressed := map[uint64]bool{}
+		grpcCli.EXPECT().SetBlockCompression(ctx, gomock.Any()).Times(3).DoAndReturn(func(ctx stdCtx.Context,
+			req *segpb.SetBlockCompressionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+			So(req.Compression, ShouldEqual, metapb.CompressAlgorithm_LZ4)
+			compressed[req.BlockId] = true
+			return &emptypb.Empty{}, nil
+		})
+		grpcCli.EXPECT().ActivateSegment(ctx, gomock.Any()).Times(1).DoAndReturn(func(ctx stdCtx.Context,
+			req *segpb.ActivateSegmentRequest, opts ...grpc.CallOption) (*segpb.ActivateSegmentResponse, error) {
+			So(req.Compression, ShouldEqual, metapb.CompressAlgorithm_LZ4)
+			return nil, nil
+		})
 
 		Convey("case: no segment", func() {
 			md := &metadata.Eventlog{
 				ID:         vanus.NewTestID(),
 				EventbusID: vanus.NewTestID(),
 			}
+			mgr.SetCompression(md.EventbusID, metapb.CompressAlgorithm_LZ4)
+			defer mgr.SetCompression(md.EventbusID, metapb.CompressAlgorithm_NONE)
 
 			el, err := newEventlog(ctx, md, kvCli, false)
 			So(err, ShouldBeNil)
@@ -576,6 +602,13 @@ func TestEventlogManager_GetAppendableSegment(t *testing.T) {
 			segs, err := mgr.GetAppendableSegment(ctx, md, 1)
 			So(err, ShouldBeNil)
 			So(segs, ShouldHaveLength, 1)
+			So(segs[0].Compression, ShouldEqual, metapb.CompressAlgorithm_LZ4)
+			So(compressed, ShouldHaveLength, 3)
+			So(bound, ShouldHaveLength, 3)
+			for id := range segs[0].Replicas.Peers {
+				So(compressed[id], ShouldBeTrue)
+				So(bound[id], ShouldEqual, segs[0].ID.Uint64())
+			}
 		})
 	})
 }
@@ -751,6 +784,181 @@ func TestEventlogManager_UpdateSegmentReplicas(t *testing.T) {
 	})
 }
 
+func TestEventlogManager_RepairSegment(t *testing.T) {
+	Convey("test repair lost replicas of segment", t, func() {
+		utMgr := &eventlogManager{segmentReplicaNum: 3, replicaLostTimeout: time.Minute}
+		ctrl := gomock.NewController(t)
+		volMgr := volume.NewMockManager(ctrl)
+		utMgr.volMgr = volMgr
+		kvCli := kv.NewMockClient(ctrl)
+		utMgr.kvClient = kvCli
+		alloc := block.NewMockAllocator(ctrl)
+		utMgr.allocator = alloc
+		ctx := stdCtx.Background()
+
+		vols := make([]vanus.ID, 4)
+		for i := range vols {
+			vols[i] = vanus.NewTestID()
+		}
+		blocks := make([]*metadata.Block, 3)
+		peers := map[uint64]*metadata.Block{}
+		for i := range blocks {
+			blocks[i] = &metadata.Block{ID: vanus.NewTestID(), VolumeID: vols[i]}
+			peers[blocks[i].ID.Uint64()] = blocks[i]
+			utMgr.globalBlockMap.Store(blocks[i].ID.Key(), blocks[i])
+		}
+		seg := &Segment{
+			ID:         vanus.NewTestID(),
+			EventLogID: vanus.NewTestID(),
+			Replicas: &ReplicaGroup{
+				ID:     vanus.NewTestID(),
+				Leader: blocks[0].ID.Uint64(),
+				Peers:  peers,
+			},
+		}
+		now := time.Now()
+		utMgr.MarkBlocksAlive([]vanus.ID{blocks[0].ID, blocks[1].ID, blocks[2].ID})
+
+		leaderIns := server.NewMockInstance(ctrl)
+		srv := server.NewMockServer(ctrl)
+		grpcCli := segpb.NewMockSegmentServerClient(ctrl)
+		leaderIns.EXPECT().GetServer().AnyTimes().Return(srv)
+		srv.EXPECT().GetClient().AnyTimes().Return(grpcCli)
+		learnerIns := server.NewMockInstance(ctrl)
+		learnerIns.EXPECT().Address().AnyTimes().Return("127.0.0.1:10004")
+		learnerIns.EXPECT().GetServer().AnyTimes().Return(srv)
+		bound := map[uint64]uint64{}
+		grpcCli.EXPECT().SetBlockSegment(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+			func(ctx stdCtx.Context, req *segpb.SetBlockSegmentRequest,
+				opts ...grpc.CallOption) (*emptypb.Empty, error) {
+				bound[req.BlockId] = req.SegmentId
+				return &emptypb.Empty{}, nil
+			})
+		lostIns := server.NewMockInstance(ctrl)
+		volMgr.EXPECT().GetVolumeInstanceByID(vols[0]).AnyTimes().Return(leaderIns)
+		volMgr.EXPECT().GetVolumeInstanceByID(vols[2]).AnyTimes().Return(lostIns)
+		volMgr.EXPECT().GetVolumeInstanceByID(vols[3]).AnyTimes().Return(learnerIns)
+
+		Convey("all replicas are alive", func() {
+			err := utMgr.repairSegment(ctx, seg, now.Add(30*time.Second))
+			So(err, ShouldBeNil)
+			So(seg.Replicas.Learners, ShouldBeEmpty)
+		})
+
+		Convey("the leader is lost", func() {
+			utMgr.blockAliveTime.Store(blocks[0].ID.Key(), now.Add(-2*time.Minute))
+			err := utMgr.repairSegment(ctx, seg, now)
+			So(err, ShouldBeNil)
+			So(seg.Replicas.Learners, ShouldBeEmpty)
+		})
+
+		Convey("the majority of replicas is lost", func() {
+			utMgr.blockAliveTime.Store(blocks[1].ID.Key(), now.Add(-2*time.Minute))
+			utMgr.blockAliveTime.Store(blocks[2].ID.Key(), now.Add(-2*time.Minute))
+			err := utMgr.repairSegment(ctx, seg, now)
+			So(errors.Is(err, errors.ErrInvalidSegment), ShouldBeTrue)
+		})
+
+		Convey("the learner is told the compression of segment", func() {
+			seg.Compression = metapb.CompressAlgorithm_ZSTD
+			utMgr.blockAliveTime.Store(blocks[2].ID.Key(), now.Add(-2*time.Minute))
+			learner := &metadata.Block{ID: vanus.NewTestID(), VolumeID: vols[3]}
+			alloc.EXPECT().PickExcluding(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(learner, nil)
+			grpcCli.EXPECT().SetBlockCompression(gomock.Any(), &segpb.SetBlockCompressionRequest{
+				BlockId:     learner.ID.Uint64(),
+				Compression: metapb.CompressAlgorithm_ZSTD,
+			}).Times(1).Return(&emptypb.Empty{}, nil)
+			kvCli.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+			grpcCli.EXPECT().ChangeMembership(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+				func(ctx stdCtx.Context, req *segpb.ChangeMembershipRequest,
+					opts ...grpc.CallOption) (*emptypb.Empty, error) {
+					if req.Type == segpb.MembershipChangeType_PROMOTE_LEARNER {
+						return nil, errors.ErrLearnerNotCaughtUp
+					}
+					return nil, nil
+				})
+
+			err := utMgr.repairSegment(ctx, seg, now)
+			So(err, ShouldBeNil)
+			So(seg.Replicas.Learners, ShouldHaveLength, 1)
+		})
+
+		Convey("replace the lost replica", func() {
+			utMgr.blockAliveTime.Store(blocks[2].ID.Key(), now.Add(-2*time.Minute))
+			learner := &metadata.Block{ID: vanus.NewTestID(), VolumeID: vols[3]}
+			alloc.EXPECT().PickExcluding(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+				func(ctx stdCtx.Context, peers, excluded []vanus.ID) (*metadata.Block, error) {
+					So(peers, ShouldHaveLength, 2)
+					So(peers, ShouldNotContain, vols[2])
+					So(excluded, ShouldResemble, []vanus.ID{vols[2]})
+					return learner, nil
+				})
+			kvCli.EXPECT().Set(gomock.Any(), metadata.GetBlockMetadataKey(vols[3], learner.ID),
+				gomock.Any()).Times(1).Return(nil)
+			kvCli.EXPECT().Set(gomock.Any(), metadata.GetSegmentMetadataKey(seg.ID),
+				gomock.Any()).AnyTimes().Return(nil)
+
+			caughtUp := false
+			changes := make([]segpb.MembershipChangeType, 0)
+			grpcCli.EXPECT().ChangeMembership(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+				func(ctx stdCtx.Context, req *segpb.ChangeMembershipRequest,
+					opts ...grpc.CallOption) (*emptypb.Empty, error) {
+					So(req.BlockId, ShouldEqual, blocks[0].ID.Uint64())
+					changes = append(changes, req.Type)
+					switch req.Type {
+					case segpb.MembershipChangeType_ADD_LEARNER:
+						So(req.PeerId, ShouldEqual, learner.ID.Uint64())
+						So(req.Endpoint, ShouldEqual, "127.0.0.1:10004")
+					case segpb.MembershipChangeType_PROMOTE_LEARNER:
+						So(req.PeerId, ShouldEqual, learner.ID.Uint64())
+						if !caughtUp {
+							return nil, errors.ErrLearnerNotCaughtUp
+						}
+					case segpb.MembershipChangeType_REMOVE_PEER:
+						So(req.PeerId, ShouldEqual, blocks[2].ID.Uint64())
+					}
+					return nil, nil
+				})
+
+			err := utMgr.repairSegment(ctx, seg, now)
+			So(err, ShouldBeNil)
+			So(changes, ShouldResemble, []segpb.MembershipChangeType{
+				segpb.MembershipChangeType_ADD_LEARNER,
+				segpb.MembershipChangeType_PROMOTE_LEARNER,
+			})
+			So(seg.Replicas.Learners, ShouldHaveLength, 1)
+			So(seg.Replicas.Peers, ShouldHaveLength, 3)
+			So(learner.SegmentID, ShouldEqual, seg.ID)
+			So(bound[learner.ID.Uint64()], ShouldEqual, seg.ID.Uint64())
+			So(utMgr.GetBlock(learner.ID), ShouldEqual, learner)
+
+			caughtUp = true
+			changes = changes[:0]
+			kvCli.EXPECT().Delete(gomock.Any(), metadata.GetBlockMetadataKey(vols[2], blocks[2].ID)).
+				Times(1).Return(nil)
+			lostIns.EXPECT().RemoveReplica(gomock.Any(), blocks[2].ID).Times(1).Return(errors.ErrVolumeInstanceNoServer)
+			err = utMgr.repairSegment(ctx, seg, now)
+			So(err, ShouldBeNil)
+			So(changes, ShouldResemble, []segpb.MembershipChangeType{
+				segpb.MembershipChangeType_ADD_LEARNER,
+				segpb.MembershipChangeType_PROMOTE_LEARNER,
+				segpb.MembershipChangeType_REMOVE_PEER,
+			})
+			So(seg.Replicas.Learners, ShouldBeEmpty)
+			So(seg.Replicas.Peers, ShouldHaveLength, 3)
+			So(seg.Replicas.Peers[learner.ID.Uint64()], ShouldEqual, learner)
+			So(seg.Replicas.Peers[blocks[2].ID.Uint64()], ShouldBeNil)
+			So(utMgr.GetBlock(blocks[2].ID), ShouldBeNil)
+
+			// nothing to do after replaced.
+			changes = changes[:0]
+			err = utMgr.repairSegment(ctx, seg, now)
+			So(err, ShouldBeNil)
+			So(changes, ShouldBeEmpty)
+		})
+	})
+}
+
 func Test_ExpiredSegmentDeleting(t *testing.T) {
 	Convey("test expired segment deleting", t, func() {
 		ctrl := gomock.NewController(t)
@@ -947,6 +1155,172 @@ func Test_ExpiredSegmentDeleting(t *testing.T) {
 	})
 }
 
+func Test_RetentionSegmentDeleting(t *testing.T) {
+	Convey("test deleting segment by retention of eventbus", t, func() {
+		ctrl := gomock.NewController(t)
+		kvCli := kv.NewMockClient(ctrl)
+		ctx := stdCtx.Background()
+		utMgr := &eventlogManager{
+			segmentReplicaNum:           3,
+			checkSegmentExpiredInterval: 100 * time.Millisecond,
+			segmentExpiredTime:          time.Hour,
+		}
+		kvCli.EXPECT().Delete(gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+		kvCli.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+
+		el, err := newEventlog(ctx, &metadata.Eventlog{
+			ID:         vanus.NewTestID(),
+			EventbusID: vanus.NewTestID(),
+		}, kvCli, false)
+		So(err, ShouldBeNil)
+		utMgr.eventLogMap.Store(el.md.ID.Key(), el)
+
+		// avoid the second of LastEventBornTime is zero
+		bornTime := time.Now().Truncate(time.Minute).Add(-30 * time.Second)
+		segments := make([]*Segment, 4)
+		for idx := range segments {
+			segments[idx] = &Segment{
+				ID:                 vanus.NewTestID(),
+				StartOffsetInLog:   int64(idx * 100),
+				Size:               1000,
+				Number:             100,
+				FirstEventBornTime: bornTime,
+				LastEventBornTime:  bornTime,
+				State:              StateFrozen,
+			}
+			el.segmentList.Set(segments[idx].ID.Uint64(), segments[idx])
+			el.segments = append(el.segments, segments[idx].ID)
+		}
+		segments[3].State = StateWorking
+
+		run := func() {
+			cCtx, cancel := stdCtx.WithCancel(ctx)
+			ch := make(chan struct{})
+			go func() {
+				utMgr.checkSegmentExpired(cCtx)
+				ch <- struct{}{}
+			}()
+			time.Sleep(500 * time.Millisecond)
+			cancel()
+			<-ch
+		}
+
+		Convey("test without retention", func() {
+			run()
+			So(el.segmentList.Len(), ShouldEqual, 4)
+		})
+
+		Convey("test max bytes", func() {
+			utMgr.SetRetention(el.md.EventbusID, &metadata.Retention{MaxBytes: 2500})
+			run()
+			So(el.segmentList.Len(), ShouldEqual, 2)
+			So(el.head().ID, ShouldEqual, segments[2].ID)
+			So(util.MapLen(&utMgr.segmentNeedBeClean), ShouldEqual, 2)
+		})
+
+		Convey("test max events", func() {
+			utMgr.SetRetention(el.md.EventbusID, &metadata.Retention{MaxEvents: 350})
+			run()
+			So(el.segmentList.Len(), ShouldEqual, 3)
+			So(el.head().ID, ShouldEqual, segments[1].ID)
+		})
+
+		Convey("test uncommitted segment isn't deleted", func() {
+			subID := vanus.NewTestID().Uint64()
+			utMgr.offsetQuerier = func(ctx stdCtx.Context) (*ConsumedOffsets, error) {
+				return &ConsumedOffsets{
+					subscriptions: map[string][]uint64{el.md.Eventbus(): {subID}},
+					offsets:       map[uint64]map[uint64]uint64{subID: {el.md.ID.Uint64(): 150}},
+				}, nil
+			}
+			utMgr.SetRetention(el.md.EventbusID, &metadata.Retention{MaxEvents: 100})
+			run()
+			So(el.segmentList.Len(), ShouldEqual, 3)
+			So(el.head().ID, ShouldEqual, segments[1].ID)
+
+			Convey("test allow deleting unconsumed segment", func() {
+				utMgr.SetRetention(el.md.EventbusID, &metadata.Retention{
+					MaxEvents:             100,
+					AllowDeleteUnconsumed: true,
+				})
+				run()
+				So(el.segmentList.Len(), ShouldEqual, 1)
+				So(el.head().ID, ShouldEqual, segments[3].ID)
+			})
+		})
+
+		Convey("test subscription without committed offset", func() {
+			utMgr.offsetQuerier = func(ctx stdCtx.Context) (*ConsumedOffsets, error) {
+				return &ConsumedOffsets{
+					subscriptions: map[string][]uint64{el.md.Eventbus(): {vanus.NewTestID().Uint64()}},
+					offsets:       map[uint64]map[uint64]uint64{},
+				}, nil
+			}
+			utMgr.SetRetention(el.md.EventbusID, &metadata.Retention{MaxEvents: 100})
+			run()
+			So(el.segmentList.Len(), ShouldEqual, 4)
+		})
+
+		Convey("test eventbus without subscription", func() {
+			utMgr.offsetQuerier = func(ctx stdCtx.Context) (*ConsumedOffsets, error) {
+				return &ConsumedOffsets{}, nil
+			}
+			utMgr.SetRetention(el.md.EventbusID, &metadata.Retention{MaxEvents: 100})
+			run()
+			So(el.segmentList.Len(), ShouldEqual, 1)
+		})
+
+		Convey("test query offset failed", func() {
+			utMgr.offsetQuerier = func(ctx stdCtx.Context) (*ConsumedOffsets, error) {
+				return nil, kv.ErrUnknown
+			}
+			utMgr.SetRetention(el.md.EventbusID, &metadata.Retention{MaxBytes: 1000})
+			run()
+			So(el.segmentList.Len(), ShouldEqual, 4)
+		})
+	})
+}
+
+func TestNewKVOffsetQuerier(t *testing.T) {
+	Convey("test query committed offset from kv", t, func() {
+		ctrl := gomock.NewController(t)
+		kvCli := kv.NewMockClient(ctrl)
+		ctx := stdCtx.Background()
+		el1 := &metadata.Eventlog{ID: vanus.NewTestID(), EventbusName: "bus1"}
+		el2 := &metadata.Eventlog{ID: vanus.NewTestID(), EventbusName: "bus2"}
+		el3 := &metadata.Eventlog{ID: vanus.NewTestID(), EventbusName: "bus3"}
+		sub1, sub2, sub3, sub4 := vanus.NewTestID(), vanus.NewTestID(), vanus.NewTestID(), vanus.NewTestID()
+		subscription := func(id vanus.ID, eventbus string, phase trigger.SubscriptionPhase) kv.Pair {
+			data, _ := stdJson.Marshal(&trigger.Subscription{ID: id, EventBus: eventbus, Phase: phase})
+			return kv.Pair{Key: filepath.Join("/trigger/subscriptions", id.String()), Value: data}
+		}
+		kvCli.EXPECT().List(ctx, "/trigger/subscriptions/").Times(1).Return([]kv.Pair{
+			subscription(sub1, "bus1", trigger.SubscriptionPhaseRunning),
+			subscription(sub2, "bus1", trigger.SubscriptionPhaseRunning),
+			subscription(sub3, "bus2", trigger.SubscriptionPhaseCreated),
+			subscription(sub4, "bus3", trigger.SubscriptionPhaseToDelete),
+		}, nil)
+		kvCli.EXPECT().List(ctx, "/trigger/offsets/").Times(1).Return([]kv.Pair{
+			{Key: filepath.Join("/trigger/offsets", sub1.String(), el1.ID.String()), Value: []byte("100")},
+			{Key: filepath.Join("/trigger/offsets", sub2.String(), el1.ID.String()), Value: []byte("50")},
+			{Key: filepath.Join("/trigger/offsets", sub4.String(), el3.ID.String()), Value: []byte("20")},
+			{Key: filepath.Join("/trigger/offsets", sub1.String(), "invalid"), Value: []byte("20")},
+		}, nil)
+		offsets, err := newKVOffsetQuerier(kvCli)(ctx)
+		So(err, ShouldBeNil)
+		off, exist := offsets.Min(el1)
+		So(exist, ShouldBeTrue)
+		So(off, ShouldEqual, 50)
+		// the subscription hasn't committed offset yet.
+		off, exist = offsets.Min(el2)
+		So(exist, ShouldBeTrue)
+		So(off, ShouldEqual, 0)
+		// the subscription is being deleted.
+		_, exist = offsets.Min(el3)
+		So(exist, ShouldBeFalse)
+	})
+}
+
 func TestEventlog_All(t *testing.T) {
 	Convey("test eventlog operation", t, func() {
 		ctrl := gomock.NewController(t)
@@ -0,0 +1,325 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlog
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/server"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+)
+
+const (
+	defaultRebalanceTolerance = 0.1
+	defaultMaxMigrations      = 4
+)
+
+type RebalanceConfig struct {
+	// Enable starts moving replicas off the volumes which are much more used than the others, the
+	// draining volumes are migrated regardless of it.
+	Enable bool `yaml:"enable"`
+	// Tolerance is how much the usage ratio of a volume may exceed the average of the cluster
+	// before its replicas are moved.
+	Tolerance float64 `yaml:"tolerance"`
+	// Bandwidth is the bytes per second copied by migrations, 0 means unlimited. A migration is
+	// started after the data of the previous ones could have been copied at this rate.
+	Bandwidth int64 `yaml:"bandwidth"`
+	// MaxMigrations is the max number of replicas being migrated at the same time.
+	MaxMigrations int `yaml:"max_migrations"`
+}
+
+// DrainVolume starts migrating all replicas off the volume, and no new block is placed on it.
+// It's a no-op if the volume has been drained or is draining.
+func (mgr *eventlogManager) DrainVolume(ctx context.Context, id vanus.ID) (*metadata.VolumeDrain, error) {
+	if drain := mgr.GetVolumeDrain(id); drain != nil {
+		return drain, nil
+	}
+	if mgr.volMgr.GetVolumeInstanceByID(id) == nil {
+		return nil, errors.ErrVolumeInstanceNotFound
+	}
+
+	total := mgr.countReplicas(id)
+	drain := &metadata.VolumeDrain{
+		VolumeID:    id,
+		State:       metadata.VolumeDraining,
+		TotalBlocks: total,
+		StartTime:   time.Now(),
+	}
+	if err := mgr.saveVolumeDrain(ctx, drain); err != nil {
+		return nil, err
+	}
+	log.Info(ctx, "start draining volume", map[string]interface{}{
+		"volume_id": id.Key(),
+		"blocks":    total,
+	})
+	drain.RemainingBlocks = total
+	return drain, nil
+}
+
+// GetVolumeDrain returns the progress of draining volume, or nil if the volume isn't drained.
+func (mgr *eventlogManager) GetVolumeDrain(id vanus.ID) *metadata.VolumeDrain {
+	v, ok := mgr.volumeDrains.Load(id.Key())
+	if !ok {
+		return nil
+	}
+	drain := *(v.(*metadata.VolumeDrain))
+	drain.RemainingBlocks = mgr.countReplicas(id)
+	return &drain
+}
+
+func (mgr *eventlogManager) loadVolumeDrains(ctx context.Context) error {
+	pairs, err := mgr.kvClient.List(ctx, metadata.VolumeDrainKeyPrefixInKVStore)
+	if err != nil {
+		return err
+	}
+	mgr.volumeDrains.Range(func(key, _ interface{}) bool {
+		mgr.volumeDrains.Delete(key)
+		return true
+	})
+	for _, pair := range pairs {
+		drain := &metadata.VolumeDrain{}
+		if err = json.Unmarshal(pair.Value, drain); err != nil {
+			return err
+		}
+		mgr.volumeDrains.Store(drain.VolumeID.Key(), drain)
+	}
+	return nil
+}
+
+// saveVolumeDrain replaces the drain instead of modifying it, so that readers never see a drain
+// being modified.
+func (mgr *eventlogManager) saveVolumeDrain(ctx context.Context, drain *metadata.VolumeDrain) error {
+	data, _ := json.Marshal(drain)
+	if err := mgr.kvClient.Set(ctx, metadata.GetVolumeDrainKey(drain.VolumeID), data); err != nil {
+		return err
+	}
+	mgr.volumeDrains.Store(drain.VolumeID.Key(), drain)
+	return nil
+}
+
+// placeableVolumes returns the active volumes which aren't drained, new blocks are only placed on
+// them.
+func (mgr *eventlogManager) placeableVolumes() []server.Instance {
+	volumes := mgr.volMgr.GetAllActiveVolumes()
+	placeable := make([]server.Instance, 0, len(volumes))
+	for _, ins := range volumes {
+		if _, drained := mgr.volumeDrains.Load(ins.ID().Key()); !drained {
+			placeable = append(placeable, ins)
+		}
+	}
+	return placeable
+}
+
+func (mgr *eventlogManager) countReplicas(volumeID vanus.ID) int {
+	count := 0
+	mgr.globalSegmentMap.Range(func(key, value interface{}) bool {
+		seg, ok := value.(*Segment)
+		if !ok || seg.Replicas == nil {
+			return true
+		}
+		if _, deleted := mgr.segmentNeedBeClean.Load(key); deleted {
+			return true
+		}
+		if seg.replicaOn(volumeID) != nil {
+			count++
+		}
+		for _, blk := range seg.Replicas.Learners {
+			if blk.VolumeID == volumeID {
+				count++
+			}
+		}
+		return true
+	})
+	return count
+}
+
+// migrateReplicas starts migrating replicas off the draining volumes, and off the most used volume
+// if rebalancing is enabled. The migrations in progress are continued by repairSegment.
+func (mgr *eventlogManager) migrateReplicas(ctx context.Context, now time.Time) {
+	inflight := 0
+	idle := make([]*Segment, 0)
+	mgr.globalSegmentMap.Range(func(key, value interface{}) bool {
+		seg, ok := value.(*Segment)
+		if !ok || !seg.isReady() {
+			return true
+		}
+		if _, deleted := mgr.segmentNeedBeClean.Load(key); deleted {
+			return true
+		}
+		if seg.Replicas.Evicting != 0 || len(seg.Replicas.Learners) != 0 {
+			inflight++
+		} else if len(mgr.lostPeers(seg, now)) == 0 {
+			idle = append(idle, seg)
+		}
+		return true
+	})
+
+	mgr.volumeDrains.Range(func(_, value interface{}) bool {
+		drain, _ := value.(*metadata.VolumeDrain)
+		if drain.State != metadata.VolumeDraining {
+			return true
+		}
+		if mgr.countReplicas(drain.VolumeID) == 0 {
+			mgr.finishDrain(ctx, drain, now)
+			return true
+		}
+		for _, seg := range idle {
+			if inflight >= mgr.rebalance.MaxMigrations || now.Before(mgr.nextMigrationTime) {
+				return false
+			}
+			target := seg.replicaOn(drain.VolumeID)
+			if target == nil || seg.Replicas.Evicting != 0 {
+				continue
+			}
+			if err := mgr.startMigration(ctx, seg, target, nil, now); err != nil {
+				log.Warning(ctx, "migrate replica off the draining volume failed", map[string]interface{}{
+					log.KeyError: err,
+					"segment_id": seg.ID.Key(),
+					"volume_id":  drain.VolumeID.Key(),
+				})
+				return false
+			}
+			inflight++
+		}
+		return true
+	})
+
+	// rebalance one replica at a time, so that the usage is up to date when choosing the next one.
+	if !mgr.rebalance.Enable || inflight != 0 || now.Before(mgr.nextMigrationTime) {
+		return
+	}
+	mgr.rebalanceOnce(ctx, idle, now)
+}
+
+// rebalanceOnce moves a replica off the most used volume if its usage ratio exceeds the average by
+// the tolerance, the replica is placed on a volume used less than the average.
+func (mgr *eventlogManager) rebalanceOnce(ctx context.Context, idle []*Segment, now time.Time) {
+	var source vanus.ID
+	var sum, maxRatio float64
+	ratios := make(map[vanus.ID]float64)
+	for _, ins := range mgr.placeableVolumes() {
+		capacity, used := ins.Usage()
+		if capacity <= 0 {
+			continue
+		}
+		ratio := float64(used) / float64(capacity)
+		ratios[ins.ID()] = ratio
+		sum += ratio
+		if source == vanus.EmptyID() || ratio > maxRatio {
+			source, maxRatio = ins.ID(), ratio
+		}
+	}
+	if len(ratios) < 2 {
+		return
+	}
+	avg := sum / float64(len(ratios))
+	if maxRatio-avg <= mgr.rebalance.Tolerance {
+		return
+	}
+	excluded := make([]vanus.ID, 0, len(ratios))
+	for id, ratio := range ratios {
+		if ratio >= avg {
+			excluded = append(excluded, id)
+		}
+	}
+
+	for _, seg := range idle {
+		target := seg.replicaOn(source)
+		if target == nil {
+			continue
+		}
+		if err := mgr.startMigration(ctx, seg, target, excluded, now); err != nil {
+			log.Warning(ctx, "rebalance replica failed", map[string]interface{}{
+				log.KeyError: err,
+				"segment_id": seg.ID.Key(),
+				"volume_id":  source.Key(),
+			})
+			return
+		}
+		log.Info(ctx, "rebalance replica off the most used volume", map[string]interface{}{
+			"segment_id":  seg.ID.Key(),
+			"block_id":    target.ID.Key(),
+			"volume_id":   source.Key(),
+			"usage_ratio": maxRatio,
+			"average":     avg,
+		})
+		return
+	}
+}
+
+// startMigration replaces the target replica of segment by a new one, and delays the next migration
+// to limit the bandwidth of copying.
+func (mgr *eventlogManager) startMigration(
+	ctx context.Context, seg *Segment, target *metadata.Block, excluded []vanus.ID, now time.Time,
+) error {
+	if mgr.rebalance.Bandwidth > 0 {
+		delay := time.Duration(float64(seg.Size) / float64(mgr.rebalance.Bandwidth) * float64(time.Second))
+		mgr.nextMigrationTime = now.Add(delay)
+	}
+	err := mgr.replaceReplica(ctx, seg, target, nil, excluded)
+	// the migration is continued by repairSegment once the learner is created.
+	if err != nil && seg.Replicas.Evicting == target.ID.Uint64() {
+		return nil
+	}
+	return err
+}
+
+// recordMigration adds the size of segment to the progress of draining volume of the target.
+func (mgr *eventlogManager) recordMigration(ctx context.Context, seg *Segment, target *metadata.Block) {
+	v, ok := mgr.volumeDrains.Load(target.VolumeID.Key())
+	if !ok {
+		return
+	}
+	drain := *(v.(*metadata.VolumeDrain))
+	drain.MigratedBytes += seg.Size
+	if err := mgr.saveVolumeDrain(ctx, &drain); err != nil {
+		log.Warning(ctx, "save the progress of draining volume failed", map[string]interface{}{
+			log.KeyError: err,
+			"volume_id":  target.VolumeID.Key(),
+		})
+	}
+}
+
+func (mgr *eventlogManager) finishDrain(ctx context.Context, drain *metadata.VolumeDrain, now time.Time) {
+	finished := *drain
+	finished.State = metadata.VolumeDrained
+	finished.FinishTime = now
+	if err := mgr.saveVolumeDrain(ctx, &finished); err != nil {
+		log.Warning(ctx, "save the drained volume failed", map[string]interface{}{
+			log.KeyError: err,
+			"volume_id":  drain.VolumeID.Key(),
+		})
+		return
+	}
+	log.Info(ctx, "the volume has been drained", map[string]interface{}{
+		"volume_id":      drain.VolumeID.Key(),
+		"migrated_bytes": drain.MigratedBytes,
+		"used":           now.Sub(drain.StartTime),
+	})
+}
+
+func (seg *Segment) replicaOn(volumeID vanus.ID) *metadata.Block {
+	for _, blk := range seg.Replicas.Peers {
+		if blk.VolumeID == volumeID {
+			return blk
+		}
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlog
+
+import (
+	stdCtx "context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/block"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/server"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/volume"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestEventlogManager_DrainVolume(t *testing.T) {
+	Convey("test drain volume", t, func() {
+		utMgr := &eventlogManager{
+			segmentReplicaNum:  3,
+			replicaLostTimeout: time.Minute,
+			rebalance:          RebalanceConfig{MaxMigrations: defaultMaxMigrations},
+		}
+		ctrl := gomock.NewController(t)
+		volMgr := volume.NewMockManager(ctrl)
+		utMgr.volMgr = volMgr
+		kvCli := kv.NewMockClient(ctrl)
+		utMgr.kvClient = kvCli
+		alloc := block.NewMockAllocator(ctrl)
+		utMgr.allocator = alloc
+		ctx := stdCtx.Background()
+
+		vols := make([]vanus.ID, 4)
+		instances := make([]server.Instance, 4)
+		srv := server.NewMockServer(ctrl)
+		grpcCli := segpb.NewMockSegmentServerClient(ctrl)
+		srv.EXPECT().GetClient().AnyTimes().Return(grpcCli)
+		grpcCli.EXPECT().SetBlockSegment(gomock.Any(), gomock.Any()).AnyTimes().Return(&emptypb.Empty{}, nil)
+		for i := range vols {
+			vols[i] = vanus.NewTestID()
+			ins := server.NewMockInstance(ctrl)
+			ins.EXPECT().ID().AnyTimes().Return(vols[i])
+			ins.EXPECT().GetServer().AnyTimes().Return(srv)
+			ins.EXPECT().Address().AnyTimes().Return("127.0.0.1:10004")
+			volMgr.EXPECT().GetVolumeInstanceByID(vols[i]).AnyTimes().Return(ins)
+			instances[i] = ins
+		}
+		volMgr.EXPECT().GetAllActiveVolumes().AnyTimes().Return(instances)
+
+		blocks := make([]*metadata.Block, 3)
+		peers := map[uint64]*metadata.Block{}
+		for i := range blocks {
+			blocks[i] = &metadata.Block{ID: vanus.NewTestID(), VolumeID: vols[i]}
+			peers[blocks[i].ID.Uint64()] = blocks[i]
+			utMgr.globalBlockMap.Store(blocks[i].ID.Key(), blocks[i])
+		}
+		seg := &Segment{
+			ID:         vanus.NewTestID(),
+			EventLogID: vanus.NewTestID(),
+			Size:       1024,
+			Replicas: &ReplicaGroup{
+				ID:     vanus.NewTestID(),
+				Leader: blocks[0].ID.Uint64(),
+				Peers:  peers,
+			},
+		}
+		utMgr.globalSegmentMap.Store(seg.ID.Key(), seg)
+		now := time.Now()
+		utMgr.MarkBlocksAlive([]vanus.ID{blocks[0].ID, blocks[1].ID, blocks[2].ID})
+
+		kvCli.EXPECT().Set(gomock.Any(), metadata.GetVolumeDrainKey(vols[0]), gomock.Any()).AnyTimes().Return(nil)
+		kvCli.EXPECT().Set(gomock.Any(), metadata.GetSegmentMetadataKey(seg.ID), gomock.Any()).AnyTimes().Return(nil)
+
+		Convey("test drain the volume not found", func() {
+			volMgr.EXPECT().GetVolumeInstanceByID(gomock.Any()).Times(1).Return(nil)
+			_, err := utMgr.DrainVolume(ctx, vanus.NewTestID())
+			So(errors.Is(err, errors.ErrVolumeInstanceNotFound), ShouldBeTrue)
+		})
+
+		Convey("test migrate the leader off the draining volume", func() {
+			drain, err := utMgr.DrainVolume(ctx, vols[0])
+			So(err, ShouldBeNil)
+			So(drain.State, ShouldEqual, metadata.VolumeDraining)
+			So(drain.TotalBlocks, ShouldEqual, 1)
+			So(drain.RemainingBlocks, ShouldEqual, 1)
+			placeable := utMgr.placeableVolumes()
+			So(placeable, ShouldHaveLength, 3)
+			for _, ins := range placeable {
+				So(ins.ID(), ShouldNotEqual, vols[0])
+			}
+
+			learner := &metadata.Block{ID: vanus.NewTestID(), VolumeID: vols[3]}
+			alloc.EXPECT().PickExcluding(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+				func(ctx stdCtx.Context, peers, excluded []vanus.ID) (*metadata.Block, error) {
+					So(peers, ShouldHaveLength, 2)
+					So(excluded, ShouldResemble, []vanus.ID{vols[0]})
+					return learner, nil
+				})
+			kvCli.EXPECT().Set(gomock.Any(), metadata.GetBlockMetadataKey(vols[3], learner.ID),
+				gomock.Any()).Times(1).Return(nil)
+
+			transferee := learner
+			for _, blk := range blocks[1:] {
+				if blk.ID < transferee.ID {
+					transferee = blk
+				}
+			}
+			changes := make([]segpb.MembershipChangeType, 0)
+			grpcCli.EXPECT().ChangeMembership(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+				func(ctx stdCtx.Context, req *segpb.ChangeMembershipRequest,
+					opts ...grpc.CallOption) (*emptypb.Empty, error) {
+					So(req.BlockId, ShouldEqual, seg.Replicas.Leader)
+					changes = append(changes, req.Type)
+					switch req.Type {
+					case segpb.MembershipChangeType_TRANSFER_LEADER:
+						So(req.PeerId, ShouldEqual, transferee.ID.Uint64())
+					case segpb.MembershipChangeType_REMOVE_PEER:
+						So(req.PeerId, ShouldEqual, blocks[0].ID.Uint64())
+					}
+					return nil, nil
+				})
+
+			utMgr.migrateReplicas(ctx, now)
+			So(changes, ShouldResemble, []segpb.MembershipChangeType{
+				segpb.MembershipChangeType_ADD_LEARNER,
+				segpb.MembershipChangeType_PROMOTE_LEARNER,
+				segpb.MembershipChangeType_TRANSFER_LEADER,
+			})
+			So(seg.Replicas.Peers, ShouldHaveLength, 4)
+			So(seg.Replicas.Evicting, ShouldEqual, blocks[0].ID.Uint64())
+
+			// waiting for the new leader is reported.
+			changes = changes[:0]
+			So(utMgr.repairSegment(ctx, seg, now), ShouldBeNil)
+			So(changes, ShouldResemble, []segpb.MembershipChangeType{
+				segpb.MembershipChangeType_TRANSFER_LEADER,
+			})
+
+			changes = changes[:0]
+			seg.Replicas.Leader = transferee.ID.Uint64()
+			kvCli.EXPECT().Delete(gomock.Any(), metadata.GetBlockMetadataKey(vols[0], blocks[0].ID)).
+				Times(1).Return(nil)
+			instances[0].(*server.MockInstance).EXPECT().RemoveReplica(gomock.Any(), blocks[0].ID).Times(1).Return(nil)
+			So(utMgr.repairSegment(ctx, seg, now), ShouldBeNil)
+			So(changes, ShouldResemble, []segpb.MembershipChangeType{
+				segpb.MembershipChangeType_REMOVE_PEER,
+			})
+			So(seg.Replicas.Peers, ShouldHaveLength, 3)
+			So(seg.Replicas.Peers[blocks[0].ID.Uint64()], ShouldBeNil)
+			So(seg.Replicas.Evicting, ShouldBeZeroValue)
+
+			utMgr.migrateReplicas(ctx, now)
+			drain = utMgr.GetVolumeDrain(vols[0])
+			So(drain.State, ShouldEqual, metadata.VolumeDrained)
+			So(drain.RemainingBlocks, ShouldBeZeroValue)
+			So(drain.MigratedBytes, ShouldEqual, 1024)
+
+			// it's a no-op to drain again.
+			drain, err = utMgr.DrainVolume(ctx, vols[0])
+			So(err, ShouldBeNil)
+			So(drain.State, ShouldEqual, metadata.VolumeDrained)
+		})
+
+		Convey("test throttle migrations", func() {
+			utMgr.rebalance.Bandwidth = 512
+			_, err := utMgr.DrainVolume(ctx, vols[0])
+			So(err, ShouldBeNil)
+			alloc.EXPECT().PickExcluding(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(
+				nil, errors.ErrVolumeInstanceNotFound)
+			utMgr.migrateReplicas(ctx, now)
+			So(utMgr.nextMigrationTime, ShouldEqual, now.Add(2*time.Second))
+
+			// the next migration isn't started until the data could have been copied.
+			utMgr.migrateReplicas(ctx, now.Add(time.Second))
+		})
+	})
+}
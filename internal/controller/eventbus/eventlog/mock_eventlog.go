@@ -12,6 +12,7 @@ import (
 	metadata "github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
 	kv "github.com/linkall-labs/vanus/internal/kv"
 	vanus "github.com/linkall-labs/vanus/internal/primitive/vanus"
+	meta "github.com/linkall-labs/vanus/proto/pkg/meta"
 )
 
 // MockManager is a mock of Manager interface.
@@ -64,6 +65,21 @@ func (mr *MockManagerMockRecorder) DeleteEventlog(ctx, id interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventlog", reflect.TypeOf((*MockManager)(nil).DeleteEventlog), ctx, id)
 }
 
+// DrainVolume mocks base method.
+func (m *MockManager) DrainVolume(ctx context.Context, id vanus.ID) (*metadata.VolumeDrain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DrainVolume", ctx, id)
+	ret0, _ := ret[0].(*metadata.VolumeDrain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DrainVolume indicates an expected call of DrainVolume.
+func (mr *MockManagerMockRecorder) DrainVolume(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DrainVolume", reflect.TypeOf((*MockManager)(nil).DrainVolume), ctx, id)
+}
+
 // GetAppendableSegment mocks base method.
 func (m *MockManager) GetAppendableSegment(ctx context.Context, eli *metadata.Eventlog, num int) ([]*Segment, error) {
 	m.ctrl.T.Helper()
@@ -150,6 +166,32 @@ func (mr *MockManagerMockRecorder) GetSegmentByBlockID(block interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSegmentByBlockID", reflect.TypeOf((*MockManager)(nil).GetSegmentByBlockID), block)
 }
 
+// GetVolumeDrain mocks base method.
+func (m *MockManager) GetVolumeDrain(id vanus.ID) *metadata.VolumeDrain {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeDrain", id)
+	ret0, _ := ret[0].(*metadata.VolumeDrain)
+	return ret0
+}
+
+// GetVolumeDrain indicates an expected call of GetVolumeDrain.
+func (mr *MockManagerMockRecorder) GetVolumeDrain(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeDrain", reflect.TypeOf((*MockManager)(nil).GetVolumeDrain), id)
+}
+
+// MarkBlocksAlive mocks base method.
+func (m *MockManager) MarkBlocksAlive(ids []vanus.ID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "MarkBlocksAlive", ids)
+}
+
+// MarkBlocksAlive indicates an expected call of MarkBlocksAlive.
+func (mr *MockManagerMockRecorder) MarkBlocksAlive(ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkBlocksAlive", reflect.TypeOf((*MockManager)(nil).MarkBlocksAlive), ids)
+}
+
 // Run mocks base method.
 func (m *MockManager) Run(ctx context.Context, kvClient kv.Client, startTask bool) error {
 	m.ctrl.T.Helper()
@@ -164,6 +206,30 @@ func (mr *MockManagerMockRecorder) Run(ctx, kvClient, startTask interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockManager)(nil).Run), ctx, kvClient, startTask)
 }
 
+// SetCompression mocks base method.
+func (m *MockManager) SetCompression(eventbusID vanus.ID, algorithm meta.CompressAlgorithm) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetCompression", eventbusID, algorithm)
+}
+
+// SetCompression indicates an expected call of SetCompression.
+func (mr *MockManagerMockRecorder) SetCompression(eventbusID, algorithm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCompression", reflect.TypeOf((*MockManager)(nil).SetCompression), eventbusID, algorithm)
+}
+
+// SetRetention mocks base method.
+func (m *MockManager) SetRetention(eventbusID vanus.ID, retention *metadata.Retention) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetRetention", eventbusID, retention)
+}
+
+// SetRetention indicates an expected call of SetRetention.
+func (mr *MockManagerMockRecorder) SetRetention(eventbusID, retention interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRetention", reflect.TypeOf((*MockManager)(nil).SetRetention), eventbusID, retention)
+}
+
 // Stop mocks base method.
 func (m *MockManager) Stop() {
 	m.ctrl.T.Helper()
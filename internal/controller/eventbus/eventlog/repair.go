@@ -0,0 +1,368 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlog
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+)
+
+const (
+	defaultRepairInterval          = 10 * time.Second
+	defaultReplicaLostTimeout      = 5 * time.Minute
+	defaultMembershipChangeTimeout = 10 * time.Second
+)
+
+// MarkBlocksAlive records the blocks reported by heartbeat of segment server, a replica is
+// regarded as lost if it isn't reported for replicaLostTimeout.
+func (mgr *eventlogManager) MarkBlocksAlive(ids []vanus.ID) {
+	now := time.Now()
+	for _, id := range ids {
+		mgr.blockAliveTime.Store(id.Key(), now)
+	}
+}
+
+func (mgr *eventlogManager) lostPeers(seg *Segment, now time.Time) []*metadata.Block {
+	lost := make([]*metadata.Block, 0)
+	for _, blk := range seg.Replicas.Peers {
+		// the grace period starts from the block is checked first time if it has never been
+		// reported, e.g. the controller just became leader.
+		v, _ := mgr.blockAliveTime.LoadOrStore(blk.ID.Key(), now)
+		if t, _ := v.(time.Time); now.Sub(t) > mgr.replicaLostTimeout {
+			lost = append(lost, blk)
+		}
+	}
+	sort.Slice(lost, func(i, j int) bool {
+		return lost[i].ID < lost[j].ID
+	})
+	return lost
+}
+
+func (mgr *eventlogManager) repairReplicas(ctx context.Context) {
+	ticker := time.NewTicker(mgr.repairInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info(ctx, "the task of repair-replicas stopped", nil)
+			return
+		case <-ticker.C:
+			now := time.Now()
+			mgr.globalSegmentMap.Range(func(key, value interface{}) bool {
+				seg, ok := value.(*Segment)
+				if !ok || !seg.isReady() {
+					return true
+				}
+				if _, deleted := mgr.segmentNeedBeClean.Load(key); deleted {
+					return true
+				}
+				if err := mgr.repairSegment(ctx, seg, now); err != nil {
+					log.Warning(ctx, "repair replicas of segment failed", map[string]interface{}{
+						log.KeyError: err,
+						"segment_id": seg.ID.Key(),
+					})
+				}
+				return true
+			})
+			mgr.migrateReplicas(ctx, now)
+		}
+	}
+}
+
+// repairSegment replaces a lost replica of segment, or continues the replacement in progress.
+func (mgr *eventlogManager) repairSegment(ctx context.Context, seg *Segment, now time.Time) error {
+	lost := mgr.lostPeers(seg, now)
+	target := seg.evicting()
+	if target == nil && len(lost) != 0 {
+		target = lost[0]
+	}
+	if target == nil && len(seg.Replicas.Learners) == 0 {
+		return nil
+	}
+	return mgr.replaceReplica(ctx, seg, target, lost, nil)
+}
+
+// replaceReplica replaces the target replica of segment step by step: create a block on another
+// volume and add it to replica group as a learner, promote it to voter after it has caught up, then
+// remove the target. Each step is idempotent and the progress is saved in Segment.Replicas, so
+// that the task can resume it after failure or controller restart. The target is nil if there is
+// only a learner to be promoted.
+func (mgr *eventlogManager) replaceReplica(
+	ctx context.Context, seg *Segment, target *metadata.Block, lost []*metadata.Block, excluded []vanus.ID,
+) error {
+	leader := seg.GetLeaderBlock()
+	if leader == nil || containsBlock(lost, leader.ID) {
+		// waiting for a new leader is elected and reported.
+		return nil
+	}
+	if (len(seg.Replicas.Peers)-len(lost))*2 <= len(seg.Replicas.Peers) {
+		return errors.ErrInvalidSegment.WithMessage("the majority of replicas is lost")
+	}
+
+	// the replacement has been promoted if the other peers are enough.
+	if target == nil || seg.learner() != nil || len(seg.Replicas.Peers)-1 < int(mgr.segmentReplicaNum) {
+		promoted, err := mgr.promoteLearner(ctx, seg, leader, target, excluded)
+		if err != nil || !promoted {
+			return err
+		}
+	}
+	if target == nil {
+		return nil
+	}
+
+	if target.ID == leader.ID {
+		// the leader can't remove itself, it's removed after the new leader is reported.
+		return mgr.transferLeader(ctx, seg, leader, lost)
+	}
+	err := mgr.changeMembership(ctx, leader, &segpb.ChangeMembershipRequest{
+		Type:   segpb.MembershipChangeType_REMOVE_PEER,
+		PeerId: target.ID.Uint64(),
+	})
+	if err != nil {
+		return err
+	}
+	err = mgr.updateReplicas(ctx, seg, func(rg *ReplicaGroup) {
+		delete(rg.Peers, target.ID.Uint64())
+		rg.Evicting = 0
+	})
+	if err != nil {
+		return err
+	}
+	mgr.removeBlock(ctx, target)
+	mgr.recordMigration(ctx, seg, target)
+	log.Info(ctx, "the replica has been replaced", map[string]interface{}{
+		"segment_id": seg.ID.Key(),
+		"block_id":   target.ID.Key(),
+		"volume_id":  target.VolumeID.Key(),
+	})
+	return nil
+}
+
+// promoteLearner creates a learner if there is none, and promotes it after it has caught up.
+func (mgr *eventlogManager) promoteLearner(
+	ctx context.Context, seg *Segment, leader, target *metadata.Block, excluded []vanus.ID,
+) (bool, error) {
+	learner := seg.learner()
+	if learner == nil {
+		var err error
+		if learner, err = mgr.createLearner(ctx, seg, target, excluded); err != nil {
+			return false, err
+		}
+	}
+	learnerIns := mgr.volMgr.GetVolumeInstanceByID(learner.VolumeID)
+	if learnerIns == nil || learnerIns.Address() == "" {
+		return false, errors.ErrVolumeInstanceNoServer.WithMessage("the server of learner is unavailable")
+	}
+
+	err := mgr.changeMembership(ctx, leader, &segpb.ChangeMembershipRequest{
+		Type:     segpb.MembershipChangeType_ADD_LEARNER,
+		PeerId:   learner.ID.Uint64(),
+		Endpoint: learnerIns.Address(),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	err = mgr.changeMembership(ctx, leader, &segpb.ChangeMembershipRequest{
+		Type:   segpb.MembershipChangeType_PROMOTE_LEARNER,
+		PeerId: learner.ID.Uint64(),
+	})
+	if err != nil {
+		if errors.Is(err, errors.ErrLearnerNotCaughtUp) {
+			return false, nil
+		}
+		return false, err
+	}
+	err = mgr.updateReplicas(ctx, seg, func(rg *ReplicaGroup) {
+		delete(rg.Learners, learner.ID.Uint64())
+		rg.Peers[learner.ID.Uint64()] = learner
+	})
+	if err != nil {
+		return false, err
+	}
+	log.Info(ctx, "the learner has been promoted", map[string]interface{}{
+		"segment_id": seg.ID.Key(),
+		"block_id":   learner.ID.Key(),
+		"volume_id":  learner.VolumeID.Key(),
+	})
+	return true, nil
+}
+
+// transferLeader hands over the leadership to the alive peer with the smallest id.
+func (mgr *eventlogManager) transferLeader(
+	ctx context.Context, seg *Segment, leader *metadata.Block, lost []*metadata.Block,
+) error {
+	var transferee *metadata.Block
+	for _, blk := range seg.Replicas.Peers {
+		if blk.ID == leader.ID || containsBlock(lost, blk.ID) {
+			continue
+		}
+		if transferee == nil || blk.ID < transferee.ID {
+			transferee = blk
+		}
+	}
+	if transferee == nil {
+		return errors.ErrInvalidSegment.WithMessage("no peer to transfer the leadership to")
+	}
+	log.Info(ctx, "transfer the leadership of segment", map[string]interface{}{
+		"segment_id": seg.ID.Key(),
+		"leader":     leader.ID.Key(),
+		"transferee": transferee.ID.Key(),
+	})
+	return mgr.changeMembership(ctx, leader, &segpb.ChangeMembershipRequest{
+		Type:   segpb.MembershipChangeType_TRANSFER_LEADER,
+		PeerId: transferee.ID.Uint64(),
+	})
+}
+
+// createLearner picks a block on the volume which isn't in excluded and doesn't hold the other
+// replicas, the target replica is evicted after the learner is promoted.
+func (mgr *eventlogManager) createLearner(
+	ctx context.Context, seg *Segment, target *metadata.Block, excluded []vanus.ID,
+) (*metadata.Block, error) {
+	peers := make([]vanus.ID, 0, len(seg.Replicas.Peers))
+	for _, blk := range seg.Replicas.Peers {
+		if target != nil && blk.ID == target.ID {
+			excluded = append(excluded, blk.VolumeID)
+			continue
+		}
+		peers = append(peers, blk.VolumeID)
+	}
+	blk, err := mgr.allocator.PickExcluding(ctx, peers, excluded)
+	if err != nil {
+		return nil, err
+	}
+	if err = mgr.setBlockSegment(ctx, blk, seg.ID); err != nil {
+		return nil, err
+	}
+	if err = mgr.setBlockCompression(ctx, blk, seg.Compression); err != nil {
+		return nil, err
+	}
+
+	blk.SegmentID = seg.ID
+	blk.EventlogID = seg.EventLogID
+	data, _ := json.Marshal(blk)
+	if err = mgr.kvClient.Set(ctx, metadata.GetBlockMetadataKey(blk.VolumeID, blk.ID), data); err != nil {
+		return nil, err
+	}
+	err = mgr.updateReplicas(ctx, seg, func(rg *ReplicaGroup) {
+		rg.Learners = map[uint64]*metadata.Block{blk.ID.Uint64(): blk}
+		if target != nil {
+			rg.Evicting = target.ID.Uint64()
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	mgr.globalBlockMap.Store(blk.ID.Key(), blk)
+	log.Info(ctx, "a learner created to replace the replica", map[string]interface{}{
+		"segment_id": seg.ID.Key(),
+		"block_id":   blk.ID.Key(),
+		"volume_id":  blk.VolumeID.Key(),
+	})
+	return blk, nil
+}
+
+func (mgr *eventlogManager) changeMembership(
+	ctx context.Context, leader *metadata.Block, req *segpb.ChangeMembershipRequest,
+) error {
+	ins := mgr.volMgr.GetVolumeInstanceByID(leader.VolumeID)
+	if ins == nil {
+		return errors.ErrVolumeInstanceNotFound
+	}
+	srv := ins.GetServer()
+	if srv == nil {
+		return errors.ErrVolumeInstanceNoServer
+	}
+	req.BlockId = leader.ID.Uint64()
+	ctx, cancel := context.WithTimeout(ctx, defaultMembershipChangeTimeout)
+	defer cancel()
+	_, err := srv.GetClient().ChangeMembership(ctx, req)
+	return err
+}
+
+// updateReplicas applies the change to a copy of replica group, the copy replaces the original one
+// after the segment is saved in KV, so that readers never see a map being modified.
+func (mgr *eventlogManager) updateReplicas(ctx context.Context, seg *Segment, change func(rg *ReplicaGroup)) error {
+	if el := mgr.getEventLog(seg.EventLogID); el != nil {
+		el.lock()
+		defer el.unlock()
+	}
+
+	old := seg.Replicas
+	rg := old.copy()
+	change(rg)
+	seg.Replicas = rg
+	data, _ := json.Marshal(seg)
+	if err := mgr.kvClient.Set(ctx, metadata.GetSegmentMetadataKey(seg.ID), data); err != nil {
+		seg.Replicas = old
+		return err
+	}
+	return nil
+}
+
+func (mgr *eventlogManager) removeBlock(ctx context.Context, blk *metadata.Block) {
+	mgr.globalBlockMap.Delete(blk.ID.Key())
+	mgr.blockAliveTime.Delete(blk.ID.Key())
+	if err := mgr.kvClient.Delete(ctx, metadata.GetBlockMetadataKey(blk.VolumeID, blk.ID)); err != nil {
+		log.Warning(ctx, "delete block metadata in kv failed", map[string]interface{}{
+			log.KeyError: err,
+			"block_id":   blk.ID.Key(),
+		})
+	}
+	// the server of volume is alive if the replica is migrated, or may come back if it is lost.
+	if ins := mgr.volMgr.GetVolumeInstanceByID(blk.VolumeID); ins != nil {
+		if err := ins.RemoveReplica(ctx, blk.ID); err != nil {
+			log.Debug(ctx, "delete the replaced block failed", map[string]interface{}{
+				log.KeyError: err,
+				"block_id":   blk.ID.Key(),
+			})
+		}
+	}
+}
+
+func (seg *Segment) evicting() *metadata.Block {
+	if seg.Replicas.Evicting == 0 {
+		return nil
+	}
+	return seg.Replicas.Peers[seg.Replicas.Evicting]
+}
+
+func (seg *Segment) learner() *metadata.Block {
+	var learner *metadata.Block
+	for _, blk := range seg.Replicas.Learners {
+		if learner == nil || blk.ID < learner.ID {
+			learner = blk
+		}
+	}
+	return learner
+}
+
+func containsBlock(blocks []*metadata.Block, id vanus.ID) bool {
+	for _, blk := range blocks {
+		if blk.ID == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,156 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlog
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	trigger "github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/storage"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/observability/metrics"
+)
+
+// OffsetQuerier returns the offsets committed by the subscriptions.
+type OffsetQuerier func(ctx context.Context) (*ConsumedOffsets, error)
+
+// ConsumedOffsets contains the subscriptions of each eventbus and the offsets committed by them.
+type ConsumedOffsets struct {
+	// subscriptions is the IDs of subscriptions, the key is the name of eventbus.
+	subscriptions map[string][]uint64
+	// offsets is the committed offsets, the key is the ID of subscription and then eventlog.
+	offsets map[uint64]map[uint64]uint64
+}
+
+// Min returns the minimum offset committed by the subscriptions of eventbus in the eventlog, the
+// offset is 0 if any subscription hasn't committed yet, it returns false if the eventbus has no
+// subscription.
+func (c *ConsumedOffsets) Min(el *metadata.Eventlog) (uint64, bool) {
+	subscriptions := c.subscriptions[el.Eventbus()]
+	if len(subscriptions) == 0 {
+		return 0, false
+	}
+	var min uint64
+	for idx, sub := range subscriptions {
+		off := c.offsets[sub][el.ID.Uint64()]
+		if idx == 0 || off < min {
+			min = off
+		}
+	}
+	return min, true
+}
+
+// newKVOffsetQuerier reads subscriptions and offsets which committed by trigger controller, the
+// key of an offset is /trigger/offsets/{subscription_id}/{eventlog_id}.
+func newKVOffsetQuerier(cli kv.Client) OffsetQuerier {
+	return func(ctx context.Context) (*ConsumedOffsets, error) {
+		subscriptions, err := cli.List(ctx, storage.KeyPrefixSubscription.String())
+		if err != nil {
+			return nil, err
+		}
+		result := &ConsumedOffsets{
+			subscriptions: make(map[string][]uint64, len(subscriptions)),
+			offsets:       make(map[uint64]map[uint64]uint64, len(subscriptions)),
+		}
+		for _, pair := range subscriptions {
+			sub := &trigger.Subscription{}
+			if err = json.Unmarshal(pair.Value, sub); err != nil {
+				return nil, err
+			}
+			if sub.Phase == trigger.SubscriptionPhaseToDelete {
+				continue
+			}
+			result.subscriptions[sub.EventBus] = append(result.subscriptions[sub.EventBus], sub.ID.Uint64())
+		}
+		pairs, err := cli.List(ctx, storage.KeyPrefixOffset.String())
+		if err != nil {
+			return nil, err
+		}
+		for _, pair := range pairs {
+			subID, err := vanus.NewIDFromString(filepath.Base(filepath.Dir(pair.Key)))
+			if err != nil {
+				continue
+			}
+			elID, err := vanus.NewIDFromString(filepath.Base(pair.Key))
+			if err != nil {
+				continue
+			}
+			off, err := strconv.ParseUint(string(pair.Value), 10, 64)
+			if err != nil {
+				continue
+			}
+			offsets, exist := result.offsets[subID.Uint64()]
+			if !exist {
+				offsets = make(map[uint64]uint64)
+				result.offsets[subID.Uint64()] = offsets
+			}
+			offsets[elID.Uint64()] = off
+		}
+		return result, nil
+	}
+}
+
+func (mgr *eventlogManager) SetRetention(eventbusID vanus.ID, retention *metadata.Retention) {
+	if retention == nil {
+		mgr.retentionMap.Delete(eventbusID.Key())
+		return
+	}
+	mgr.retentionMap.Store(eventbusID.Key(), retention)
+}
+
+// getRetention returns the effective retention of eventbus, the global segmentExpiredTime is
+// used if MaxAge isn't set.
+func (mgr *eventlogManager) getRetention(eventbusID vanus.ID) metadata.Retention {
+	retention := metadata.Retention{}
+	if v, exist := mgr.retentionMap.Load(eventbusID.Key()); exist {
+		retention = *(v.(*metadata.Retention))
+	}
+	if retention.MaxAge <= 0 {
+		retention.MaxAge = mgr.segmentExpiredTime
+	}
+	return retention
+}
+
+// retentionExceeded checks if the head segment should be deleted, the reason is returned
+// if any limit of retention is reached, otherwise returns empty string.
+func (el *eventlog) retentionExceeded(head *Segment, retention metadata.Retention) string {
+	if time.Since(head.LastEventBornTime.Add(retention.MaxAge)) > 0 {
+		return metrics.LabelSegmentDeletedBecauseExpired
+	}
+	if retention.MaxBytes <= 0 && retention.MaxEvents <= 0 {
+		return ""
+	}
+	el.mutex.RLock()
+	defer el.mutex.RUnlock()
+	var size, number int64
+	for ptr := el.segmentList.Front(); ptr != nil; ptr = ptr.Next() {
+		seg, _ := ptr.Value.(*Segment)
+		size += seg.Size
+		number += int64(seg.Number)
+	}
+	if retention.MaxBytes > 0 && size > retention.MaxBytes {
+		return metrics.LabelSegmentDeletedBecauseOversize
+	}
+	if retention.MaxEvents > 0 && number > retention.MaxEvents {
+		return metrics.LabelSegmentDeletedBecauseOverCount
+	}
+	return ""
+}
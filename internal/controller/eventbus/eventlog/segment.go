@@ -35,18 +35,20 @@ const (
 )
 
 type Segment struct {
-	ID                 vanus.ID      `json:"id,omitempty"`
-	Capacity           int64         `json:"capacity,omitempty"`
-	EventLogID         vanus.ID      `json:"event_log_id,omitempty"`
-	PreviousSegmentID  vanus.ID      `json:"previous_segment_id,omitempty"`
-	NextSegmentID      vanus.ID      `json:"next_segment_id,omitempty"`
-	StartOffsetInLog   int64         `json:"start_offset_in_log,omitempty"`
-	Replicas           *ReplicaGroup `json:"replicas,omitempty"`
-	State              SegmentState  `json:"state,omitempty"`
-	Size               int64         `json:"size,omitempty"`
-	Number             int32         `json:"number,omitempty"`
-	FirstEventBornTime time.Time     `json:"first_event_born_time"`
-	LastEventBornTime  time.Time     `json:"last_event_born_time"`
+	ID                 vanus.ID                 `json:"id,omitempty"`
+	Capacity           int64                    `json:"capacity,omitempty"`
+	EventLogID         vanus.ID                 `json:"event_log_id,omitempty"`
+	PreviousSegmentID  vanus.ID                 `json:"previous_segment_id,omitempty"`
+	NextSegmentID      vanus.ID                 `json:"next_segment_id,omitempty"`
+	StartOffsetInLog   int64                    `json:"start_offset_in_log,omitempty"`
+	Replicas           *ReplicaGroup            `json:"replicas,omitempty"`
+	State              SegmentState             `json:"state,omitempty"`
+	Size               int64                    `json:"size,omitempty"`
+	Compression        metapb.CompressAlgorithm `json:"compression,omitempty"`
+	Tier               metapb.StorageTier       `json:"tier,omitempty"`
+	Number             int32                    `json:"number,omitempty"`
+	FirstEventBornTime time.Time                `json:"first_event_born_time"`
+	LastEventBornTime  time.Time                `json:"last_event_born_time"`
 }
 
 func (seg *Segment) IsAppendable() bool {
@@ -67,13 +69,18 @@ func (seg *Segment) String() string {
 
 // TODO Don't update field in here
 func (seg *Segment) isNeedUpdate(newSeg Segment) bool {
-	if seg.isFull() {
-		return false
-	}
 	if seg.ID != newSeg.ID {
 		return false
 	}
+	// Blocks are offloaded to object storage after the segment is full.
 	needed := false
+	if newSeg.Tier != metapb.StorageTier_MEMORY && seg.Tier != newSeg.Tier {
+		seg.Tier = newSeg.Tier
+		needed = true
+	}
+	if seg.isFull() {
+		return needed
+	}
 	if seg.Size < newSeg.Size {
 		seg.Size = newSeg.Size
 		needed = true
@@ -118,6 +125,8 @@ func (seg *Segment) Copy() Segment {
 		Replicas:           seg.Replicas,
 		State:              seg.State,
 		Size:               seg.Size,
+		Compression:        seg.Compression,
+		Tier:               seg.Tier,
 		Number:             seg.Number,
 		FirstEventBornTime: seg.FirstEventBornTime,
 		LastEventBornTime:  seg.LastEventBornTime,
@@ -125,12 +134,33 @@ func (seg *Segment) Copy() Segment {
 }
 
 type ReplicaGroup struct {
-	ID        vanus.ID                   `json:"id"`
-	Leader    uint64                     `json:"leader"`
-	Peers     map[uint64]*metadata.Block `json:"blocks"`
-	Term      uint64                     `json:"term"`
-	CreateAt  time.Time                  `json:"create_at"`
-	DestroyAt time.Time                  `json:"destroy_at"`
+	ID     vanus.ID                   `json:"id"`
+	Leader uint64                     `json:"leader"`
+	Peers  map[uint64]*metadata.Block `json:"blocks"`
+	// Learners are the blocks which are catching up with leader when replacing lost replicas,
+	// they are moved to Peers after promoted.
+	Learners map[uint64]*metadata.Block `json:"learners,omitempty"`
+	// Evicting is the peer which is removed after the learner is promoted, when replacing a lost
+	// replica or migrating a replica off its volume.
+	Evicting  uint64    `json:"evicting,omitempty"`
+	Term      uint64    `json:"term"`
+	CreateAt  time.Time `json:"create_at"`
+	DestroyAt time.Time `json:"destroy_at"`
+}
+
+func (rg *ReplicaGroup) copy() *ReplicaGroup {
+	c := *rg
+	c.Peers = make(map[uint64]*metadata.Block, len(rg.Peers))
+	for k, v := range rg.Peers {
+		c.Peers[k] = v
+	}
+	if len(rg.Learners) != 0 {
+		c.Learners = make(map[uint64]*metadata.Block, len(rg.Learners))
+		for k, v := range rg.Learners {
+			c.Learners[k] = v
+		}
+	}
+	return &c
 }
 
 func Convert2ProtoSegment(ctx context.Context, ins ...*Segment) []*metapb.Segment {
@@ -158,10 +188,12 @@ func Convert2ProtoSegment(ctx context.Context, ins ...*Segment) []*metapb.Segmen
 			Size:                     seg.Size,
 			Capacity:                 seg.Capacity,
 			NumberEventStored:        seg.Number,
+			Compressed:               seg.Compression,
 			Replicas:                 blocks,
 			State:                    string(seg.State),
 			FirstEventBornAtByUnixMs: seg.FirstEventBornTime.UnixMilli(),
 			LastEvnetBornAtByUnixMs:  seg.LastEventBornTime.UnixMilli(),
+			Tier:                     seg.Tier,
 		}
 		if seg.GetLeaderBlock() != nil {
 			segs[idx].LeaderBlockId = seg.GetLeaderBlock().ID.Uint64()
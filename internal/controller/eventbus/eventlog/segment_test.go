@@ -24,6 +24,7 @@ import (
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/server"
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/volume"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -142,6 +143,8 @@ func TestSegment_Copy(t *testing.T) {
 		seg.Number = 123456
 		seg.FirstEventBornTime = time.Now()
 		seg.LastEventBornTime = time.Now()
+		seg.Compression = metapb.CompressAlgorithm_LZ4
+		seg.Tier = metapb.StorageTier_S3
 
 		segV1 := seg.Copy()
 		So(segV1.Capacity, ShouldEqual, seg.Capacity)
@@ -154,6 +157,8 @@ func TestSegment_Copy(t *testing.T) {
 		So(segV1.Number, ShouldEqual, seg.Number)
 		So(segV1.FirstEventBornTime, ShouldEqual, seg.FirstEventBornTime)
 		So(segV1.LastEventBornTime, ShouldEqual, seg.LastEventBornTime)
+		So(segV1.Compression, ShouldEqual, seg.Compression)
+		So(segV1.Tier, ShouldEqual, seg.Tier)
 
 		segV1.Size = 7654321
 		segV1.Number = 654321
@@ -165,6 +170,23 @@ func TestSegment_Copy(t *testing.T) {
 	})
 }
 
+func TestSegment_UpdateTier(t *testing.T) {
+	Convey("test update tier of segment", t, func() {
+		seg := createTestSegment(vanus.NewTestID())
+		seg.State = StateFrozen
+		seg.Tier = metapb.StorageTier_SSD
+
+		So(seg.isNeedUpdate(Segment{ID: seg.ID}), ShouldBeFalse)
+		So(seg.isNeedUpdate(Segment{ID: seg.ID, Tier: metapb.StorageTier_SSD, Size: 100}), ShouldBeFalse)
+		So(seg.Size, ShouldEqual, 0)
+
+		So(seg.isNeedUpdate(Segment{ID: seg.ID, Tier: metapb.StorageTier_S3}), ShouldBeTrue)
+		So(seg.Tier, ShouldEqual, metapb.StorageTier_S3)
+		So(Convert2ProtoSegment(stdCtx.Background(), &Segment{ID: seg.ID, Tier: seg.Tier})[0].Tier,
+			ShouldEqual, metapb.StorageTier_S3)
+	})
+}
+
 func createTestSegment(volID vanus.ID) *Segment {
 	leader := vanus.NewTestID()
 	fo1 := vanus.NewTestID()
@@ -0,0 +1,370 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate mockgen -source=manager.go  -destination=mock_manager.go -package=group
+package group
+
+import (
+	"context"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+)
+
+const (
+	defaultSessionTimeout = 15 * time.Second
+	expireCheckInterval   = time.Second
+	// NoCommittedOffset means the group hasn't committed offset of the eventlog.
+	NoCommittedOffset = int64(-1)
+)
+
+// Manager coordinates the consumer groups of eventbuses. The members of a group are kept in
+// memory of leader and expired if no heartbeat in session timeout, the eventlogs are assigned
+// to members evenly and reassigned when members changed. The committed offsets are persisted.
+type Manager interface {
+	Run(ctx context.Context, kvClient kv.Client)
+	Stop()
+	Join(ctx context.Context, eventbus, group string, eventlogs []vanus.ID) (*Assignment, error)
+	Heartbeat(ctx context.Context, eventbus, group, memberID string, eventlogs []vanus.ID) (*Assignment, error)
+	Leave(ctx context.Context, eventbus, group, memberID string)
+	CommitOffset(ctx context.Context, eventbus, group, memberID string, generation uint64,
+		offsets map[vanus.ID]uint64) error
+	DeleteGroups(ctx context.Context, eventbus string) error
+}
+
+type EventlogOffset struct {
+	ID     vanus.ID
+	Offset int64
+}
+
+type Assignment struct {
+	MemberID       string
+	Generation     uint64
+	Eventlogs      []EventlogOffset
+	SessionTimeout time.Duration
+}
+
+func NewManager() Manager {
+	return &manager{
+		groups:         map[string]*consumerGroup{},
+		sessionTimeout: defaultSessionTimeout,
+	}
+}
+
+type consumerGroup struct {
+	eventbus   string
+	name       string
+	generation uint64
+	// memberID -> last heartbeat time
+	members   map[string]time.Time
+	eventlogs []vanus.ID
+	offsets   map[vanus.ID]uint64
+}
+
+type manager struct {
+	kvClient       kv.Client
+	groups         map[string]*consumerGroup
+	sessionTimeout time.Duration
+	mutex          sync.Mutex
+	cancel         context.CancelFunc
+}
+
+func (mgr *manager) Run(ctx context.Context, kvClient kv.Client) {
+	mgr.mutex.Lock()
+	defer mgr.mutex.Unlock()
+	mgr.kvClient = kvClient
+	mgr.groups = map[string]*consumerGroup{}
+	var cancelCtx context.Context
+	cancelCtx, mgr.cancel = context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(expireCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-cancelCtx.Done():
+				return
+			case <-ticker.C:
+				mgr.expireMembers(cancelCtx)
+			}
+		}
+	}()
+}
+
+func (mgr *manager) Stop() {
+	mgr.mutex.Lock()
+	defer mgr.mutex.Unlock()
+	if mgr.cancel != nil {
+		mgr.cancel()
+	}
+	mgr.groups = map[string]*consumerGroup{}
+}
+
+func (mgr *manager) Join(ctx context.Context, eventbus, group string,
+	eventlogs []vanus.ID) (*Assignment, error) {
+	if group == "" {
+		return nil, errors.ErrInvalidRequest.WithMessage("the consumer group name can't be empty")
+	}
+	mgr.mutex.Lock()
+	defer mgr.mutex.Unlock()
+	g, err := mgr.getGroup(ctx, eventbus, group)
+	if err != nil {
+		return nil, err
+	}
+	memberID := uuid.NewString()
+	g.members[memberID] = time.Now()
+	g.eventlogs = sortEventlogs(eventlogs)
+	g.generation++
+	log.Info(ctx, "a member joined the consumer group", map[string]interface{}{
+		"eventbus":   eventbus,
+		"group":      group,
+		"member_id":  memberID,
+		"members":    len(g.members),
+		"generation": g.generation,
+	})
+	return mgr.assign(g, memberID), nil
+}
+
+func (mgr *manager) Heartbeat(ctx context.Context, eventbus, group, memberID string,
+	eventlogs []vanus.ID) (*Assignment, error) {
+	mgr.mutex.Lock()
+	defer mgr.mutex.Unlock()
+	g, exist := mgr.groups[groupKey(eventbus, group)]
+	if !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("the consumer group member not found")
+	}
+	if _, exist = g.members[memberID]; !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("the consumer group member not found")
+	}
+	g.members[memberID] = time.Now()
+	if sorted := sortEventlogs(eventlogs); !equalEventlogs(g.eventlogs, sorted) {
+		g.eventlogs = sorted
+		g.generation++
+	}
+	return mgr.assign(g, memberID), nil
+}
+
+func (mgr *manager) Leave(ctx context.Context, eventbus, group, memberID string) {
+	mgr.mutex.Lock()
+	defer mgr.mutex.Unlock()
+	g, exist := mgr.groups[groupKey(eventbus, group)]
+	if !exist {
+		return
+	}
+	if _, exist = g.members[memberID]; !exist {
+		return
+	}
+	mgr.removeMember(ctx, g, memberID)
+}
+
+func (mgr *manager) CommitOffset(ctx context.Context, eventbus, group, memberID string, generation uint64,
+	offsets map[vanus.ID]uint64) error {
+	mgr.mutex.Lock()
+	defer mgr.mutex.Unlock()
+	g, exist := mgr.groups[groupKey(eventbus, group)]
+	if !exist {
+		return errors.ErrResourceNotFound.WithMessage("the consumer group member not found")
+	}
+	if _, exist = g.members[memberID]; !exist {
+		return errors.ErrResourceNotFound.WithMessage("the consumer group member not found")
+	}
+	// the eventlogs may have been reassigned to other members.
+	if generation != g.generation {
+		return errors.ErrInvalidRequest.WithMessage("the generation of consumer group has changed")
+	}
+	assigned := make(map[vanus.ID]bool)
+	for _, id := range g.assignedEventlogs(memberID) {
+		assigned[id] = true
+	}
+	for id := range offsets {
+		if !assigned[id] {
+			return errors.ErrInvalidRequest.WithMessage("the eventlog isn't assigned to the member")
+		}
+	}
+	for id, offset := range offsets {
+		if old, exist := g.offsets[id]; exist && old == offset {
+			continue
+		}
+		key := metadata.GetConsumerGroupOffsetKey(eventbus, group, id)
+		if err := mgr.kvClient.Set(ctx, key, []byte(strconv.FormatUint(offset, 10))); err != nil {
+			return errors.ErrInternal.WithMessage("save consumer group offset failed").Wrap(err)
+		}
+		g.offsets[id] = offset
+	}
+	return nil
+}
+
+func (mgr *manager) DeleteGroups(ctx context.Context, eventbus string) error {
+	mgr.mutex.Lock()
+	defer mgr.mutex.Unlock()
+	for key, g := range mgr.groups {
+		if g.eventbus == eventbus {
+			delete(mgr.groups, key)
+		}
+	}
+	pairs, err := mgr.kvClient.List(ctx, metadata.GetConsumerGroupKey(eventbus, ""))
+	if err != nil {
+		return err
+	}
+	for _, pair := range pairs {
+		// the key is prefix/eventbus/group/eventlog, skip the eventbuses which have same prefix.
+		groupDir := filepath.Dir(pair.Key)
+		if filepath.Base(filepath.Dir(groupDir)) != eventbus {
+			continue
+		}
+		id, err := vanus.NewIDFromString(filepath.Base(pair.Key))
+		if err != nil {
+			continue
+		}
+		key := metadata.GetConsumerGroupOffsetKey(eventbus, filepath.Base(groupDir), id)
+		if err = mgr.kvClient.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (mgr *manager) getGroup(ctx context.Context, eventbus, group string) (*consumerGroup, error) {
+	key := groupKey(eventbus, group)
+	if g, exist := mgr.groups[key]; exist {
+		return g, nil
+	}
+	g := &consumerGroup{
+		eventbus: eventbus,
+		name:     group,
+		members:  map[string]time.Time{},
+		offsets:  map[vanus.ID]uint64{},
+	}
+	pairs, err := mgr.kvClient.List(ctx, metadata.GetConsumerGroupKey(eventbus, group))
+	if err != nil {
+		return nil, errors.ErrInternal.WithMessage("load consumer group offsets failed").Wrap(err)
+	}
+	for _, pair := range pairs {
+		// skip the groups which have same prefix.
+		if filepath.Base(filepath.Dir(pair.Key)) != group {
+			continue
+		}
+		id, err := vanus.NewIDFromString(filepath.Base(pair.Key))
+		if err != nil {
+			continue
+		}
+		offset, err := strconv.ParseUint(string(pair.Value), 10, 64)
+		if err != nil {
+			continue
+		}
+		g.offsets[id] = offset
+	}
+	mgr.groups[key] = g
+	return g, nil
+}
+
+func (mgr *manager) assign(g *consumerGroup, memberID string) *Assignment {
+	a := &Assignment{
+		MemberID:       memberID,
+		Generation:     g.generation,
+		Eventlogs:      make([]EventlogOffset, 0),
+		SessionTimeout: mgr.sessionTimeout,
+	}
+	for _, id := range g.assignedEventlogs(memberID) {
+		offset := NoCommittedOffset
+		if v, exist := g.offsets[id]; exist {
+			offset = int64(v)
+		}
+		a.Eventlogs = append(a.Eventlogs, EventlogOffset{ID: id, Offset: offset})
+	}
+	return a
+}
+
+func (mgr *manager) expireMembers(ctx context.Context) {
+	mgr.mutex.Lock()
+	defer mgr.mutex.Unlock()
+	now := time.Now()
+	for _, g := range mgr.groups {
+		for memberID, t := range g.members {
+			if now.Sub(t) > mgr.sessionTimeout {
+				mgr.removeMember(ctx, g, memberID)
+			}
+		}
+	}
+}
+
+func (mgr *manager) removeMember(ctx context.Context, g *consumerGroup, memberID string) {
+	delete(g.members, memberID)
+	g.generation++
+	log.Info(ctx, "a member left the consumer group", map[string]interface{}{
+		"eventbus":   g.eventbus,
+		"group":      g.name,
+		"member_id":  memberID,
+		"members":    len(g.members),
+		"generation": g.generation,
+	})
+	if len(g.members) == 0 {
+		// the offsets will be reloaded when a member joins again.
+		delete(mgr.groups, groupKey(g.eventbus, g.name))
+	}
+}
+
+// assignedEventlogs assigns the eventlogs to members in order of member ID, so that
+// every member has the same view of assignment in the same generation.
+func (g *consumerGroup) assignedEventlogs(memberID string) []vanus.ID {
+	members := make([]string, 0, len(g.members))
+	for id := range g.members {
+		members = append(members, id)
+	}
+	sort.Strings(members)
+	idx := sort.SearchStrings(members, memberID)
+	if idx == len(members) || members[idx] != memberID {
+		return nil
+	}
+	eventlogs := make([]vanus.ID, 0)
+	for i, id := range g.eventlogs {
+		if i%len(members) == idx {
+			eventlogs = append(eventlogs, id)
+		}
+	}
+	return eventlogs
+}
+
+func groupKey(eventbus, group string) string {
+	return eventbus + "/" + group
+}
+
+func sortEventlogs(eventlogs []vanus.ID) []vanus.ID {
+	sorted := make([]vanus.ID, len(eventlogs))
+	copy(sorted, eventlogs)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+	return sorted
+}
+
+func equalEventlogs(a, b []vanus.ID) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
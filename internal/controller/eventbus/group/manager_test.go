@@ -0,0 +1,190 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	stdCtx "context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func eventlogIDs(a *Assignment) []vanus.ID {
+	ids := make([]vanus.ID, 0, len(a.Eventlogs))
+	for _, el := range a.Eventlogs {
+		ids = append(ids, el.ID)
+	}
+	return ids
+}
+
+func TestManager_JoinAndLeave(t *testing.T) {
+	Convey("test join and leave consumer group", t, func() {
+		ctx := stdCtx.Background()
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		kvCli := kv.NewMockClient(mockCtrl)
+		mgr := NewManager().(*manager)
+		mgr.Run(ctx, kvCli)
+		defer mgr.Stop()
+		logs := []vanus.ID{4, 3, 2, 1}
+
+		kvCli.EXPECT().List(ctx, metadata.GetConsumerGroupKey("bus", "group")).Times(1).Return([]kv.Pair{
+			{Key: metadata.GetConsumerGroupOffsetKey("bus", "group", 1), Value: []byte("10")},
+			{Key: metadata.GetConsumerGroupOffsetKey("bus", "group-1", 2), Value: []byte("20")},
+		}, nil)
+		a1, err := mgr.Join(ctx, "bus", "group", logs)
+		So(err, ShouldBeNil)
+		So(a1.Generation, ShouldEqual, 1)
+		So(eventlogIDs(a1), ShouldResemble, []vanus.ID{1, 2, 3, 4})
+		So(a1.Eventlogs[0].Offset, ShouldEqual, 10)
+		So(a1.Eventlogs[1].Offset, ShouldEqual, NoCommittedOffset)
+
+		a2, err := mgr.Join(ctx, "bus", "group", logs)
+		So(err, ShouldBeNil)
+		So(a2.Generation, ShouldEqual, 2)
+		So(a2.Eventlogs, ShouldHaveLength, 2)
+
+		a1, err = mgr.Heartbeat(ctx, "bus", "group", a1.MemberID, logs)
+		So(err, ShouldBeNil)
+		So(a1.Generation, ShouldEqual, 2)
+		So(a1.Eventlogs, ShouldHaveLength, 2)
+		assigned := append(eventlogIDs(a1), eventlogIDs(a2)...)
+		So(assigned, ShouldHaveLength, 4)
+		So(assigned, ShouldContain, vanus.ID(1))
+		So(assigned, ShouldContain, vanus.ID(2))
+		So(assigned, ShouldContain, vanus.ID(3))
+		So(assigned, ShouldContain, vanus.ID(4))
+
+		_, err = mgr.Heartbeat(ctx, "bus", "group", "unknown", logs)
+		So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+
+		mgr.Leave(ctx, "bus", "group", a2.MemberID)
+		a1, err = mgr.Heartbeat(ctx, "bus", "group", a1.MemberID, logs)
+		So(err, ShouldBeNil)
+		So(a1.Generation, ShouldEqual, 3)
+		So(eventlogIDs(a1), ShouldResemble, []vanus.ID{1, 2, 3, 4})
+
+		mgr.Leave(ctx, "bus", "group", a1.MemberID)
+		So(mgr.groups, ShouldBeEmpty)
+	})
+
+	Convey("test expire member", t, func() {
+		ctx := stdCtx.Background()
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		kvCli := kv.NewMockClient(mockCtrl)
+		mgr := NewManager().(*manager)
+		mgr.kvClient = kvCli
+		mgr.sessionTimeout = 10 * time.Millisecond
+		logs := []vanus.ID{1, 2}
+
+		kvCli.EXPECT().List(ctx, gomock.Any()).Times(1).Return(nil, nil)
+		a1, err := mgr.Join(ctx, "bus", "group", logs)
+		So(err, ShouldBeNil)
+		a2, err := mgr.Join(ctx, "bus", "group", logs)
+		So(err, ShouldBeNil)
+		time.Sleep(20 * time.Millisecond)
+		_, err = mgr.Heartbeat(ctx, "bus", "group", a2.MemberID, logs)
+		So(err, ShouldBeNil)
+		mgr.expireMembers(ctx)
+
+		_, err = mgr.Heartbeat(ctx, "bus", "group", a1.MemberID, logs)
+		So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+		a2, err = mgr.Heartbeat(ctx, "bus", "group", a2.MemberID, logs)
+		So(err, ShouldBeNil)
+		So(a2.Generation, ShouldEqual, 3)
+		So(eventlogIDs(a2), ShouldResemble, []vanus.ID{1, 2})
+	})
+}
+
+func TestManager_CommitOffset(t *testing.T) {
+	Convey("test commit offset of consumer group", t, func() {
+		ctx := stdCtx.Background()
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		kvCli := kv.NewMockClient(mockCtrl)
+		mgr := NewManager().(*manager)
+		mgr.kvClient = kvCli
+		logs := []vanus.ID{1, 2}
+
+		kvCli.EXPECT().List(ctx, gomock.Any()).Times(1).Return(nil, nil)
+		a1, err := mgr.Join(ctx, "bus", "group", logs)
+		So(err, ShouldBeNil)
+		a2, err := mgr.Join(ctx, "bus", "group", logs)
+		So(err, ShouldBeNil)
+		own := a2.Eventlogs[0].ID
+		other := vanus.ID(1)
+		if own == other {
+			other = vanus.ID(2)
+		}
+
+		Convey("test commit with stale generation", func() {
+			err = mgr.CommitOffset(ctx, "bus", "group", a1.MemberID, a1.Generation, map[vanus.ID]uint64{other: 1})
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("test commit eventlog of other member", func() {
+			err = mgr.CommitOffset(ctx, "bus", "group", a2.MemberID, a2.Generation, map[vanus.ID]uint64{other: 1})
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("test commit and restore", func() {
+			kvCli.EXPECT().Set(ctx, metadata.GetConsumerGroupOffsetKey("bus", "group", own), []byte("100")).
+				Times(1).Return(nil)
+			err = mgr.CommitOffset(ctx, "bus", "group", a2.MemberID, a2.Generation, map[vanus.ID]uint64{own: 100})
+			So(err, ShouldBeNil)
+			// the same offset isn't saved again
+			err = mgr.CommitOffset(ctx, "bus", "group", a2.MemberID, a2.Generation, map[vanus.ID]uint64{own: 100})
+			So(err, ShouldBeNil)
+
+			mgr.Leave(ctx, "bus", "group", a2.MemberID)
+			a1, err = mgr.Heartbeat(ctx, "bus", "group", a1.MemberID, logs)
+			So(err, ShouldBeNil)
+			for _, el := range a1.Eventlogs {
+				if el.ID == own {
+					So(el.Offset, ShouldEqual, 100)
+				} else {
+					So(el.Offset, ShouldEqual, NoCommittedOffset)
+				}
+			}
+		})
+	})
+}
+
+func TestManager_DeleteGroups(t *testing.T) {
+	Convey("test delete consumer groups of eventbus", t, func() {
+		ctx := stdCtx.Background()
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		kvCli := kv.NewMockClient(mockCtrl)
+		mgr := NewManager().(*manager)
+		mgr.kvClient = kvCli
+
+		kvCli.EXPECT().List(ctx, metadata.GetConsumerGroupKey("bus", "")).Times(1).Return([]kv.Pair{
+			{Key: metadata.GetConsumerGroupOffsetKey("bus", "g1", 1)},
+			{Key: metadata.GetConsumerGroupOffsetKey("bus", "g2", 2)},
+			{Key: metadata.GetConsumerGroupOffsetKey("bus-1", "g1", 1)},
+		}, nil)
+		kvCli.EXPECT().Delete(ctx, metadata.GetConsumerGroupOffsetKey("bus", "g1", 1)).Times(1).Return(nil)
+		kvCli.EXPECT().Delete(ctx, metadata.GetConsumerGroupOffsetKey("bus", "g2", 2)).Times(1).Return(nil)
+		So(mgr.DeleteGroups(ctx, "bus"), ShouldBeNil)
+	})
+}
@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: manager.go
+
+// Package group is a generated GoMock package.
+package group
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	kv "github.com/linkall-labs/vanus/internal/kv"
+	vanus "github.com/linkall-labs/vanus/internal/primitive/vanus"
+)
+
+// MockManager is a mock of Manager interface.
+type MockManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockManagerMockRecorder
+}
+
+// MockManagerMockRecorder is the mock recorder for MockManager.
+type MockManagerMockRecorder struct {
+	mock *MockManager
+}
+
+// NewMockManager creates a new mock instance.
+func NewMockManager(ctrl *gomock.Controller) *MockManager {
+	mock := &MockManager{ctrl: ctrl}
+	mock.recorder = &MockManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManager) EXPECT() *MockManagerMockRecorder {
+	return m.recorder
+}
+
+// CommitOffset mocks base method.
+func (m *MockManager) CommitOffset(ctx context.Context, eventbus, group, memberID string, generation uint64, offsets map[vanus.ID]uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitOffset", ctx, eventbus, group, memberID, generation, offsets)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CommitOffset indicates an expected call of CommitOffset.
+func (mr *MockManagerMockRecorder) CommitOffset(ctx, eventbus, group, memberID, generation, offsets interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitOffset", reflect.TypeOf((*MockManager)(nil).CommitOffset), ctx, eventbus, group, memberID, generation, offsets)
+}
+
+// DeleteGroups mocks base method.
+func (m *MockManager) DeleteGroups(ctx context.Context, eventbus string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroups", ctx, eventbus)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGroups indicates an expected call of DeleteGroups.
+func (mr *MockManagerMockRecorder) DeleteGroups(ctx, eventbus interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroups", reflect.TypeOf((*MockManager)(nil).DeleteGroups), ctx, eventbus)
+}
+
+// Heartbeat mocks base method.
+func (m *MockManager) Heartbeat(ctx context.Context, eventbus, group, memberID string, eventlogs []vanus.ID) (*Assignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Heartbeat", ctx, eventbus, group, memberID, eventlogs)
+	ret0, _ := ret[0].(*Assignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Heartbeat indicates an expected call of Heartbeat.
+func (mr *MockManagerMockRecorder) Heartbeat(ctx, eventbus, group, memberID, eventlogs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Heartbeat", reflect.TypeOf((*MockManager)(nil).Heartbeat), ctx, eventbus, group, memberID, eventlogs)
+}
+
+// Join mocks base method.
+func (m *MockManager) Join(ctx context.Context, eventbus, group string, eventlogs []vanus.ID) (*Assignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Join", ctx, eventbus, group, eventlogs)
+	ret0, _ := ret[0].(*Assignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Join indicates an expected call of Join.
+func (mr *MockManagerMockRecorder) Join(ctx, eventbus, group, eventlogs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Join", reflect.TypeOf((*MockManager)(nil).Join), ctx, eventbus, group, eventlogs)
+}
+
+// Leave mocks base method.
+func (m *MockManager) Leave(ctx context.Context, eventbus, group, memberID string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Leave", ctx, eventbus, group, memberID)
+}
+
+// Leave indicates an expected call of Leave.
+func (mr *MockManagerMockRecorder) Leave(ctx, eventbus, group, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Leave", reflect.TypeOf((*MockManager)(nil).Leave), ctx, eventbus, group, memberID)
+}
+
+// Run mocks base method.
+func (m *MockManager) Run(ctx context.Context, kvClient kv.Client) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Run", ctx, kvClient)
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockManagerMockRecorder) Run(ctx, kvClient interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockManager)(nil).Run), ctx, kvClient)
+}
+
+// Stop mocks base method.
+func (m *MockManager) Stop() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Stop")
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockManagerMockRecorder) Stop() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockManager)(nil).Stop))
+}
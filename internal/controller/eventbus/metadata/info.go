@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/linkall-labs/vanus/internal/primitive/schema"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/proto/pkg/meta"
 )
@@ -28,8 +29,11 @@ type Eventbus struct {
 	LogNumber   int         `json:"log_number"`
 	EventLogs   []*Eventlog `json:"event_logs"`
 	Description string      `json:"description"`
-	CreatedAt   time.Time   `json:"created_at"`
-	UpdatedAt   time.Time   `json:"updated_at"`
+	Retention   *Retention  `json:"retention,omitempty"`
+	// Compression is the algorithm which compresses the data of events in new segments.
+	Compression meta.CompressAlgorithm `json:"compression,omitempty"`
+	CreatedAt   time.Time              `json:"created_at"`
+	UpdatedAt   time.Time              `json:"updated_at"`
 }
 
 func Convert2ProtoEventBus(ins ...*Eventbus) []*meta.EventBus {
@@ -42,6 +46,8 @@ func Convert2ProtoEventBus(ins ...*Eventbus) []*meta.EventBus {
 			Logs:        Convert2ProtoEventLog(eb.EventLogs...),
 			Id:          eb.ID.Uint64(),
 			Description: eb.Description,
+			Retention:   eb.Retention.ToProto(),
+			Compression: eb.Compression,
 			CreatedAt:   eb.CreatedAt.UnixMilli(),
 			UpdatedAt:   eb.UpdatedAt.UnixMilli(),
 		}
@@ -49,6 +55,83 @@ func Convert2ProtoEventBus(ins ...*Eventbus) []*meta.EventBus {
 	return pebs
 }
 
+// ScheduledEvent is a pending event in timer, it's removed after the event was delivered
+// or the schedule was cancelled.
+type ScheduledEvent struct {
+	ID           vanus.ID  `json:"id"`
+	Eventbus     string    `json:"eventbus"`
+	EventID      string    `json:"event_id"`
+	DeliveryTime time.Time `json:"delivery_time"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func (se *ScheduledEvent) ToProto() *meta.ScheduledEvent {
+	return &meta.ScheduledEvent{
+		Id:           se.ID.Uint64(),
+		Eventbus:     se.Eventbus,
+		EventId:      se.EventID,
+		DeliveryTime: se.DeliveryTime.UnixMilli(),
+		CreatedAt:    se.CreatedAt.UnixMilli(),
+	}
+}
+
+// Schema is a version of the schema of the events which have the type in the eventbus, it's
+// immutable after registered.
+type Schema struct {
+	Eventbus      string                    `json:"eventbus"`
+	Type          string                    `json:"type"`
+	Version       uint32                    `json:"version"`
+	Format        meta.Schema_Format        `json:"format"`
+	Definition    string                    `json:"definition"`
+	Compatibility meta.Schema_Compatibility `json:"compatibility"`
+	CreatedAt     time.Time                 `json:"created_at"`
+}
+
+func (s *Schema) ToProto() *meta.Schema {
+	return &meta.Schema{
+		Eventbus:      s.Eventbus,
+		Type:          s.Type,
+		Version:       s.Version,
+		Format:        s.Format,
+		Definition:    s.Definition,
+		Compatibility: s.Compatibility,
+		Uri:           schema.URI(s.Eventbus, s.Type, s.Version),
+		CreatedAt:     s.CreatedAt.UnixMilli(),
+	}
+}
+
+// Retention is the retention policy of an eventbus, the zero value of each limit means unlimited.
+type Retention struct {
+	MaxAge                time.Duration `json:"max_age"`
+	MaxBytes              int64         `json:"max_bytes"`
+	MaxEvents             int64         `json:"max_events"`
+	AllowDeleteUnconsumed bool          `json:"allow_delete_unconsumed"`
+}
+
+func NewRetentionFromProto(pb *meta.RetentionPolicy) *Retention {
+	if pb == nil {
+		return nil
+	}
+	return &Retention{
+		MaxAge:                time.Duration(pb.MaxAge) * time.Second,
+		MaxBytes:              pb.MaxBytes,
+		MaxEvents:             pb.MaxEvents,
+		AllowDeleteUnconsumed: pb.AllowDeleteUnconsumed,
+	}
+}
+
+func (r *Retention) ToProto() *meta.RetentionPolicy {
+	if r == nil {
+		return nil
+	}
+	return &meta.RetentionPolicy{
+		MaxAge:                int64(r.MaxAge / time.Second),
+		MaxBytes:              r.MaxBytes,
+		MaxEvents:             r.MaxEvents,
+		AllowDeleteUnconsumed: r.AllowDeleteUnconsumed,
+	}
+}
+
 type Eventlog struct {
 	// global unique id
 	ID            vanus.ID `json:"id"`
@@ -81,9 +164,28 @@ type VolumeMetadata struct {
 	ID       vanus.ID          `json:"id"`
 	Capacity int64             `json:"capacity"`
 	Used     int64             `json:"used"`
+	Labels   map[string]string `json:"labels,omitempty"`
 	Blocks   map[uint64]*Block `json:"blocks"`
 }
 
+const (
+	VolumeDraining = "draining"
+	VolumeDrained  = "drained"
+)
+
+// VolumeDrain is the progress of migrating all block replicas off a volume.
+type VolumeDrain struct {
+	VolumeID vanus.ID `json:"volume_id"`
+	State    string   `json:"state"`
+	// TotalBlocks is the number of replicas on the volume when draining started.
+	TotalBlocks   int       `json:"total_blocks"`
+	MigratedBytes int64     `json:"migrated_bytes"`
+	StartTime     time.Time `json:"start_time"`
+	FinishTime    time.Time `json:"finish_time"`
+	// RemainingBlocks is counted when the drain is queried.
+	RemainingBlocks int `json:"-"`
+}
+
 type Block struct {
 	ID         vanus.ID `json:"id"`
 	Capacity   int64    `json:"capacity"`
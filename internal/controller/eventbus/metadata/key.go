@@ -15,7 +15,9 @@
 package metadata
 
 import (
+	"net/url"
 	"path"
+	"strconv"
 
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 )
@@ -24,12 +26,17 @@ const (
 	VolumeKeyPrefixInKVStore         = "/vanus/internal/resource/volume/metadata"
 	BlockKeyPrefixInKVStore          = "/vanus/internal/resource/volume/block"
 	VolumeInstanceKeyPrefixInKVStore = "/vanus/internal/resource/volume/instance"
+	VolumeDrainKeyPrefixInKVStore    = "/vanus/internal/resource/volume/drain"
 
 	EventbusKeyPrefixInKVStore = "/vanus/internal/resource/eventbus"
 	EventlogKeyPrefixInKVStore = "/vanus/internal/resource/eventlog"
 	SegmentKeyPrefixInKVStore  = "/vanus/internal/resource/segment"
 
 	EventlogSegmentsKeyPrefixInKVStore = "/vanus/internal/resource/segs_of_eventlog"
+
+	ConsumerGroupKeyPrefixInKVStore  = "/vanus/internal/resource/consumer_group"
+	ScheduledEventKeyPrefixInKVStore = "/vanus/internal/resource/scheduled_event"
+	SchemaKeyPrefixInKVStore         = "/vanus/internal/resource/schema"
 )
 
 func GetEventbusMetadataKey(ebName string) string {
@@ -44,6 +51,10 @@ func GetBlockMetadataKey(volumeID, blockID vanus.ID) string {
 	return path.Join(BlockKeyPrefixInKVStore, volumeID.Key(), blockID.Key())
 }
 
+func GetVolumeDrainKey(volumeID vanus.ID) string {
+	return path.Join(VolumeDrainKeyPrefixInKVStore, volumeID.Key())
+}
+
 func GetSegmentMetadataKey(segmentID vanus.ID) string {
 	return path.Join(SegmentKeyPrefixInKVStore, segmentID.Key())
 }
@@ -51,3 +62,33 @@ func GetSegmentMetadataKey(segmentID vanus.ID) string {
 func GetEventlogSegmentsMetadataKey(eventlogID, segmentID vanus.ID) string {
 	return path.Join(EventlogSegmentsKeyPrefixInKVStore, eventlogID.Key(), segmentID.Key())
 }
+
+func GetConsumerGroupKey(ebName, group string) string {
+	return path.Join(ConsumerGroupKeyPrefixInKVStore, ebName, group)
+}
+
+func GetConsumerGroupOffsetKey(ebName, group string, eventlogID vanus.ID) string {
+	return path.Join(ConsumerGroupKeyPrefixInKVStore, ebName, group, eventlogID.Key())
+}
+
+func GetScheduledEventsKey(ebName string) string {
+	return path.Join(ScheduledEventKeyPrefixInKVStore, ebName)
+}
+
+func GetScheduledEventKey(ebName string, id vanus.ID) string {
+	return path.Join(ScheduledEventKeyPrefixInKVStore, ebName, id.Key())
+}
+
+func GetSchemasKey(ebName string) string {
+	return path.Join(SchemaKeyPrefixInKVStore, ebName)
+}
+
+// GetSchemaVersionsKey returns the key of versions of schema, the type is escaped since it may
+// contain slashes.
+func GetSchemaVersionsKey(ebName, eventType string) string {
+	return path.Join(SchemaKeyPrefixInKVStore, ebName, url.PathEscape(eventType))
+}
+
+func GetSchemaKey(ebName, eventType string, version uint32) string {
+	return path.Join(GetSchemaVersionsKey(ebName, eventType), strconv.FormatUint(uint64(version), 10))
+}
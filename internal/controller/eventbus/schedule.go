@@ -0,0 +1,155 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	stdErr "errors"
+	"sort"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// The scheduled events are registered by gateway before they are sent to timer, and deleted by
+// timer after they were delivered, so a schedule which doesn't exist won't be delivered.
+
+// scheduleClockSkew tolerates the clock difference between gateway and controller, a delivery time
+// which is earlier than it is rejected.
+const scheduleClockSkew = time.Second
+
+func (ctrl *controller) CreateScheduledEvent(ctx context.Context,
+	req *metapb.ScheduledEvent) (*metapb.ScheduledEvent, error) {
+	if req.DeliveryTime <= 0 {
+		return nil, errors.ErrInvalidRequest.WithMessage("delivery time must be set")
+	}
+	if time.UnixMilli(req.DeliveryTime).Before(time.Now().Add(-scheduleClockSkew)) {
+		return nil, errors.ErrInvalidRequest.WithMessage("delivery time can't be in the past")
+	}
+	if _, err := ctrl.getEventlogIDs(req.Eventbus); err != nil {
+		return nil, err
+	}
+	id, err := vanus.NewID()
+	if err != nil {
+		return nil, err
+	}
+	se := &metadata.ScheduledEvent{
+		ID:           id,
+		Eventbus:     req.Eventbus,
+		EventID:      req.EventId,
+		DeliveryTime: time.UnixMilli(req.DeliveryTime),
+		CreatedAt:    time.Now(),
+	}
+	data, _ := json.Marshal(se)
+	if err = ctrl.kvStore.Set(ctx, metadata.GetScheduledEventKey(se.Eventbus, se.ID), data); err != nil {
+		return nil, errors.ErrInternal.WithMessage("save scheduled event failed").Wrap(err)
+	}
+	return se.ToProto(), nil
+}
+
+func (ctrl *controller) GetScheduledEvent(ctx context.Context,
+	req *ctrlpb.GetScheduledEventRequest) (*metapb.ScheduledEvent, error) {
+	data, err := ctrl.kvStore.Get(ctx, metadata.GetScheduledEventKey(req.Eventbus, vanus.NewIDFromUint64(req.Id)))
+	if err != nil {
+		if stdErr.Is(err, kv.ErrKeyNotFound) {
+			return nil, errors.ErrResourceNotFound.WithMessage("the scheduled event doesn't exist")
+		}
+		return nil, errors.ErrInternal.WithMessage("get scheduled event failed").Wrap(err)
+	}
+	se := &metadata.ScheduledEvent{}
+	if err = json.Unmarshal(data, se); err != nil {
+		return nil, errors.ErrJSONUnMarshal.Wrap(err)
+	}
+	return se.ToProto(), nil
+}
+
+func (ctrl *controller) DeleteScheduledEvent(ctx context.Context,
+	req *ctrlpb.DeleteScheduledEventRequest) (*emptypb.Empty, error) {
+	key := metadata.GetScheduledEventKey(req.Eventbus, vanus.NewIDFromUint64(req.Id))
+	exist, err := ctrl.kvStore.Exists(ctx, key)
+	if err != nil {
+		return nil, errors.ErrInternal.WithMessage("get scheduled event failed").Wrap(err)
+	}
+	if !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("the scheduled event doesn't exist")
+	}
+	if err = ctrl.kvStore.Delete(ctx, key); err != nil {
+		return nil, errors.ErrInternal.WithMessage("delete scheduled event failed").Wrap(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (ctrl *controller) ListScheduledEvent(ctx context.Context,
+	req *ctrlpb.ListScheduledEventRequest) (*ctrlpb.ListScheduledEventResponse, error) {
+	events, err := ctrl.listScheduledEvents(ctx, req.Eventbus)
+	if err != nil {
+		return nil, err
+	}
+	res := &ctrlpb.ListScheduledEventResponse{Events: make([]*metapb.ScheduledEvent, 0, len(events))}
+	for _, se := range events {
+		res.Events = append(res.Events, se.ToProto())
+	}
+	return res, nil
+}
+
+// listScheduledEvents returns the scheduled events of eventbus in order of delivery time.
+func (ctrl *controller) listScheduledEvents(ctx context.Context,
+	eventbus string) ([]*metadata.ScheduledEvent, error) {
+	pairs, err := ctrl.kvStore.List(ctx, metadata.GetScheduledEventsKey(eventbus))
+	if err != nil {
+		return nil, errors.ErrInternal.WithMessage("list scheduled events failed").Wrap(err)
+	}
+	events := make([]*metadata.ScheduledEvent, 0, len(pairs))
+	for _, pair := range pairs {
+		se := &metadata.ScheduledEvent{}
+		if err = json.Unmarshal(pair.Value, se); err != nil {
+			log.Warning(ctx, "unmarshal scheduled event failed", map[string]interface{}{
+				log.KeyError: err,
+				"key":        pair.Key,
+			})
+			continue
+		}
+		// skip the eventbuses which have same prefix.
+		if se.Eventbus != eventbus {
+			continue
+		}
+		events = append(events, se)
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].DeliveryTime.Before(events[j].DeliveryTime)
+	})
+	return events, nil
+}
+
+func (ctrl *controller) deleteScheduledEvents(ctx context.Context, eventbus string) error {
+	events, err := ctrl.listScheduledEvents(ctx, eventbus)
+	if err != nil {
+		return err
+	}
+	for _, se := range events {
+		if err = ctrl.kvStore.Delete(ctx, metadata.GetScheduledEventKey(se.Eventbus, se.ID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	stdCtx "context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func scheduledEventPair(eventbus string, id vanus.ID, deliveryTime time.Time) kv.Pair {
+	se := &metadata.ScheduledEvent{
+		ID:           id,
+		Eventbus:     eventbus,
+		EventID:      "event",
+		DeliveryTime: deliveryTime,
+	}
+	data, _ := json.Marshal(se)
+	return kv.Pair{Key: metadata.GetScheduledEventKey(eventbus, id), Value: data}
+}
+
+func TestController_ScheduledEvent(t *testing.T) {
+	Convey("test scheduled event", t, func() {
+		ctrl := NewController(Config{}, nil)
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		kvCli := kv.NewMockClient(mockCtrl)
+		ctrl.kvStore = kvCli
+		ctx := stdCtx.Background()
+
+		Convey("test create scheduled event", func() {
+			deliveryTime := time.Now().Add(time.Minute).UnixMilli()
+			_, err := ctrl.CreateScheduledEvent(ctx, &metapb.ScheduledEvent{
+				Eventbus:     "test-1",
+				DeliveryTime: deliveryTime,
+			})
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+
+			ctrl.eventBusMap["test-1"] = &metadata.Eventbus{ID: vanus.NewTestID(), Name: "test-1"}
+			_, err = ctrl.CreateScheduledEvent(ctx, &metapb.ScheduledEvent{Eventbus: "test-1", EventId: "event"})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+			_, err = ctrl.CreateScheduledEvent(ctx, &metapb.ScheduledEvent{
+				Eventbus:     "test-1",
+				EventId:      "event",
+				DeliveryTime: time.Now().Add(-time.Minute).UnixMilli(),
+			})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+			var saved []byte
+			kvCli.EXPECT().Set(ctx, gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+				func(_ stdCtx.Context, _ string, value []byte) error {
+					saved = value
+					return nil
+				})
+			se, err := ctrl.CreateScheduledEvent(ctx, &metapb.ScheduledEvent{
+				Eventbus:     "test-1",
+				EventId:      "event",
+				DeliveryTime: deliveryTime,
+			})
+			So(err, ShouldBeNil)
+			So(se.Id, ShouldNotEqual, 0)
+			So(se.DeliveryTime, ShouldEqual, deliveryTime)
+			md := &metadata.ScheduledEvent{}
+			So(json.Unmarshal(saved, md), ShouldBeNil)
+			So(md.ToProto(), ShouldResemble, se)
+		})
+
+		Convey("test get and delete scheduled event", func() {
+			id := vanus.NewTestID()
+			pair := scheduledEventPair("test-1", id, time.UnixMilli(1000))
+			kvCli.EXPECT().Get(ctx, pair.Key).Times(1).Return(pair.Value, nil)
+			se, err := ctrl.GetScheduledEvent(ctx, &ctrlpb.GetScheduledEventRequest{
+				Eventbus: "test-1",
+				Id:       id.Uint64(),
+			})
+			So(err, ShouldBeNil)
+			So(se.DeliveryTime, ShouldEqual, 1000)
+
+			kvCli.EXPECT().Get(ctx, pair.Key).Times(1).Return(nil, kv.ErrKeyNotFound)
+			_, err = ctrl.GetScheduledEvent(ctx, &ctrlpb.GetScheduledEventRequest{
+				Eventbus: "test-1",
+				Id:       id.Uint64(),
+			})
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+
+			kvCli.EXPECT().Exists(ctx, pair.Key).Times(1).Return(true, nil)
+			kvCli.EXPECT().Delete(ctx, pair.Key).Times(1).Return(nil)
+			_, err = ctrl.DeleteScheduledEvent(ctx, &ctrlpb.DeleteScheduledEventRequest{
+				Eventbus: "test-1",
+				Id:       id.Uint64(),
+			})
+			So(err, ShouldBeNil)
+
+			kvCli.EXPECT().Exists(ctx, pair.Key).Times(1).Return(false, nil)
+			_, err = ctrl.DeleteScheduledEvent(ctx, &ctrlpb.DeleteScheduledEventRequest{
+				Eventbus: "test-1",
+				Id:       id.Uint64(),
+			})
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+		})
+
+		Convey("test list scheduled events", func() {
+			now := time.Now()
+			kvCli.EXPECT().List(ctx, metadata.GetScheduledEventsKey("test")).Times(1).Return([]kv.Pair{
+				scheduledEventPair("test", 1, now.Add(2*time.Minute)),
+				scheduledEventPair("test-1", 2, now),
+				scheduledEventPair("test", 3, now.Add(time.Minute)),
+			}, nil)
+			res, err := ctrl.ListScheduledEvent(ctx, &ctrlpb.ListScheduledEventRequest{Eventbus: "test"})
+			So(err, ShouldBeNil)
+			So(res.Events, ShouldHaveLength, 2)
+			So(res.Events[0].Id, ShouldEqual, 3)
+			So(res.Events[1].Id, ShouldEqual, 1)
+		})
+	})
+}
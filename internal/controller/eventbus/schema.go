@@ -0,0 +1,248 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	stdErr "errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/schema"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// The schemas are registered by eventbus and event type, each new version is checked against
+// the latest version with the requested compatibility. The versions are immutable, so gateway
+// can cache them.
+
+func (ctrl *controller) RegisterSchema(ctx context.Context,
+	req *ctrlpb.RegisterSchemaRequest) (*metapb.Schema, error) {
+	if req.Type == "" {
+		return nil, errors.ErrInvalidRequest.WithMessage("the type of schema can't be empty")
+	}
+	if _, err := ctrl.getEventlogIDs(req.Eventbus); err != nil {
+		return nil, err
+	}
+	next, err := compileSchema(req.Format, req.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	ctrl.schemaMutex.Lock()
+	defer ctrl.schemaMutex.Unlock()
+
+	versions, err := ctrl.listSchemas(ctx, req.Eventbus, req.Type)
+	if err != nil {
+		return nil, err
+	}
+	version := uint32(1)
+	if n := len(versions); n > 0 {
+		latest := versions[n-1]
+		if latest.Format == req.Format && latest.Definition == req.Definition {
+			return latest.ToProto(), nil
+		}
+		if latest.Format != req.Format {
+			return nil, errors.ErrInvalidRequest.WithMessage("can not change the format of schema")
+		}
+		prev, err := compileSchema(latest.Format, latest.Definition)
+		if err != nil {
+			return nil, errors.ErrInternal.WithMessage("the latest version of schema is invalid").Wrap(err)
+		}
+		if err = schema.CheckCompatibility(prev, next, toCompatibility(req.Compatibility)); err != nil {
+			return nil, errors.ErrInvalidRequest.WithMessage(err.Error())
+		}
+		version = latest.Version + 1
+	}
+
+	s := &metadata.Schema{
+		Eventbus:      req.Eventbus,
+		Type:          req.Type,
+		Version:       version,
+		Format:        req.Format,
+		Definition:    req.Definition,
+		Compatibility: req.Compatibility,
+		CreatedAt:     time.Now(),
+	}
+	data, _ := json.Marshal(s)
+	if err = ctrl.kvStore.Set(ctx, metadata.GetSchemaKey(s.Eventbus, s.Type, s.Version), data); err != nil {
+		return nil, errors.ErrInternal.WithMessage("save schema failed").Wrap(err)
+	}
+	return s.ToProto(), nil
+}
+
+func (ctrl *controller) GetSchema(ctx context.Context, req *ctrlpb.GetSchemaRequest) (*metapb.Schema, error) {
+	if req.Version == 0 {
+		versions, err := ctrl.listSchemas(ctx, req.Eventbus, req.Type)
+		if err != nil {
+			return nil, err
+		}
+		if len(versions) == 0 {
+			return nil, errors.ErrResourceNotFound.WithMessage("the schema doesn't exist")
+		}
+		return versions[len(versions)-1].ToProto(), nil
+	}
+
+	data, err := ctrl.kvStore.Get(ctx, metadata.GetSchemaKey(req.Eventbus, req.Type, req.Version))
+	if err != nil {
+		if stdErr.Is(err, kv.ErrKeyNotFound) {
+			return nil, errors.ErrResourceNotFound.WithMessage("the schema doesn't exist")
+		}
+		return nil, errors.ErrInternal.WithMessage("get schema failed").Wrap(err)
+	}
+	s := &metadata.Schema{}
+	if err = json.Unmarshal(data, s); err != nil {
+		return nil, errors.ErrJSONUnMarshal.Wrap(err)
+	}
+	return s.ToProto(), nil
+}
+
+func (ctrl *controller) ListSchema(ctx context.Context,
+	req *ctrlpb.ListSchemaRequest) (*ctrlpb.ListSchemaResponse, error) {
+	schemas, err := ctrl.listSchemas(ctx, req.Eventbus, req.Type)
+	if err != nil {
+		return nil, err
+	}
+	res := &ctrlpb.ListSchemaResponse{Schemas: make([]*metapb.Schema, 0, len(schemas))}
+	for _, s := range schemas {
+		res.Schemas = append(res.Schemas, s.ToProto())
+	}
+	return res, nil
+}
+
+func (ctrl *controller) DeleteSchema(ctx context.Context, req *ctrlpb.DeleteSchemaRequest) (*emptypb.Empty, error) {
+	if req.Type == "" {
+		return nil, errors.ErrInvalidRequest.WithMessage("the type of schema can't be empty")
+	}
+
+	ctrl.schemaMutex.Lock()
+	defer ctrl.schemaMutex.Unlock()
+
+	if req.Version == 0 {
+		versions, err := ctrl.listSchemas(ctx, req.Eventbus, req.Type)
+		if err != nil {
+			return nil, err
+		}
+		if len(versions) == 0 {
+			return nil, errors.ErrResourceNotFound.WithMessage("the schema doesn't exist")
+		}
+		if err = ctrl.deleteSchemaVersions(ctx, versions); err != nil {
+			return nil, errors.ErrInternal.WithMessage("delete schema failed").Wrap(err)
+		}
+		return &emptypb.Empty{}, nil
+	}
+
+	key := metadata.GetSchemaKey(req.Eventbus, req.Type, req.Version)
+	exist, err := ctrl.kvStore.Exists(ctx, key)
+	if err != nil {
+		return nil, errors.ErrInternal.WithMessage("get schema failed").Wrap(err)
+	}
+	if !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("the schema doesn't exist")
+	}
+	if err = ctrl.kvStore.Delete(ctx, key); err != nil {
+		return nil, errors.ErrInternal.WithMessage("delete schema failed").Wrap(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// listSchemas returns the schemas of eventbus in order of type and version, all types are
+// returned if eventType is empty.
+func (ctrl *controller) listSchemas(ctx context.Context, eventbus, eventType string) ([]*metadata.Schema, error) {
+	key := metadata.GetSchemasKey(eventbus)
+	if eventType != "" {
+		key = metadata.GetSchemaVersionsKey(eventbus, eventType)
+	}
+	pairs, err := ctrl.kvStore.List(ctx, key)
+	if err != nil {
+		return nil, errors.ErrInternal.WithMessage("list schemas failed").Wrap(err)
+	}
+	schemas := make([]*metadata.Schema, 0, len(pairs))
+	for _, pair := range pairs {
+		s := &metadata.Schema{}
+		if err = json.Unmarshal(pair.Value, s); err != nil {
+			log.Warning(ctx, "unmarshal schema failed", map[string]interface{}{
+				log.KeyError: err,
+				"key":        pair.Key,
+			})
+			continue
+		}
+		// skip the eventbuses and types which have same prefix.
+		if s.Eventbus != eventbus || (eventType != "" && s.Type != eventType) {
+			continue
+		}
+		schemas = append(schemas, s)
+	}
+	sort.Slice(schemas, func(i, j int) bool {
+		if schemas[i].Type != schemas[j].Type {
+			return schemas[i].Type < schemas[j].Type
+		}
+		return schemas[i].Version < schemas[j].Version
+	})
+	return schemas, nil
+}
+
+func (ctrl *controller) deleteSchemaVersions(ctx context.Context, schemas []*metadata.Schema) error {
+	for _, s := range schemas {
+		if err := ctrl.kvStore.Delete(ctx, metadata.GetSchemaKey(s.Eventbus, s.Type, s.Version)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ctrl *controller) deleteSchemas(ctx context.Context, eventbus string) error {
+	ctrl.schemaMutex.Lock()
+	defer ctrl.schemaMutex.Unlock()
+
+	schemas, err := ctrl.listSchemas(ctx, eventbus, "")
+	if err != nil {
+		return err
+	}
+	return ctrl.deleteSchemaVersions(ctx, schemas)
+}
+
+func compileSchema(format metapb.Schema_Format, definition string) (*schema.JSONSchema, error) {
+	if format != metapb.Schema_JSON_SCHEMA {
+		return nil, errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("the format %s of schema isn't supported yet", format))
+	}
+	s, err := schema.CompileJSONSchema([]byte(definition))
+	if err != nil {
+		return nil, errors.ErrInvalidRequest.WithMessage(fmt.Sprintf("invalid schema: %s", err))
+	}
+	return s, nil
+}
+
+func toCompatibility(c metapb.Schema_Compatibility) schema.Compatibility {
+	switch c {
+	case metapb.Schema_FORWARD:
+		return schema.Forward
+	case metapb.Schema_FULL:
+		return schema.Full
+	case metapb.Schema_NONE:
+		return schema.None
+	default:
+		return schema.Backward
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	stdCtx "context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func schemaPair(eventbus, eventType string, version uint32, definition string) kv.Pair {
+	s := &metadata.Schema{
+		Eventbus:   eventbus,
+		Type:       eventType,
+		Version:    version,
+		Format:     metapb.Schema_JSON_SCHEMA,
+		Definition: definition,
+	}
+	data, _ := json.Marshal(s)
+	return kv.Pair{Key: metadata.GetSchemaKey(eventbus, eventType, version), Value: data}
+}
+
+func TestController_Schema(t *testing.T) {
+	Convey("test schema", t, func() {
+		ctrl := NewController(Config{}, nil)
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		kvCli := kv.NewMockClient(mockCtrl)
+		ctrl.kvStore = kvCli
+		ctx := stdCtx.Background()
+		v1 := `{"type":"object","properties":{"id":{"type":"string"}}}`
+
+		Convey("test register schema", func() {
+			req := &ctrlpb.RegisterSchemaRequest{
+				Eventbus:   "test-1",
+				Type:       "order.created",
+				Format:     metapb.Schema_JSON_SCHEMA,
+				Definition: v1,
+			}
+			_, err := ctrl.RegisterSchema(ctx, req)
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+
+			ctrl.eventBusMap["test-1"] = &metadata.Eventbus{ID: vanus.NewTestID(), Name: "test-1"}
+			_, err = ctrl.RegisterSchema(ctx, &ctrlpb.RegisterSchemaRequest{
+				Eventbus:   "test-1",
+				Type:       "order.created",
+				Format:     metapb.Schema_AVRO,
+				Definition: v1,
+			})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+			versionsKey := metadata.GetSchemaVersionsKey("test-1", "order.created")
+			kvCli.EXPECT().List(ctx, versionsKey).Times(1).Return(nil, nil)
+			kvCli.EXPECT().Set(ctx, metadata.GetSchemaKey("test-1", "order.created", 1), gomock.Any()).
+				Times(1).Return(nil)
+			s, err := ctrl.RegisterSchema(ctx, req)
+			So(err, ShouldBeNil)
+			So(s.Version, ShouldEqual, 1)
+			So(s.Uri, ShouldEqual, "vanus://schemas/test-1/order.created/1")
+
+			pairs := []kv.Pair{schemaPair("test-1", "order.created", 1, v1)}
+			kvCli.EXPECT().List(ctx, versionsKey).Times(1).Return(pairs, nil)
+			s, err = ctrl.RegisterSchema(ctx, req)
+			So(err, ShouldBeNil)
+			So(s.Version, ShouldEqual, 1)
+
+			kvCli.EXPECT().List(ctx, versionsKey).Times(1).Return(pairs, nil)
+			req.Definition = `{"type":"object","properties":{"id":{"type":"string"}},"required":["id"]}`
+			_, err = ctrl.RegisterSchema(ctx, req)
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+			kvCli.EXPECT().List(ctx, versionsKey).Times(1).Return(pairs, nil)
+			kvCli.EXPECT().Set(ctx, metadata.GetSchemaKey("test-1", "order.created", 2), gomock.Any()).
+				Times(1).Return(nil)
+			req.Compatibility = metapb.Schema_NONE
+			s, err = ctrl.RegisterSchema(ctx, req)
+			So(err, ShouldBeNil)
+			So(s.Version, ShouldEqual, 2)
+		})
+
+		Convey("test get and list schema", func() {
+			pairs := []kv.Pair{
+				schemaPair("test-1", "order.created", 2, v1),
+				schemaPair("test-1", "order.created", 1, v1),
+				schemaPair("test-1", "order", 1, v1),
+				schemaPair("test-10", "order.created", 1, v1),
+			}
+			kvCli.EXPECT().List(ctx, metadata.GetSchemasKey("test-1")).Times(1).Return(pairs, nil)
+			res, err := ctrl.ListSchema(ctx, &ctrlpb.ListSchemaRequest{Eventbus: "test-1"})
+			So(err, ShouldBeNil)
+			So(res.Schemas, ShouldHaveLength, 3)
+			So(res.Schemas[0].Type, ShouldEqual, "order")
+			So(res.Schemas[2].Version, ShouldEqual, 2)
+
+			versionsKey := metadata.GetSchemaVersionsKey("test-1", "order.created")
+			kvCli.EXPECT().List(ctx, versionsKey).Times(1).Return(pairs, nil)
+			s, err := ctrl.GetSchema(ctx, &ctrlpb.GetSchemaRequest{Eventbus: "test-1", Type: "order.created"})
+			So(err, ShouldBeNil)
+			So(s.Version, ShouldEqual, 2)
+
+			pair := schemaPair("test-1", "order.created", 1, v1)
+			kvCli.EXPECT().Get(ctx, pair.Key).Times(1).Return(pair.Value, nil)
+			s, err = ctrl.GetSchema(ctx, &ctrlpb.GetSchemaRequest{
+				Eventbus: "test-1", Type: "order.created", Version: 1,
+			})
+			So(err, ShouldBeNil)
+			So(s.Definition, ShouldEqual, v1)
+
+			kvCli.EXPECT().Get(ctx, gomock.Any()).Times(1).Return(nil, kv.ErrKeyNotFound)
+			_, err = ctrl.GetSchema(ctx, &ctrlpb.GetSchemaRequest{
+				Eventbus: "test-1", Type: "order.created", Version: 3,
+			})
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+		})
+
+		Convey("test delete schema", func() {
+			key := metadata.GetSchemaKey("test-1", "order.created", 1)
+			kvCli.EXPECT().Exists(ctx, key).Times(1).Return(false, nil)
+			_, err := ctrl.DeleteSchema(ctx, &ctrlpb.DeleteSchemaRequest{
+				Eventbus: "test-1", Type: "order.created", Version: 1,
+			})
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+
+			pairs := []kv.Pair{
+				schemaPair("test-1", "order.created", 1, v1),
+				schemaPair("test-1", "order.created", 2, v1),
+			}
+			kvCli.EXPECT().List(ctx, metadata.GetSchemaVersionsKey("test-1", "order.created")).
+				Times(1).Return(pairs, nil)
+			kvCli.EXPECT().Delete(ctx, pairs[0].Key).Times(1).Return(nil)
+			kvCli.EXPECT().Delete(ctx, pairs[1].Key).Times(1).Return(nil)
+			_, err = ctrl.DeleteSchema(ctx, &ctrlpb.DeleteSchemaRequest{Eventbus: "test-1", Type: "order.created"})
+			So(err, ShouldBeNil)
+		})
+	})
+}
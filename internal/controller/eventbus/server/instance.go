@@ -32,8 +32,18 @@ type Instance interface {
 	GetMeta() *metadata.VolumeMetadata
 	CreateBlock(context.Context, int64) (*metadata.Block, error)
 	DeleteBlock(context.Context, vanus.ID) error
+	// RemoveReplica deletes the block which is a replica of living segment, the object in tiered
+	// storage is kept because the other replicas share it.
+	RemoveReplica(context.Context, vanus.ID) error
 	GetServer() Server
 	SetServer(Server)
+	// UpdateUsage updates the capacity and used bytes reported by segment server, a zero
+	// capacity is ignored.
+	UpdateUsage(capacity, used int64)
+	// Usage returns the capacity and used bytes of the volume.
+	Usage() (int64, int64)
+	SetLabels(labels map[string]string)
+	Labels() map[string]string
 }
 
 func NewInstance(md *metadata.VolumeMetadata) Instance {
@@ -82,6 +92,15 @@ func (ins *volumeInstance) CreateBlock(ctx context.Context, capacity int64) (*me
 }
 
 func (ins *volumeInstance) DeleteBlock(ctx context.Context, id vanus.ID) error {
+	return ins.removeBlock(ctx, &segpb.RemoveBlockRequest{Id: id.Uint64()})
+}
+
+func (ins *volumeInstance) RemoveReplica(ctx context.Context, id vanus.ID) error {
+	return ins.removeBlock(ctx, &segpb.RemoveBlockRequest{Id: id.Uint64(), KeepObject: true})
+}
+
+func (ins *volumeInstance) removeBlock(ctx context.Context, req *segpb.RemoveBlockRequest) error {
+	id := vanus.NewIDFromUint64(req.Id)
 	if ins.srv == nil {
 		return errors.ErrVolumeInstanceNoServer
 	}
@@ -92,7 +111,7 @@ func (ins *volumeInstance) DeleteBlock(ctx context.Context, id vanus.ID) error {
 	if ins.srv == nil {
 		return nil
 	}
-	_, err := ins.srv.GetClient().RemoveBlock(ctx, &segpb.RemoveBlockRequest{Id: id.Uint64()})
+	_, err := ins.srv.GetClient().RemoveBlock(ctx, req)
 	if err != nil {
 		return err
 	}
@@ -154,3 +173,31 @@ func (ins *volumeInstance) GetServer() Server {
 	}
 	return ins.srv
 }
+
+func (ins *volumeInstance) UpdateUsage(capacity, used int64) {
+	if capacity <= 0 {
+		return
+	}
+	ins.metaMutex.Lock()
+	defer ins.metaMutex.Unlock()
+	ins.md.Capacity = capacity
+	ins.md.Used = used
+}
+
+func (ins *volumeInstance) Usage() (int64, int64) {
+	ins.metaMutex.Lock()
+	defer ins.metaMutex.Unlock()
+	return ins.md.Capacity, ins.md.Used
+}
+
+func (ins *volumeInstance) SetLabels(labels map[string]string) {
+	ins.metaMutex.Lock()
+	defer ins.metaMutex.Unlock()
+	ins.md.Labels = labels
+}
+
+func (ins *volumeInstance) Labels() map[string]string {
+	ins.metaMutex.Lock()
+	defer ins.metaMutex.Unlock()
+	return ins.md.Labels
+}
@@ -93,6 +93,7 @@ func TestVolumeInstance(t *testing.T) {
 
 		f2 := func(ctx stdCtx.Context, in *segpb.RemoveBlockRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 			So(in.Id, ShouldEqual, block.ID.Uint64())
+			So(in.KeepObject, ShouldBeFalse)
 			return &empty.Empty{}, nil
 		}
 		segCli.EXPECT().RemoveBlock(ctx, gomock.Any(), gomock.Any()).Times(1).DoAndReturn(f2)
@@ -102,5 +103,29 @@ func TestVolumeInstance(t *testing.T) {
 		So(md.Used, ShouldEqual, 64*1024*1024)
 		So(md.Blocks[block.ID.Uint64()], ShouldBeNil)
 		So(md.Blocks[block2.ID.Uint64()], ShouldEqual, block2)
+
+		ins.UpdateUsage(0, 0)
+		capacity, used := ins.Usage()
+		So(capacity, ShouldEqual, md.Capacity)
+		So(used, ShouldEqual, 64*1024*1024)
+
+		ins.UpdateUsage(1024*1024*1024, 128*1024*1024)
+		capacity, used = ins.Usage()
+		So(capacity, ShouldEqual, 1024*1024*1024)
+		So(used, ShouldEqual, 128*1024*1024)
+
+		ins.SetLabels(map[string]string{"zone": "a"})
+		So(ins.Labels(), ShouldResemble, map[string]string{"zone": "a"})
+
+		f3 := func(ctx stdCtx.Context, in *segpb.RemoveBlockRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+			So(in.Id, ShouldEqual, block2.ID.Uint64())
+			So(in.KeepObject, ShouldBeTrue)
+			return &empty.Empty{}, nil
+		}
+		segCli.EXPECT().RemoveBlock(ctx, gomock.Any(), gomock.Any()).Times(1).DoAndReturn(f3)
+
+		err = ins.RemoveReplica(ctx, block2.ID)
+		So(err, ShouldBeNil)
+		So(md.Blocks[block2.ID.Uint64()], ShouldBeNil)
 	})
 }
@@ -25,10 +25,10 @@ import (
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/pkg/errors"
+	"github.com/linkall-labs/vanus/pkg/util/tlsconfig"
 	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 type Manager interface {
@@ -48,7 +48,7 @@ const (
 func NewServerManager() Manager {
 	return &segmentServerManager{
 		ticker:                   time.NewTicker(time.Second),
-		segmentServerCredentials: insecure.NewCredentials(),
+		segmentServerCredentials: tlsconfig.ClientCredentials(),
 	}
 }
 
@@ -260,7 +260,7 @@ func NewSegmentServer(addr string) (Server, error) {
 		lastHeartbeatTime: time.Now(),
 	}
 	var opts []grpc.DialOption
-	opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	opts = append(opts, grpc.WithTransportCredentials(tlsconfig.ClientCredentials()))
 	conn, err := grpc.Dial(addr, opts...)
 	if err != nil {
 		return nil, err
@@ -135,6 +135,46 @@ func (mr *MockInstanceMockRecorder) ID() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ID", reflect.TypeOf((*MockInstance)(nil).ID))
 }
 
+// Labels mocks base method.
+func (m *MockInstance) Labels() map[string]string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Labels")
+	ret0, _ := ret[0].(map[string]string)
+	return ret0
+}
+
+// Labels indicates an expected call of Labels.
+func (mr *MockInstanceMockRecorder) Labels() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Labels", reflect.TypeOf((*MockInstance)(nil).Labels))
+}
+
+// RemoveReplica mocks base method.
+func (m *MockInstance) RemoveReplica(arg0 context.Context, arg1 vanus.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveReplica", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveReplica indicates an expected call of RemoveReplica.
+func (mr *MockInstanceMockRecorder) RemoveReplica(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReplica", reflect.TypeOf((*MockInstance)(nil).RemoveReplica), arg0, arg1)
+}
+
+// SetLabels mocks base method.
+func (m *MockInstance) SetLabels(labels map[string]string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetLabels", labels)
+}
+
+// SetLabels indicates an expected call of SetLabels.
+func (mr *MockInstanceMockRecorder) SetLabels(labels interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLabels", reflect.TypeOf((*MockInstance)(nil).SetLabels), labels)
+}
+
 // SetServer mocks base method.
 func (m *MockInstance) SetServer(arg0 Server) {
 	m.ctrl.T.Helper()
@@ -146,3 +186,30 @@ func (mr *MockInstanceMockRecorder) SetServer(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetServer", reflect.TypeOf((*MockInstance)(nil).SetServer), arg0)
 }
+
+// UpdateUsage mocks base method.
+func (m *MockInstance) UpdateUsage(capacity, used int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateUsage", capacity, used)
+}
+
+// UpdateUsage indicates an expected call of UpdateUsage.
+func (mr *MockInstanceMockRecorder) UpdateUsage(capacity, used interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUsage", reflect.TypeOf((*MockInstance)(nil).UpdateUsage), capacity, used)
+}
+
+// Usage mocks base method.
+func (m *MockInstance) Usage() (int64, int64) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Usage")
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(int64)
+	return ret0, ret1
+}
+
+// Usage indicates an expected call of Usage.
+func (mr *MockInstanceMockRecorder) Usage() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Usage", reflect.TypeOf((*MockInstance)(nil).Usage))
+}
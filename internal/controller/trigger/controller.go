@@ -19,6 +19,7 @@ import (
 	stdErr "errors"
 	"io"
 	"os"
+	"reflect"
 	"sync"
 	"time"
 
@@ -37,9 +38,9 @@ import (
 	"github.com/linkall-labs/vanus/pkg/cluster"
 	"github.com/linkall-labs/vanus/pkg/errors"
 	"github.com/linkall-labs/vanus/pkg/util"
+	"github.com/linkall-labs/vanus/pkg/util/tlsconfig"
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	"github.com/linkall-labs/vanus/proto/pkg/meta"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -57,7 +58,7 @@ func NewController(config Config, controllerAddr []string, member embedetcd.Memb
 		member:                member,
 		needCleanSubscription: map[vanus.ID]string{},
 		state:                 primitive.ServerStateCreated,
-		cl:                    cluster.NewClusterController(controllerAddr, insecure.NewCredentials()),
+		cl:                    cluster.NewClusterController(controllerAddr, tlsconfig.ClientCredentials()),
 	}
 	ctrl.ctx, ctrl.stopFunc = context.WithCancel(context.Background())
 	return ctrl
@@ -88,10 +89,20 @@ func (ctrl *controller) CommitOffset(ctx context.Context,
 	}
 	resp := new(ctrlpb.CommitOffsetResponse)
 	for _, subInfo := range request.SubscriptionInfo {
+		id := vanus.ID(subInfo.SubscriptionId)
+		if len(subInfo.Checkpoint) != 0 {
+			if err := ctrl.subscriptionManager.SaveCheckpoint(ctx, id, subInfo.Checkpoint); err != nil {
+				resp.FailSubscriptionId = append(resp.FailSubscriptionId, subInfo.SubscriptionId)
+				log.Warning(ctx, "commit checkpoint error", map[string]interface{}{
+					log.KeyError:          err,
+					log.KeySubscriptionID: id,
+				})
+				continue
+			}
+		}
 		if len(subInfo.Offsets) == 0 {
 			continue
 		}
-		id := vanus.ID(subInfo.SubscriptionId)
 		offsets := convert.FromPbOffsetInfos(subInfo.Offsets)
 		err := ctrl.subscriptionManager.SaveOffset(ctx, id, offsets, request.ForceCommit)
 		if err != nil {
@@ -118,6 +129,9 @@ func (ctrl *controller) ResetOffsetToTimestamp(ctx context.Context,
 	if sub == nil {
 		return nil, errors.ErrResourceNotFound.WithMessage("subscription not exist")
 	}
+	if sub.Pull {
+		return nil, errors.ErrInvalidRequest.WithMessage("pull subscription can not reset offset")
+	}
 	if sub.Phase != metadata.SubscriptionPhaseRunning {
 		return nil, errors.ErrResourceCanNotOp.WithMessage("subscription is not running")
 	}
@@ -129,6 +143,12 @@ func (ctrl *controller) ResetOffsetToTimestamp(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	if sub.Aggregation != nil {
+		// the open windows are rebuilt from the reset offsets.
+		if err = ctrl.subscriptionManager.DeleteCheckpoint(ctx, subID); err != nil {
+			return nil, err
+		}
+	}
 	return &emptypb.Empty{}, nil
 }
 
@@ -177,12 +197,21 @@ func (ctrl *controller) UpdateSubscription(ctx context.Context,
 	if request.Subscription.EventBus != sub.EventBus {
 		return nil, errors.ErrInvalidRequest.WithMessage("can not change eventbus")
 	}
+	if request.Subscription.Pull != sub.Pull {
+		return nil, errors.ErrInvalidRequest.WithMessage("can not change pull mode")
+	}
 	if request.Subscription.Config != nil {
 		if request.Subscription.Config.DeadLetterEventbus != sub.Config.DeadLetterEventbus {
 			return nil, errors.ErrInvalidRequest.WithMessage("can not change dead letter eventbus")
 		}
+		if request.Subscription.Config.OrderKey != sub.Config.OrderKey {
+			return nil, errors.ErrInvalidRequest.WithMessage("can not change order key")
+		}
 	}
 	update := convert.FromPbSubscriptionRequest(request.Subscription)
+	if !reflect.DeepEqual(update.Aggregation, sub.Aggregation) {
+		return nil, errors.ErrInvalidRequest.WithMessage("can not change aggregation")
+	}
 	transChange := 0
 	if !sub.Transformer.Exist() && update.Transformer.Exist() {
 		transChange = 1
@@ -245,6 +274,54 @@ func (ctrl *controller) GetSubscription(ctx context.Context,
 	return resp, nil
 }
 
+func (ctrl *controller) CreateDeadLetterOperation(ctx context.Context,
+	request *meta.DeadLetterOperation) (*meta.DeadLetterOperation, error) {
+	if ctrl.state != primitive.ServerStateRunning {
+		return nil, errors.ErrServerNotStart
+	}
+	subID := vanus.ID(request.SubscriptionId)
+	if sub := ctrl.subscriptionManager.GetSubscription(ctx, subID); sub == nil {
+		return nil, errors.ErrResourceNotFound.WithMessage("subscription not exist")
+	}
+	op := convert.FromPbDeadLetterOperation(request)
+	id, err := vanus.NewID()
+	if err != nil {
+		return nil, err
+	}
+	op.ID = id
+	op.CreatedAt = time.Now()
+	if err = ctrl.storage.CreateDeadLetterOperation(ctx, op); err != nil {
+		return nil, errors.ErrInternal.WithMessage("save dead letter operation failed").Wrap(err)
+	}
+	log.Info(ctx, "dead letter operation created", map[string]interface{}{
+		log.KeySubscriptionID: subID,
+		"type":                op.Type,
+		"operator":            op.Operator,
+		"event_number":        len(op.Events),
+	})
+	return convert.ToPbDeadLetterOperation(op), nil
+}
+
+func (ctrl *controller) ListDeadLetterOperation(ctx context.Context,
+	request *ctrlpb.ListDeadLetterOperationRequest) (*ctrlpb.ListDeadLetterOperationResponse, error) {
+	if ctrl.state != primitive.ServerStateRunning {
+		return nil, errors.ErrServerNotStart
+	}
+	subID := vanus.ID(request.SubscriptionId)
+	if sub := ctrl.subscriptionManager.GetSubscription(ctx, subID); sub == nil {
+		return nil, errors.ErrResourceNotFound.WithMessage("subscription not exist")
+	}
+	ops, err := ctrl.storage.ListDeadLetterOperation(ctx, subID)
+	if err != nil {
+		return nil, errors.ErrInternal.WithMessage("list dead letter operation failed").Wrap(err)
+	}
+	list := make([]*meta.DeadLetterOperation, 0, len(ops))
+	for _, op := range ops {
+		list = append(list, convert.ToPbDeadLetterOperation(op))
+	}
+	return &ctrlpb.ListDeadLetterOperationResponse{Operations: list}, nil
+}
+
 func (ctrl *controller) TriggerWorkerHeartbeat(
 	heartbeat ctrlpb.TriggerController_TriggerWorkerHeartbeatServer) error {
 	ctx := ctrl.ctx
@@ -300,6 +377,15 @@ func (ctrl *controller) triggerWorkerHeartbeatRequest(ctx context.Context,
 		return errors.ErrResourceNotFound.WithMessage("unknown trigger worker")
 	}
 	for _, subInfo := range req.SubscriptionInfo {
+		if len(subInfo.Checkpoint) != 0 {
+			err = ctrl.subscriptionManager.SaveCheckpoint(ctx, vanus.ID(subInfo.SubscriptionId), subInfo.Checkpoint)
+			if err != nil {
+				log.Warning(ctx, "heartbeat commit checkpoint error", map[string]interface{}{
+					log.KeyError:          err,
+					log.KeySubscriptionID: subInfo.SubscriptionId,
+				})
+			}
+		}
 		if len(subInfo.Offsets) == 0 {
 			continue
 		}
@@ -356,7 +442,8 @@ func (ctrl *controller) ListSubscription(ctx context.Context,
 //
 // 1.trigger worker remove subscription
 // 2.delete offset
-// 3.delete subscription .
+// 3.delete subscription
+// 4.delete dead letter operations .
 func (ctrl *controller) gcSubscription(ctx context.Context, id vanus.ID, addr string) error {
 	tWorker := ctrl.workerManager.GetTriggerWorker(addr)
 	if tWorker != nil {
@@ -366,6 +453,12 @@ func (ctrl *controller) gcSubscription(ctx context.Context, id vanus.ID, addr st
 	if err != nil {
 		return err
 	}
+	if err = ctrl.storage.DeleteDeadLetterOperation(ctx, id); err != nil {
+		log.Warning(ctx, "delete dead letter operation error", map[string]interface{}{
+			log.KeyError:          err,
+			log.KeySubscriptionID: id,
+		})
+	}
 	return nil
 }
 
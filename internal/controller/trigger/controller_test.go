@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/storage"
 	"github.com/linkall-labs/vanus/internal/controller/trigger/subscription"
 	"github.com/linkall-labs/vanus/internal/controller/trigger/worker"
 	"github.com/linkall-labs/vanus/internal/primitive"
@@ -385,7 +386,11 @@ func TestController_DeleteSubscription(t *testing.T) {
 			workerManager.EXPECT().GetTriggerWorker(addr).Return(tWorker)
 			tWorker.EXPECT().UnAssignSubscription(gomock.Eq(subID)).Return()
 			Convey("delete subscription success", func() {
+				mockStorage := storage.NewMockStorage(mockCtrl)
+				ctrl.storage = mockStorage
 				subManager.EXPECT().DeleteSubscription(gomock.Any(), gomock.Eq(subID)).Return(nil)
+				mockStorage.MockDeadLetterStorage.EXPECT().DeleteDeadLetterOperation(gomock.Any(),
+					gomock.Eq(subID)).Return(nil)
 				_, err := ctrl.DeleteSubscription(ctx, request)
 				So(err, ShouldBeNil)
 				So(sub.Phase, ShouldEqual, metadata.SubscriptionPhaseToDelete)
@@ -447,6 +452,67 @@ func TestController_GetSubscription(t *testing.T) {
 	})
 }
 
+func TestController_DeadLetterOperation(t *testing.T) {
+	Convey("test dead letter operation", t, func() {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ctrl := NewController(Config{}, nil, nil)
+		ctx := context.Background()
+		subManager := subscription.NewMockManager(mockCtrl)
+		ctrl.subscriptionManager = subManager
+		mockStorage := storage.NewMockStorage(mockCtrl)
+		ctrl.storage = mockStorage
+		ctrl.state = primitive.ServerStateRunning
+		subID := vanus.NewTestID()
+
+		Convey("subscription not exist", func() {
+			subManager.EXPECT().GetSubscription(gomock.Any(), gomock.Eq(subID)).Times(2).Return(nil)
+			_, err := ctrl.CreateDeadLetterOperation(ctx, &metapb.DeadLetterOperation{SubscriptionId: subID.Uint64()})
+			So(err, ShouldNotBeNil)
+			_, err = ctrl.ListDeadLetterOperation(ctx, &ctrlpb.ListDeadLetterOperationRequest{
+				SubscriptionId: subID.Uint64(),
+			})
+			So(err, ShouldNotBeNil)
+		})
+		Convey("create operation", func() {
+			subManager.EXPECT().GetSubscription(gomock.Any(), gomock.Eq(subID)).Return(&metadata.Subscription{ID: subID})
+			var saved *metadata.DeadLetterOperation
+			mockStorage.MockDeadLetterStorage.EXPECT().CreateDeadLetterOperation(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, op *metadata.DeadLetterOperation) error {
+					saved = op
+					return nil
+				})
+			resp, err := ctrl.CreateDeadLetterOperation(ctx, &metapb.DeadLetterOperation{
+				SubscriptionId: subID.Uint64(),
+				Type:           metapb.DeadLetterOperation_PURGE,
+				Filter:         &metapb.DeadLetterFilter{Reason: "test"},
+				Events:         []*metapb.EventPosition{{EventlogId: 1, Offset: 2}},
+				Operator:       "admin",
+			})
+			So(err, ShouldBeNil)
+			So(resp.Id, ShouldNotEqual, 0)
+			So(resp.CreatedAt, ShouldBeGreaterThan, 0)
+			So(saved.Type, ShouldEqual, metadata.DeadLetterOperationPurge)
+			So(saved.Reason, ShouldEqual, "test")
+			So(saved.Events, ShouldResemble, []metadata.EventPosition{{EventlogID: 1, Offset: 2}})
+			So(saved.Operator, ShouldEqual, "admin")
+		})
+		Convey("list operation", func() {
+			subManager.EXPECT().GetSubscription(gomock.Any(), gomock.Eq(subID)).Return(&metadata.Subscription{ID: subID})
+			mockStorage.MockDeadLetterStorage.EXPECT().ListDeadLetterOperation(gomock.Any(), gomock.Eq(subID)).
+				Return([]*metadata.DeadLetterOperation{
+					{ID: 1, SubscriptionID: subID, Type: metadata.DeadLetterOperationReplay, CreatedAt: time.Now()},
+				}, nil)
+			resp, err := ctrl.ListDeadLetterOperation(ctx, &ctrlpb.ListDeadLetterOperationRequest{
+				SubscriptionId: subID.Uint64(),
+			})
+			So(err, ShouldBeNil)
+			So(resp.Operations, ShouldHaveLength, 1)
+			So(resp.Operations[0].Type, ShouldEqual, metapb.DeadLetterOperation_REPLAY)
+		})
+	})
+}
+
 func TestController_ListSubscription(t *testing.T) {
 	Convey("test list subscription", t, func() {
 		mockCtrl := gomock.NewController(t)
@@ -0,0 +1,48 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+)
+
+type DeadLetterOperationType string
+
+const (
+	DeadLetterOperationReplay DeadLetterOperationType = "replay"
+	DeadLetterOperationPurge  DeadLetterOperationType = "purge"
+)
+
+type EventPosition struct {
+	EventlogID vanus.ID `json:"eventlog_id"`
+	Offset     uint64   `json:"offset"`
+}
+
+// DeadLetterOperation is the audit record of replaying or purging the dead-lettered events of
+// a subscription, the purged events are hidden from the later operations.
+type DeadLetterOperation struct {
+	ID             vanus.ID                `json:"id"`
+	SubscriptionID vanus.ID                `json:"subscription_id"`
+	Type           DeadLetterOperationType `json:"type"`
+	Reason         string                  `json:"reason,omitempty"`
+	StartTime      int64                   `json:"start_time,omitempty"`
+	EndTime        int64                   `json:"end_time,omitempty"`
+	Selected       []EventPosition         `json:"selected,omitempty"`
+	Events         []EventPosition         `json:"events"`
+	Operator       string                  `json:"operator,omitempty"`
+	CreatedAt      time.Time               `json:"created_at"`
+}
@@ -78,6 +78,8 @@ type Subscription struct {
 	Name               string                          `json:"name"`
 	Disable            bool                            `json:"disable"`
 	Description        string                          `json:"description"`
+	Pull               bool                            `json:"pull,omitempty"`
+	Aggregation        *primitive.Aggregation          `json:"aggregation,omitempty"`
 	CreatedAt          time.Time                       `json:"created_at"`
 	UpdatedAt          time.Time                       `json:"updated_at"`
 
@@ -0,0 +1,67 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate mockgen -source=checkpoint.go  -destination=mock_checkpoint.go -package=storage
+package storage
+
+import (
+	"context"
+	"errors"
+	"path"
+
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+)
+
+// CheckpointStorage stores the opaque state of stateful subscriptions, like the open windows of
+// an aggregation subscription, the state is only meaningful to the trigger worker.
+type CheckpointStorage interface {
+	SaveCheckpoint(ctx context.Context, subscriptionID vanus.ID, checkpoint []byte) error
+	// GetCheckpoint returns nil if the subscription hasn't a checkpoint.
+	GetCheckpoint(ctx context.Context, subscriptionID vanus.ID) ([]byte, error)
+	DeleteCheckpoint(ctx context.Context, subscriptionID vanus.ID) error
+}
+
+type checkpointStorage struct {
+	client kv.Client
+}
+
+func NewCheckpointStorage(client kv.Client) CheckpointStorage {
+	return &checkpointStorage{
+		client: client,
+	}
+}
+
+func (s *checkpointStorage) getKey(subscriptionID vanus.ID) string {
+	return path.Join(KeyPrefixCheckpoint.String(), subscriptionID.String())
+}
+
+func (s *checkpointStorage) SaveCheckpoint(ctx context.Context, subscriptionID vanus.ID, checkpoint []byte) error {
+	return s.client.Set(ctx, s.getKey(subscriptionID), checkpoint)
+}
+
+func (s *checkpointStorage) GetCheckpoint(ctx context.Context, subscriptionID vanus.ID) ([]byte, error) {
+	v, err := s.client.Get(ctx, s.getKey(subscriptionID))
+	if err != nil {
+		if errors.Is(err, kv.ErrKeyNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return v, nil
+}
+
+func (s *checkpointStorage) DeleteCheckpoint(ctx context.Context, subscriptionID vanus.ID) error {
+	return s.client.Delete(ctx, s.getKey(subscriptionID))
+}
@@ -0,0 +1,58 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	kvClient := kv.NewMockClient(ctrl)
+	s := NewCheckpointStorage(kvClient).(*checkpointStorage)
+	subID := vanus.ID(1)
+	checkpoint := []byte(`{"windows":[]}`)
+	Convey("save checkpoint", t, func() {
+		kvClient.EXPECT().Set(ctx, s.getKey(subID), checkpoint).Return(nil)
+		err := s.SaveCheckpoint(ctx, subID, checkpoint)
+		So(err, ShouldBeNil)
+	})
+	Convey("get checkpoint", t, func() {
+		kvClient.EXPECT().Get(ctx, s.getKey(subID)).Return(checkpoint, nil)
+		v, err := s.GetCheckpoint(ctx, subID)
+		So(err, ShouldBeNil)
+		So(v, ShouldResemble, checkpoint)
+	})
+	Convey("get not exist checkpoint", t, func() {
+		kvClient.EXPECT().Get(ctx, s.getKey(subID)).Return(nil, kv.ErrKeyNotFound)
+		v, err := s.GetCheckpoint(ctx, subID)
+		So(err, ShouldBeNil)
+		So(v, ShouldBeNil)
+	})
+	Convey("delete checkpoint", t, func() {
+		kvClient.EXPECT().Delete(ctx, s.getKey(subID)).Return(nil)
+		err := s.DeleteCheckpoint(ctx, subID)
+		So(err, ShouldBeNil)
+	})
+}
@@ -25,4 +25,6 @@ const (
 	KeyPrefixSubscription  KeyPrefix = "/trigger/subscriptions/"
 	KeyPrefixTriggerWorker KeyPrefix = "/trigger/triggerWorkers/"
 	KeyPrefixSecret        KeyPrefix = "/trigger/secret/"
+	KeyPrefixDeadLetter    KeyPrefix = "/trigger/deadletter/"
+	KeyPrefixCheckpoint    KeyPrefix = "/trigger/checkpoints/"
 )
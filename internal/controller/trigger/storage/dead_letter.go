@@ -0,0 +1,85 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate mockgen -source=dead_letter.go  -destination=mock_dead_letter.go -package=storage
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"sort"
+
+	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/pkg/errors"
+)
+
+type DeadLetterStorage interface {
+	CreateDeadLetterOperation(ctx context.Context, op *metadata.DeadLetterOperation) error
+	// ListDeadLetterOperation returns the operations of subscription in the order of creation.
+	ListDeadLetterOperation(ctx context.Context, subscriptionID vanus.ID) ([]*metadata.DeadLetterOperation, error)
+	DeleteDeadLetterOperation(ctx context.Context, subscriptionID vanus.ID) error
+}
+
+type deadLetterStorage struct {
+	client kv.Client
+}
+
+func NewDeadLetterStorage(client kv.Client) DeadLetterStorage {
+	return &deadLetterStorage{
+		client: client,
+	}
+}
+
+func (s *deadLetterStorage) getKey(subscriptionID, id vanus.ID) string {
+	return path.Join(KeyPrefixDeadLetter.String(), subscriptionID.String(), id.String())
+}
+
+func (s *deadLetterStorage) getSubKey(subscriptionID vanus.ID) string {
+	return path.Join(KeyPrefixDeadLetter.String(), subscriptionID.String())
+}
+
+func (s *deadLetterStorage) CreateDeadLetterOperation(ctx context.Context, op *metadata.DeadLetterOperation) error {
+	v, err := json.Marshal(op)
+	if err != nil {
+		return errors.ErrJSONMarshal.Wrap(err)
+	}
+	return s.client.Create(ctx, s.getKey(op.SubscriptionID, op.ID), v)
+}
+
+func (s *deadLetterStorage) ListDeadLetterOperation(ctx context.Context,
+	subscriptionID vanus.ID) ([]*metadata.DeadLetterOperation, error) {
+	l, err := s.client.List(ctx, s.getSubKey(subscriptionID))
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*metadata.DeadLetterOperation, 0, len(l))
+	for _, v := range l {
+		op := &metadata.DeadLetterOperation{}
+		if err = json.Unmarshal(v.Value, op); err != nil {
+			return nil, errors.ErrJSONUnMarshal.Wrap(err)
+		}
+		list = append(list, op)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].CreatedAt.Before(list[j].CreatedAt)
+	})
+	return list, nil
+}
+
+func (s *deadLetterStorage) DeleteDeadLetterOperation(ctx context.Context, subscriptionID vanus.ID) error {
+	return s.client.DeleteDir(ctx, s.getSubKey(subscriptionID))
+}
@@ -0,0 +1,79 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDeadLetterStorage(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	kvClient := kv.NewMockClient(ctrl)
+	s := NewDeadLetterStorage(kvClient).(*deadLetterStorage)
+	subID := vanus.ID(1)
+	now := time.Now()
+	op := &metadata.DeadLetterOperation{
+		ID:             vanus.ID(2),
+		SubscriptionID: subID,
+		Type:           metadata.DeadLetterOperationPurge,
+		Events:         []metadata.EventPosition{{EventlogID: vanus.ID(3), Offset: 10}},
+		CreatedAt:      now,
+	}
+	Convey("create dead letter operation", t, func() {
+		v, _ := json.Marshal(op)
+		kvClient.EXPECT().Create(ctx, s.getKey(subID, op.ID), v).Return(nil)
+		err := s.CreateDeadLetterOperation(ctx, op)
+		So(err, ShouldBeNil)
+	})
+
+	Convey("list dead letter operation", t, func() {
+		older := &metadata.DeadLetterOperation{
+			ID:             vanus.ID(4),
+			SubscriptionID: subID,
+			Type:           metadata.DeadLetterOperationReplay,
+			CreatedAt:      now.Add(-time.Minute),
+		}
+		v1, _ := json.Marshal(op)
+		v2, _ := json.Marshal(older)
+		kvClient.EXPECT().List(ctx, s.getSubKey(subID)).Return([]kv.Pair{
+			{Key: s.getKey(subID, op.ID), Value: v1},
+			{Key: s.getKey(subID, older.ID), Value: v2},
+		}, nil)
+		list, err := s.ListDeadLetterOperation(ctx, subID)
+		So(err, ShouldBeNil)
+		So(list, ShouldHaveLength, 2)
+		So(list[0].ID, ShouldEqual, older.ID)
+		So(list[1].ID, ShouldEqual, op.ID)
+		So(list[1].Events, ShouldResemble, op.Events)
+	})
+
+	Convey("delete dead letter operation", t, func() {
+		kvClient.EXPECT().DeleteDir(ctx, s.getSubKey(subID)).Return(nil)
+		err := s.DeleteDeadLetterOperation(ctx, subID)
+		So(err, ShouldBeNil)
+	})
+}
@@ -27,6 +27,8 @@ type fake struct {
 	subs     map[vanus.ID]*metadata.Subscription
 	offset   map[vanus.ID]map[vanus.ID]pInfo.OffsetInfo
 	tWorkers map[string]*metadata.TriggerWorkerInfo
+	ops      map[vanus.ID][]*metadata.DeadLetterOperation
+	cps      map[vanus.ID][]byte
 }
 
 func NewFakeStorage() Storage {
@@ -34,6 +36,8 @@ func NewFakeStorage() Storage {
 		subs:     map[vanus.ID]*metadata.Subscription{},
 		offset:   map[vanus.ID]map[vanus.ID]pInfo.OffsetInfo{},
 		tWorkers: map[string]*metadata.TriggerWorkerInfo{},
+		ops:      map[vanus.ID][]*metadata.DeadLetterOperation{},
+		cps:      map[vanus.ID][]byte{},
 	}
 	return s
 }
@@ -121,3 +125,32 @@ func (f *fake) ListTriggerWorker(ctx context.Context) ([]*metadata.TriggerWorker
 	}
 	return list, nil
 }
+
+func (f *fake) CreateDeadLetterOperation(ctx context.Context, op *metadata.DeadLetterOperation) error {
+	f.ops[op.SubscriptionID] = append(f.ops[op.SubscriptionID], op)
+	return nil
+}
+
+func (f *fake) ListDeadLetterOperation(ctx context.Context,
+	subscriptionID vanus.ID) ([]*metadata.DeadLetterOperation, error) {
+	return f.ops[subscriptionID], nil
+}
+
+func (f *fake) DeleteDeadLetterOperation(ctx context.Context, subscriptionID vanus.ID) error {
+	delete(f.ops, subscriptionID)
+	return nil
+}
+
+func (f *fake) SaveCheckpoint(ctx context.Context, subscriptionID vanus.ID, checkpoint []byte) error {
+	f.cps[subscriptionID] = checkpoint
+	return nil
+}
+
+func (f *fake) GetCheckpoint(ctx context.Context, subscriptionID vanus.ID) ([]byte, error) {
+	return f.cps[subscriptionID], nil
+}
+
+func (f *fake) DeleteCheckpoint(ctx context.Context, subscriptionID vanus.ID) error {
+	delete(f.cps, subscriptionID)
+	return nil
+}
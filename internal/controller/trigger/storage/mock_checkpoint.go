@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: checkpoint.go
+
+// Package storage is a generated GoMock package.
+package storage
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	vanus "github.com/linkall-labs/vanus/internal/primitive/vanus"
+)
+
+// MockCheckpointStorage is a mock of CheckpointStorage interface.
+type MockCheckpointStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockCheckpointStorageMockRecorder
+}
+
+// MockCheckpointStorageMockRecorder is the mock recorder for MockCheckpointStorage.
+type MockCheckpointStorageMockRecorder struct {
+	mock *MockCheckpointStorage
+}
+
+// NewMockCheckpointStorage creates a new mock instance.
+func NewMockCheckpointStorage(ctrl *gomock.Controller) *MockCheckpointStorage {
+	mock := &MockCheckpointStorage{ctrl: ctrl}
+	mock.recorder = &MockCheckpointStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCheckpointStorage) EXPECT() *MockCheckpointStorageMockRecorder {
+	return m.recorder
+}
+
+// DeleteCheckpoint mocks base method.
+func (m *MockCheckpointStorage) DeleteCheckpoint(ctx context.Context, subscriptionID vanus.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCheckpoint", ctx, subscriptionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCheckpoint indicates an expected call of DeleteCheckpoint.
+func (mr *MockCheckpointStorageMockRecorder) DeleteCheckpoint(ctx, subscriptionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCheckpoint", reflect.TypeOf((*MockCheckpointStorage)(nil).DeleteCheckpoint), ctx, subscriptionID)
+}
+
+// GetCheckpoint mocks base method.
+func (m *MockCheckpointStorage) GetCheckpoint(ctx context.Context, subscriptionID vanus.ID) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCheckpoint", ctx, subscriptionID)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCheckpoint indicates an expected call of GetCheckpoint.
+func (mr *MockCheckpointStorageMockRecorder) GetCheckpoint(ctx, subscriptionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCheckpoint", reflect.TypeOf((*MockCheckpointStorage)(nil).GetCheckpoint), ctx, subscriptionID)
+}
+
+// SaveCheckpoint mocks base method.
+func (m *MockCheckpointStorage) SaveCheckpoint(ctx context.Context, subscriptionID vanus.ID, checkpoint []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCheckpoint", ctx, subscriptionID, checkpoint)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCheckpoint indicates an expected call of SaveCheckpoint.
+func (mr *MockCheckpointStorageMockRecorder) SaveCheckpoint(ctx, subscriptionID, checkpoint interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCheckpoint", reflect.TypeOf((*MockCheckpointStorage)(nil).SaveCheckpoint), ctx, subscriptionID, checkpoint)
+}
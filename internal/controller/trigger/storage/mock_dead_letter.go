@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: dead_letter.go
+
+// Package storage is a generated GoMock package.
+package storage
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	metadata "github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+	vanus "github.com/linkall-labs/vanus/internal/primitive/vanus"
+)
+
+// MockDeadLetterStorage is a mock of DeadLetterStorage interface.
+type MockDeadLetterStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeadLetterStorageMockRecorder
+}
+
+// MockDeadLetterStorageMockRecorder is the mock recorder for MockDeadLetterStorage.
+type MockDeadLetterStorageMockRecorder struct {
+	mock *MockDeadLetterStorage
+}
+
+// NewMockDeadLetterStorage creates a new mock instance.
+func NewMockDeadLetterStorage(ctrl *gomock.Controller) *MockDeadLetterStorage {
+	mock := &MockDeadLetterStorage{ctrl: ctrl}
+	mock.recorder = &MockDeadLetterStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeadLetterStorage) EXPECT() *MockDeadLetterStorageMockRecorder {
+	return m.recorder
+}
+
+// CreateDeadLetterOperation mocks base method.
+func (m *MockDeadLetterStorage) CreateDeadLetterOperation(ctx context.Context, op *metadata.DeadLetterOperation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeadLetterOperation", ctx, op)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDeadLetterOperation indicates an expected call of CreateDeadLetterOperation.
+func (mr *MockDeadLetterStorageMockRecorder) CreateDeadLetterOperation(ctx, op interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeadLetterOperation", reflect.TypeOf((*MockDeadLetterStorage)(nil).CreateDeadLetterOperation), ctx, op)
+}
+
+// DeleteDeadLetterOperation mocks base method.
+func (m *MockDeadLetterStorage) DeleteDeadLetterOperation(ctx context.Context, subscriptionID vanus.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDeadLetterOperation", ctx, subscriptionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDeadLetterOperation indicates an expected call of DeleteDeadLetterOperation.
+func (mr *MockDeadLetterStorageMockRecorder) DeleteDeadLetterOperation(ctx, subscriptionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeadLetterOperation", reflect.TypeOf((*MockDeadLetterStorage)(nil).DeleteDeadLetterOperation), ctx, subscriptionID)
+}
+
+// ListDeadLetterOperation mocks base method.
+func (m *MockDeadLetterStorage) ListDeadLetterOperation(ctx context.Context, subscriptionID vanus.ID) ([]*metadata.DeadLetterOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeadLetterOperation", ctx, subscriptionID)
+	ret0, _ := ret[0].([]*metadata.DeadLetterOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeadLetterOperation indicates an expected call of ListDeadLetterOperation.
+func (mr *MockDeadLetterStorageMockRecorder) ListDeadLetterOperation(ctx, subscriptionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetterOperation", reflect.TypeOf((*MockDeadLetterStorage)(nil).ListDeadLetterOperation), ctx, subscriptionID)
+}
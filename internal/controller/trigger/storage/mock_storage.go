@@ -20,6 +20,8 @@ type MockStorage struct {
 	*MockOffsetStorage
 	*MockSubscriptionStorage
 	*MockTriggerWorkerStorage
+	*MockDeadLetterStorage
+	*MockCheckpointStorage
 }
 
 // NewMockStorage creates a new mock instance.
@@ -28,6 +30,8 @@ func NewMockStorage(ctrl *gomock.Controller) *MockStorage {
 		MockOffsetStorage:        NewMockOffsetStorage(ctrl),
 		MockSubscriptionStorage:  NewMockSubscriptionStorage(ctrl),
 		MockTriggerWorkerStorage: NewMockTriggerWorkerStorage(ctrl),
+		MockDeadLetterStorage:    NewMockDeadLetterStorage(ctrl),
+		MockCheckpointStorage:    NewMockCheckpointStorage(ctrl),
 	}
 	return mock
 }
@@ -95,6 +95,27 @@ func (p *SecretStorage) Read(ctx context.Context,
 			return nil, errors.ErrAESDecrypt.Wrap(err)
 		}
 		return primitive.NewPlainSinkCredential(identifier, secret), nil
+	case primitive.Kafka:
+		credential := &primitive.KafkaSinkCredential{}
+		if err = json.Unmarshal(v, credential); err != nil {
+			return nil, errors.ErrJSONUnMarshal.Wrap(err)
+		}
+		if credential.Password, err = crypto.AESDecrypt(credential.Password, p.cipherKey); err != nil {
+			return nil, errors.ErrAESDecrypt.Wrap(err)
+		}
+		if credential.ClientKey, err = crypto.AESDecrypt(credential.ClientKey, p.cipherKey); err != nil {
+			return nil, errors.ErrAESDecrypt.Wrap(err)
+		}
+		return credential, nil
+	case primitive.TLS:
+		credential := &primitive.TLSSinkCredential{}
+		if err = json.Unmarshal(v, credential); err != nil {
+			return nil, errors.ErrJSONUnMarshal.Wrap(err)
+		}
+		if credential.ClientKey, err = crypto.AESDecrypt(credential.ClientKey, p.cipherKey); err != nil {
+			return nil, errors.ErrAESDecrypt.Wrap(err)
+		}
+		return credential, nil
 	}
 	return nil, errors.ErrInvalidRequest.WithMessage("unknown credential type")
 }
@@ -131,6 +152,25 @@ func (p *SecretStorage) Write(ctx context.Context, subID vanus.ID, credential pr
 			return errors.ErrAESEncrypt.Wrap(err)
 		}
 		save = primitive.NewPlainSinkCredential(identifier, s)
+	case primitive.Kafka:
+		kafka, _ := credential.(*primitive.KafkaSinkCredential)
+		encrypted := *kafka
+		var err error
+		if encrypted.Password, err = crypto.AESEncrypt(kafka.Password, p.cipherKey); err != nil {
+			return errors.ErrAESEncrypt.Wrap(err)
+		}
+		if encrypted.ClientKey, err = crypto.AESEncrypt(kafka.ClientKey, p.cipherKey); err != nil {
+			return errors.ErrAESEncrypt.Wrap(err)
+		}
+		save = &encrypted
+	case primitive.TLS:
+		credential, _ := credential.(*primitive.TLSSinkCredential)
+		encrypted := *credential
+		var err error
+		if encrypted.ClientKey, err = crypto.AESEncrypt(credential.ClientKey, p.cipherKey); err != nil {
+			return errors.ErrAESEncrypt.Wrap(err)
+		}
+		save = &encrypted
 	default:
 		return errors.ErrInvalidRequest.WithMessage("unknown credential type")
 	}
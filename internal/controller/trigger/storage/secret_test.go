@@ -97,6 +97,59 @@ func TestSecretStorage(t *testing.T) {
 				So(err, ShouldBeNil)
 			})
 		})
+		Convey("test credential type kafka", func() {
+			subID := vanus.NewTestID()
+			Convey("test write and read", func() {
+				credential := &primitive.KafkaSinkCredential{
+					SASLMechanism: "PLAIN",
+					Username:      "test_username",
+					Password:      "test_password",
+					EnableTLS:     true,
+				}
+				var saved []byte
+				kvClient.EXPECT().Set(ctx, secret.getKey(subID), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, v []byte) error {
+						saved = v
+						return nil
+					})
+				err := secret.Write(ctx, subID, credential)
+				So(err, ShouldBeNil)
+				So(string(saved), ShouldNotContainSubstring, "test_password")
+				So(credential.Password, ShouldEqual, "test_password")
+
+				kvClient.EXPECT().Get(ctx, secret.getKey(subID)).Return(saved, nil)
+				read, err := secret.Read(ctx, subID, primitive.Kafka)
+				So(err, ShouldBeNil)
+				So(read.GetType(), ShouldEqual, primitive.Kafka)
+				So(read, ShouldResemble, credential)
+			})
+		})
+		Convey("test credential type tls", func() {
+			subID := vanus.NewTestID()
+			Convey("test write and read", func() {
+				credential := &primitive.TLSSinkCredential{
+					CACert:     "test_ca_cert",
+					ClientCert: "test_client_cert",
+					ClientKey:  "test_client_key",
+				}
+				var saved []byte
+				kvClient.EXPECT().Set(ctx, secret.getKey(subID), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, v []byte) error {
+						saved = v
+						return nil
+					})
+				err := secret.Write(ctx, subID, credential)
+				So(err, ShouldBeNil)
+				So(string(saved), ShouldNotContainSubstring, "test_client_key")
+				So(credential.ClientKey, ShouldEqual, "test_client_key")
+
+				kvClient.EXPECT().Get(ctx, secret.getKey(subID)).Return(saved, nil)
+				read, err := secret.Read(ctx, subID, primitive.TLS)
+				So(err, ShouldBeNil)
+				So(read.GetType(), ShouldEqual, primitive.TLS)
+				So(read, ShouldResemble, credential)
+			})
+		})
 		Convey("test delete", func() {
 			subID := vanus.NewTestID()
 			kvClient.EXPECT().Delete(ctx, secret.getKey(subID)).Return(nil)
@@ -24,6 +24,8 @@ type Storage interface {
 	SubscriptionStorage
 	OffsetStorage
 	TriggerWorkerStorage
+	DeadLetterStorage
+	CheckpointStorage
 	Close()
 }
 
@@ -31,6 +33,8 @@ type storage struct {
 	SubscriptionStorage
 	OffsetStorage
 	TriggerWorkerStorage
+	DeadLetterStorage
+	CheckpointStorage
 	client kv.Client
 }
 
@@ -43,6 +47,8 @@ func NewStorage(config primitive.KvStorageConfig) (Storage, error) {
 	s.SubscriptionStorage = NewSubscriptionStorage(client)
 	s.OffsetStorage = NewOffsetStorage(client)
 	s.TriggerWorkerStorage = NewTriggerWorkerStorage(client)
+	s.DeadLetterStorage = NewDeadLetterStorage(client)
+	s.CheckpointStorage = NewCheckpointStorage(client)
 	return s, nil
 }
 
@@ -52,6 +52,20 @@ func (mr *MockManagerMockRecorder) AddSubscription(ctx, subscription interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSubscription", reflect.TypeOf((*MockManager)(nil).AddSubscription), ctx, subscription)
 }
 
+// DeleteCheckpoint mocks base method.
+func (m *MockManager) DeleteCheckpoint(ctx context.Context, id vanus.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCheckpoint", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCheckpoint indicates an expected call of DeleteCheckpoint.
+func (mr *MockManagerMockRecorder) DeleteCheckpoint(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCheckpoint", reflect.TypeOf((*MockManager)(nil).DeleteCheckpoint), ctx, id)
+}
+
 // DeleteSubscription mocks base method.
 func (m *MockManager) DeleteSubscription(ctx context.Context, id vanus.ID) error {
 	m.ctrl.T.Helper()
@@ -66,6 +80,21 @@ func (mr *MockManagerMockRecorder) DeleteSubscription(ctx, id interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockManager)(nil).DeleteSubscription), ctx, id)
 }
 
+// GetCheckpoint mocks base method.
+func (m *MockManager) GetCheckpoint(ctx context.Context, id vanus.ID) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCheckpoint", ctx, id)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCheckpoint indicates an expected call of GetCheckpoint.
+func (mr *MockManagerMockRecorder) GetCheckpoint(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCheckpoint", reflect.TypeOf((*MockManager)(nil).GetCheckpoint), ctx, id)
+}
+
 // GetOffset mocks base method.
 func (m *MockManager) GetOffset(ctx context.Context, id vanus.ID) (info.ListOffsetInfo, error) {
 	m.ctrl.T.Helper()
@@ -137,6 +166,20 @@ func (mr *MockManagerMockRecorder) ListSubscription(ctx interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscription", reflect.TypeOf((*MockManager)(nil).ListSubscription), ctx)
 }
 
+// SaveCheckpoint mocks base method.
+func (m *MockManager) SaveCheckpoint(ctx context.Context, id vanus.ID, checkpoint []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCheckpoint", ctx, id, checkpoint)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCheckpoint indicates an expected call of SaveCheckpoint.
+func (mr *MockManagerMockRecorder) SaveCheckpoint(ctx, id, checkpoint interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCheckpoint", reflect.TypeOf((*MockManager)(nil).SaveCheckpoint), ctx, id, checkpoint)
+}
+
 // SaveOffset mocks base method.
 func (m *MockManager) SaveOffset(ctx context.Context, id vanus.ID, offsets info.ListOffsetInfo, commit bool) error {
 	m.ctrl.T.Helper()
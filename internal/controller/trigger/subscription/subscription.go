@@ -16,6 +16,7 @@
 package subscription
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"reflect"
@@ -35,6 +36,10 @@ import (
 type Manager interface {
 	SaveOffset(ctx context.Context, id vanus.ID, offsets iInfo.ListOffsetInfo, commit bool) error
 	GetOffset(ctx context.Context, id vanus.ID) (iInfo.ListOffsetInfo, error)
+	SaveCheckpoint(ctx context.Context, id vanus.ID, checkpoint []byte) error
+	// GetCheckpoint returns nil if the subscription hasn't a checkpoint.
+	GetCheckpoint(ctx context.Context, id vanus.ID) ([]byte, error)
+	DeleteCheckpoint(ctx context.Context, id vanus.ID) error
 	ListSubscription(ctx context.Context) []*metadata.Subscription
 	GetSubscription(ctx context.Context, id vanus.ID) *metadata.Subscription
 	AddSubscription(ctx context.Context, subscription *metadata.Subscription) error
@@ -60,6 +65,8 @@ type manager struct {
 	offsetManager   offset.Manager
 	lock            sync.RWMutex
 	subscriptionMap map[vanus.ID]*metadata.Subscription
+	// checkpoints caches the latest saved checkpoint, vanus.ID -> []byte.
+	checkpoints sync.Map
 }
 
 func NewSubscriptionManager(storage storage.Storage, secretStorage secret.Storage) Manager {
@@ -88,6 +95,45 @@ func (m *manager) GetOffset(ctx context.Context, id vanus.ID) (iInfo.ListOffsetI
 	return m.offsetManager.GetOffset(ctx, id)
 }
 
+func (m *manager) SaveCheckpoint(ctx context.Context, id vanus.ID, checkpoint []byte) error {
+	if m.GetSubscription(ctx, id) == nil {
+		return nil
+	}
+	if v, ok := m.checkpoints.Load(id); ok && bytes.Equal(v.([]byte), checkpoint) {
+		return nil
+	}
+	if err := m.storage.SaveCheckpoint(ctx, id, checkpoint); err != nil {
+		return err
+	}
+	m.checkpoints.Store(id, checkpoint)
+	return nil
+}
+
+func (m *manager) GetCheckpoint(ctx context.Context, id vanus.ID) ([]byte, error) {
+	if m.GetSubscription(ctx, id) == nil {
+		return nil, ErrSubscriptionNotExist
+	}
+	if v, ok := m.checkpoints.Load(id); ok {
+		return v.([]byte), nil
+	}
+	checkpoint, err := m.storage.GetCheckpoint(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if checkpoint != nil {
+		m.checkpoints.Store(id, checkpoint)
+	}
+	return checkpoint, nil
+}
+
+func (m *manager) DeleteCheckpoint(ctx context.Context, id vanus.ID) error {
+	if err := m.storage.DeleteCheckpoint(ctx, id); err != nil {
+		return err
+	}
+	m.checkpoints.Delete(id)
+	return nil
+}
+
 func (m *manager) ListSubscription(ctx context.Context) []*metadata.Subscription {
 	m.lock.RLock()
 	defer m.lock.RUnlock()
@@ -155,7 +201,8 @@ func (m *manager) UpdateSubscription(ctx context.Context, sub *metadata.Subscrip
 
 // DeleteSubscription will do
 // 1.delete offset
-// 2.delete subscription .
+// 2.delete checkpoint if it's an aggregation subscription
+// 3.delete subscription .
 func (m *manager) DeleteSubscription(ctx context.Context, id vanus.ID) error {
 	m.lock.Lock()
 	defer m.lock.Unlock()
@@ -166,6 +213,12 @@ func (m *manager) DeleteSubscription(ctx context.Context, id vanus.ID) error {
 	if err := m.offsetManager.RemoveRegisterSubscription(ctx, id); err != nil {
 		return err
 	}
+	if subscription.Aggregation != nil {
+		if err := m.storage.DeleteCheckpoint(ctx, id); err != nil {
+			return err
+		}
+		m.checkpoints.Delete(id)
+	}
 	if err := m.storage.DeleteSubscription(ctx, id); err != nil {
 		return err
 	}
@@ -210,3 +210,49 @@ func TestOffset(t *testing.T) {
 		})
 	})
 }
+
+func TestCheckpoint(t *testing.T) {
+	Convey("test checkpoint", t, func() {
+		ctx := context.Background()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		storage := storage.NewMockStorage(ctrl)
+		secret := secret.NewMockStorage(ctrl)
+		m := NewSubscriptionManager(storage, secret).(*manager)
+		storage.MockSubscriptionStorage.EXPECT().CreateSubscription(ctx, gomock.Any()).Return(nil)
+		m.AddSubscription(ctx, &metadata.Subscription{})
+		id := m.ListSubscription(ctx)[0].ID
+		checkpoint := []byte(`{"windows":[]}`)
+		Convey("save checkpoint subscription no exist", func() {
+			err := m.SaveCheckpoint(ctx, 1, checkpoint)
+			So(err, ShouldBeNil)
+		})
+		Convey("save checkpoint", func() {
+			storage.MockCheckpointStorage.EXPECT().SaveCheckpoint(ctx, id, checkpoint).Return(nil)
+			err := m.SaveCheckpoint(ctx, id, checkpoint)
+			So(err, ShouldBeNil)
+			Convey("save unchanged checkpoint", func() {
+				err = m.SaveCheckpoint(ctx, id, []byte(`{"windows":[]}`))
+				So(err, ShouldBeNil)
+			})
+			Convey("get cached checkpoint", func() {
+				v, err := m.GetCheckpoint(ctx, id)
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, checkpoint)
+			})
+			Convey("delete checkpoint", func() {
+				storage.MockCheckpointStorage.EXPECT().DeleteCheckpoint(ctx, id).Return(nil)
+				err = m.DeleteCheckpoint(ctx, id)
+				So(err, ShouldBeNil)
+				storage.MockCheckpointStorage.EXPECT().GetCheckpoint(ctx, id).Return(nil, nil)
+				v, err := m.GetCheckpoint(ctx, id)
+				So(err, ShouldBeNil)
+				So(v, ShouldBeNil)
+			})
+		})
+		Convey("get checkpoint subscription no exist", func() {
+			_, err := m.GetCheckpoint(ctx, 1)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
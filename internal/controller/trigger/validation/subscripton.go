@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"regexp"
 
 	"github.com/linkall-labs/vanus/internal/primitive"
 	"github.com/linkall-labs/vanus/internal/primitive/cel"
@@ -37,13 +38,7 @@ func ValidateSubscriptionRequest(ctx context.Context, request *ctrlpb.Subscripti
 	if err := ValidateFilterList(ctx, request.Filters); err != nil {
 		return errors.ErrInvalidRequest.WithMessage("filters is invalid").Wrap(err)
 	}
-	if err := validateProtocol(ctx, request.Protocol); err != nil {
-		return err
-	}
-	if err := ValidateSinkAndProtocol(ctx, request.Sink, request.Protocol, request.SinkCredential); err != nil {
-		return err
-	}
-	if err := validateSinkCredential(ctx, request.Sink, request.SinkCredential); err != nil {
+	if err := validateSink(ctx, request); err != nil {
 		return err
 	}
 	if request.EventBus == "" {
@@ -52,17 +47,54 @@ func ValidateSubscriptionRequest(ctx context.Context, request *ctrlpb.Subscripti
 	if err := validateSubscriptionConfig(ctx, request.Config); err != nil {
 		return err
 	}
+	if !request.Pull && request.Config.GetMaxBatchSize() > 1 &&
+		request.Protocol != metapb.Protocol_HTTP && request.Protocol != metapb.Protocol_GRPC {
+		return errors.ErrInvalidRequest.WithMessage("batch delivery is only supported by http and grpc protocol")
+	}
 	if err := validateTransformer(ctx, request.Transformer); err != nil {
 		return err
 	}
+	if err := validateAggregation(ctx, request); err != nil {
+		return err
+	}
 	return nil
 }
 
+func validateSink(ctx context.Context, request *ctrlpb.SubscriptionRequest) error {
+	if request.Pull {
+		// events of pull subscription are fetched by consumers through gateway
+		if request.Sink != "" || request.SinkCredential != nil {
+			return errors.ErrInvalidRequest.WithMessage("pull subscription can not have sink")
+		}
+		if request.Config.GetMaxBatchSize() > 1 {
+			return errors.ErrInvalidRequest.WithMessage("batch delivery is not supported by pull subscription")
+		}
+		return nil
+	}
+	if request.Aggregation.GetTargetEventbus() != "" {
+		// the aggregated events are appended to the target eventbus
+		if request.Sink != "" || request.SinkCredential != nil {
+			return errors.ErrInvalidRequest.WithMessage(
+				"aggregation subscription with target eventbus can not have sink")
+		}
+		return nil
+	}
+	if err := validateProtocol(ctx, request.Protocol); err != nil {
+		return err
+	}
+	if err := ValidateSinkAndProtocol(ctx, request.Sink, request.Protocol, request.SinkCredential); err != nil {
+		return err
+	}
+	return validateSinkCredential(ctx, request.Sink, request.SinkCredential)
+}
+
 func validateProtocol(ctx context.Context, protocol metapb.Protocol) error {
 	switch protocol {
 	case metapb.Protocol_HTTP:
 	case metapb.Protocol_AWS_LAMBDA:
 	case metapb.Protocol_GCLOUD_FUNCTIONS:
+	case metapb.Protocol_KAFKA:
+	case metapb.Protocol_GRPC:
 	default:
 		return errors.ErrInvalidRequest.WithMessage("protocol is invalid")
 	}
@@ -96,6 +128,26 @@ func ValidateSinkAndProtocol(ctx context.Context,
 			return errors.ErrInvalidRequest.
 				WithMessage("protocol is http, sink is url,url parse error").Wrap(err)
 		}
+	case metapb.Protocol_KAFKA:
+		if _, err := primitive.ParseKafkaSink(sink); err != nil {
+			return errors.ErrInvalidRequest.
+				WithMessage("protocol is kafka, sink is kafka://brokers/topic, sink parse error").Wrap(err)
+		}
+		if credential != nil && credential.GetCredentialType() != metapb.SinkCredential_None &&
+			credential.GetCredentialType() != metapb.SinkCredential_KAFKA {
+			return errors.ErrInvalidRequest.
+				WithMessage("protocol is kafka, sink credential type must be kafka")
+		}
+	case metapb.Protocol_GRPC:
+		if _, err := primitive.ParseGRPCSink(sink); err != nil {
+			return errors.ErrInvalidRequest.
+				WithMessage("protocol is grpc, sink is grpc://host:port or grpcs://host:port, sink parse error").Wrap(err)
+		}
+		if credential != nil && credential.GetCredentialType() != metapb.SinkCredential_None &&
+			credential.GetCredentialType() != metapb.SinkCredential_TLS {
+			return errors.ErrInvalidRequest.
+				WithMessage("protocol is grpc, sink credential type must be tls")
+		}
 	}
 	return nil
 }
@@ -126,12 +178,52 @@ func validateSinkCredential(ctx context.Context, sink string, credential *metapb
 			return errors.ErrInvalidRequest.
 				WithMessage("gcloud credential json invalid").Wrap(err)
 		}
+	case metapb.SinkCredential_KAFKA:
+		if err := validateKafkaCredential(credential.GetKafka()); err != nil {
+			return err
+		}
+	case metapb.SinkCredential_TLS:
+		if err := validateTLSCredential(credential.GetTls()); err != nil {
+			return err
+		}
 	default:
 		return errors.ErrInvalidRequest.WithMessage("sink credential type is invalid")
 	}
 	return nil
 }
 
+func validateKafkaCredential(credential *metapb.KafkaCredential) error {
+	if credential == nil {
+		return errors.ErrInvalidRequest.WithMessage("sink credential type is kafka, credential can not be nil")
+	}
+	switch credential.SaslMechanism {
+	case "":
+	case primitive.KafkaSASLPlain, primitive.KafkaSASLSCRAMSHA256, primitive.KafkaSASLSCRAMSHA512:
+		if credential.Username == "" || credential.Password == "" {
+			return errors.ErrInvalidRequest.
+				WithMessage("sink credential type is kafka, sasl is enabled, username and password can not empty")
+		}
+	default:
+		return errors.ErrInvalidRequest.WithMessage("sink credential type is kafka, sasl mechanism is invalid")
+	}
+	if (credential.ClientCert == "") != (credential.ClientKey == "") {
+		return errors.ErrInvalidRequest.
+			WithMessage("sink credential type is kafka, client cert and client key must be set together")
+	}
+	return nil
+}
+
+func validateTLSCredential(credential *metapb.TLSCredential) error {
+	if credential == nil {
+		return errors.ErrInvalidRequest.WithMessage("sink credential type is tls, credential can not be nil")
+	}
+	if (credential.ClientCert == "") != (credential.ClientKey == "") {
+		return errors.ErrInvalidRequest.
+			WithMessage("sink credential type is tls, client cert and client key must be set together")
+	}
+	return nil
+}
+
 func validateSubscriptionConfig(ctx context.Context, cfg *metapb.SubscriptionConfig) error {
 	if cfg == nil {
 		return nil
@@ -152,6 +244,145 @@ func validateSubscriptionConfig(ctx context.Context, cfg *metapb.SubscriptionCon
 		return errors.ErrInvalidRequest.WithMessage(
 			fmt.Sprintf("could not set max retry attempts greater than %d", primitive.MaxRetryAttempts))
 	}
+	if err := validateRetryPolicy(ctx, cfg.RetryPolicy); err != nil {
+		return err
+	}
+	if err := validateCircuitBreaker(ctx, cfg.CircuitBreaker); err != nil {
+		return err
+	}
+	if err := validateOrderKey(ctx, cfg); err != nil {
+		return err
+	}
+	return nil
+}
+
+var orderKeyRegexp = regexp.MustCompile("^[a-z0-9]+$")
+
+func validateOrderKey(ctx context.Context, cfg *metapb.SubscriptionConfig) error {
+	if cfg.OrderKey == "" {
+		return nil
+	}
+	if !orderKeyRegexp.MatchString(cfg.OrderKey) {
+		return errors.ErrInvalidRequest.WithMessage(
+			"order key must be an attribute name which consists of lower-case letters and digits")
+	}
+	if cfg.OrderedEvent {
+		return errors.ErrInvalidRequest.WithMessage("order key can not be set with ordered event")
+	}
+	if cfg.MaxBatchSize > 1 {
+		return errors.ErrInvalidRequest.WithMessage("order key can not be set with batch delivery")
+	}
+	return nil
+}
+
+func validateCircuitBreaker(ctx context.Context, breaker *metapb.CircuitBreaker) error {
+	if breaker == nil {
+		return nil
+	}
+	if breaker.FailureRatio < 0 || breaker.FailureRatio > 1 {
+		return errors.ErrInvalidRequest.WithMessage(
+			"circuit breaker failure ratio must be in range (0, 1], 0 means 0.5")
+	}
+	if breaker.Window != 0 && breaker.Window < 1000 {
+		return errors.ErrInvalidRequest.WithMessage("circuit breaker window can not less than 1000ms")
+	}
+	return nil
+}
+
+func validateRetryPolicy(ctx context.Context, policy *metapb.RetryPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	switch policy.Strategy {
+	case metapb.RetryPolicy_DEFAULT:
+		return nil
+	case metapb.RetryPolicy_FIXED, metapb.RetryPolicy_LINEAR:
+	case metapb.RetryPolicy_EXPONENTIAL:
+		if policy.Multiplier != 0 && policy.Multiplier < 1 {
+			return errors.ErrInvalidRequest.WithMessage("retry policy multiplier of exponential can not less than 1")
+		}
+	default:
+		return errors.ErrInvalidRequest.WithMessage("retry policy strategy is invalid")
+	}
+	if policy.InitialDelay == 0 {
+		return errors.ErrInvalidRequest.WithMessage("retry policy initial delay can not be 0")
+	}
+	if policy.MaxDelay != 0 && policy.MaxDelay < policy.InitialDelay {
+		return errors.ErrInvalidRequest.WithMessage("retry policy max delay can not less than initial delay")
+	}
+	if policy.Multiplier < 0 {
+		return errors.ErrInvalidRequest.WithMessage("retry policy multiplier can not be negative")
+	}
+	if policy.Jitter < 0 || policy.Jitter > 1 {
+		return errors.ErrInvalidRequest.WithMessage("retry policy jitter must be in range [0, 1]")
+	}
+	return nil
+}
+
+const (
+	minWindowSize = 1000
+	// maxSlidingWindows bounds the number of windows which an event is accumulated into.
+	maxSlidingWindows = 100
+)
+
+func validateAggregation(ctx context.Context, request *ctrlpb.SubscriptionRequest) error {
+	aggregation := request.Aggregation
+	if aggregation == nil {
+		return nil
+	}
+	if request.Pull {
+		return errors.ErrInvalidRequest.WithMessage("pull subscription can not aggregate events")
+	}
+	if aggregation.WindowSize < minWindowSize {
+		return errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("aggregation window size can not less than %dms", minWindowSize))
+	}
+	switch aggregation.WindowType {
+	case metapb.Aggregation_TUMBLING:
+		if aggregation.Slide != 0 {
+			return errors.ErrInvalidRequest.WithMessage("tumbling window can not have slide")
+		}
+	case metapb.Aggregation_SLIDING:
+		if aggregation.Slide == 0 || aggregation.Slide > aggregation.WindowSize {
+			return errors.ErrInvalidRequest.WithMessage("sliding window slide must be in range (0, window size]")
+		}
+		if aggregation.WindowSize/aggregation.Slide > maxSlidingWindows {
+			return errors.ErrInvalidRequest.WithMessage(
+				fmt.Sprintf("sliding window size can not greater than %d times of slide", maxSlidingWindows))
+		}
+	default:
+		return errors.ErrInvalidRequest.WithMessage("aggregation window type is invalid")
+	}
+	if aggregation.Key != "" && !orderKeyRegexp.MatchString(aggregation.Key) {
+		return errors.ErrInvalidRequest.WithMessage(
+			"aggregation key must be an attribute name which consists of lower-case letters and digits")
+	}
+	if aggregation.Field != "" {
+		a, err := arg.NewArg(aggregation.Field)
+		if err != nil || a.Type() != arg.EventData {
+			return errors.ErrInvalidRequest.WithMessage("aggregation field must be a path of data like $.data.key")
+		}
+	}
+	if len(aggregation.Functions) == 0 {
+		return errors.ErrInvalidRequest.WithMessage("aggregation functions can not be empty")
+	}
+	seen := make(map[metapb.Aggregation_Function]bool, len(aggregation.Functions))
+	for _, f := range aggregation.Functions {
+		if _, ok := metapb.Aggregation_Function_name[int32(f)]; !ok {
+			return errors.ErrInvalidRequest.WithMessage("aggregation function is invalid")
+		}
+		if seen[f] {
+			return errors.ErrInvalidRequest.WithMessage(fmt.Sprintf("aggregation function %s is duplicated", f))
+		}
+		seen[f] = true
+		if f != metapb.Aggregation_COUNT && aggregation.Field == "" {
+			return errors.ErrInvalidRequest.WithMessage(
+				fmt.Sprintf("aggregation function %s requires field", f))
+		}
+	}
+	if aggregation.TargetEventbus != "" && aggregation.TargetEventbus == request.EventBus {
+		return errors.ErrInvalidRequest.WithMessage("aggregation target eventbus can not be the subscribed eventbus")
+	}
 	return nil
 }
 
@@ -48,6 +48,84 @@ func TestSubscriptionRequestValidator(t *testing.T) {
 		}
 		So(ValidateSubscriptionRequest(ctx, request), ShouldNotBeNil)
 	})
+	Convey("kafka protocol", t, func() {
+		request := &ctrlpb.SubscriptionRequest{
+			Sink:     "kafka://127.0.0.1:9092/topic",
+			EventBus: "test",
+			Protocol: metapb.Protocol_KAFKA,
+			SinkCredential: &metapb.SinkCredential{
+				CredentialType: metapb.SinkCredential_KAFKA,
+				Credential: &metapb.SinkCredential_Kafka{
+					Kafka: &metapb.KafkaCredential{SaslMechanism: "PLAIN", Username: "user", Password: "pwd"},
+				},
+			},
+		}
+		So(ValidateSubscriptionRequest(ctx, request), ShouldBeNil)
+		request.SinkCredential.GetKafka().Password = ""
+		So(ValidateSubscriptionRequest(ctx, request), ShouldNotBeNil)
+		request.SinkCredential.GetKafka().SaslMechanism = "unknown"
+		So(ValidateSubscriptionRequest(ctx, request), ShouldNotBeNil)
+		request.SinkCredential = nil
+		request.Sink = "kafka://127.0.0.1:9092"
+		So(ValidateSubscriptionRequest(ctx, request), ShouldNotBeNil)
+	})
+	Convey("pull subscription", t, func() {
+		request := &ctrlpb.SubscriptionRequest{
+			EventBus: "test",
+			Pull:     true,
+		}
+		So(ValidateSubscriptionRequest(ctx, request), ShouldBeNil)
+		request.Sink = "http://127.0.0.1:8080"
+		So(ValidateSubscriptionRequest(ctx, request), ShouldNotBeNil)
+		request.Sink = ""
+		request.Config = &metapb.SubscriptionConfig{MaxBatchSize: 10}
+		So(ValidateSubscriptionRequest(ctx, request), ShouldNotBeNil)
+	})
+	Convey("grpc protocol", t, func() {
+		request := &ctrlpb.SubscriptionRequest{
+			Sink:     "grpcs://127.0.0.1:8080",
+			EventBus: "test",
+			Protocol: metapb.Protocol_GRPC,
+			Config:   &metapb.SubscriptionConfig{MaxBatchSize: 10},
+		}
+		So(ValidateSubscriptionRequest(ctx, request), ShouldBeNil)
+		request.SinkCredential = &metapb.SinkCredential{
+			CredentialType: metapb.SinkCredential_TLS,
+			Credential: &metapb.SinkCredential_Tls{
+				Tls: &metapb.TLSCredential{CaCert: "ca", ClientCert: "cert", ClientKey: "key"},
+			},
+		}
+		So(ValidateSubscriptionRequest(ctx, request), ShouldBeNil)
+		request.SinkCredential.GetTls().ClientKey = ""
+		So(ValidateSubscriptionRequest(ctx, request), ShouldNotBeNil)
+		request.SinkCredential = &metapb.SinkCredential{
+			CredentialType: metapb.SinkCredential_PLAIN,
+			Credential: &metapb.SinkCredential_Plain{
+				Plain: &metapb.PlainCredential{Identifier: "id", Secret: "secret"},
+			},
+		}
+		So(ValidateSubscriptionRequest(ctx, request), ShouldNotBeNil)
+		request.SinkCredential = nil
+		request.Sink = "http://127.0.0.1:8080"
+		So(ValidateSubscriptionRequest(ctx, request), ShouldNotBeNil)
+	})
+	Convey("batch with non http protocol", t, func() {
+		request := &ctrlpb.SubscriptionRequest{
+			Sink:     "arn:aws:lambda:us-west-2:843378899134:function:xdltest",
+			EventBus: "test",
+			Protocol: metapb.Protocol_AWS_LAMBDA,
+			SinkCredential: &metapb.SinkCredential{
+				CredentialType: metapb.SinkCredential_AWS,
+				Credential: &metapb.SinkCredential_Aws{
+					Aws: &metapb.AKSKCredential{AccessKeyId: "ak", SecretAccessKey: "sk"},
+				},
+			},
+			Config: &metapb.SubscriptionConfig{MaxBatchSize: 10},
+		}
+		So(ValidateSubscriptionRequest(ctx, request), ShouldNotBeNil)
+		request.Config.MaxBatchSize = 0
+		So(ValidateSubscriptionRequest(ctx, request), ShouldBeNil)
+	})
 }
 
 func TestValidateSubscriptionConfig(t *testing.T) {
@@ -66,6 +144,57 @@ func TestValidateSubscriptionConfig(t *testing.T) {
 			}
 			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
 		})
+		Convey("test retry policy", func() {
+			config := &metapb.SubscriptionConfig{
+				RetryPolicy: &metapb.RetryPolicy{
+					Strategy:     metapb.RetryPolicy_EXPONENTIAL,
+					InitialDelay: 100,
+					MaxDelay:     10000,
+					Multiplier:   2,
+					Jitter:       0.1,
+				},
+			}
+			So(validateSubscriptionConfig(ctx, config), ShouldBeNil)
+			config.RetryPolicy.InitialDelay = 0
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+			config.RetryPolicy.InitialDelay = 100
+			config.RetryPolicy.MaxDelay = 10
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+			config.RetryPolicy.MaxDelay = 0
+			config.RetryPolicy.Multiplier = 0.5
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+			config.RetryPolicy.Multiplier = 2
+			config.RetryPolicy.Jitter = 1.5
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+			config.RetryPolicy.Jitter = 0
+			config.RetryPolicy.Strategy = 100
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+		})
+		Convey("test circuit breaker", func() {
+			config := &metapb.SubscriptionConfig{
+				CircuitBreaker: &metapb.CircuitBreaker{},
+			}
+			So(validateSubscriptionConfig(ctx, config), ShouldBeNil)
+			config.CircuitBreaker.FailureRatio = 1.5
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+			config.CircuitBreaker.FailureRatio = -0.5
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+			config.CircuitBreaker.FailureRatio = 0.5
+			config.CircuitBreaker.Window = 100
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+		})
+		Convey("test order key", func() {
+			config := &metapb.SubscriptionConfig{OrderKey: "partitionkey"}
+			So(validateSubscriptionConfig(ctx, config), ShouldBeNil)
+			config.OrderKey = "Partition-Key"
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+			config.OrderKey = "partitionkey"
+			config.OrderedEvent = true
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+			config.OrderedEvent = false
+			config.MaxBatchSize = 10
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+		})
 	})
 }
 
@@ -109,6 +238,71 @@ func TestValidateTransformer(t *testing.T) {
 	})
 }
 
+func TestValidateAggregation(t *testing.T) {
+	ctx := context.Background()
+	Convey("test validate aggregation", t, func() {
+		request := &ctrlpb.SubscriptionRequest{
+			EventBus: "test",
+			Aggregation: &metapb.Aggregation{
+				WindowType: metapb.Aggregation_TUMBLING,
+				WindowSize: 60000,
+				Key:        "source",
+				Field:      "$.data.amount",
+				Functions:  []metapb.Aggregation_Function{metapb.Aggregation_COUNT, metapb.Aggregation_SUM},
+			},
+		}
+		So(validateAggregation(ctx, request), ShouldBeNil)
+
+		Convey("test window", func() {
+			request.Aggregation.WindowSize = 100
+			So(validateAggregation(ctx, request), ShouldNotBeNil)
+			request.Aggregation.WindowSize = 60000
+			request.Aggregation.Slide = 10000
+			So(validateAggregation(ctx, request), ShouldNotBeNil)
+			request.Aggregation.WindowType = metapb.Aggregation_SLIDING
+			So(validateAggregation(ctx, request), ShouldBeNil)
+			request.Aggregation.Slide = 100
+			So(validateAggregation(ctx, request), ShouldNotBeNil)
+			request.Aggregation.Slide = 0
+			So(validateAggregation(ctx, request), ShouldNotBeNil)
+		})
+
+		Convey("test key and field", func() {
+			request.Aggregation.Key = "Source"
+			So(validateAggregation(ctx, request), ShouldNotBeNil)
+			request.Aggregation.Key = ""
+			request.Aggregation.Field = "$.source"
+			So(validateAggregation(ctx, request), ShouldNotBeNil)
+			request.Aggregation.Field = ""
+			So(validateAggregation(ctx, request), ShouldNotBeNil)
+			request.Aggregation.Functions = []metapb.Aggregation_Function{metapb.Aggregation_COUNT}
+			So(validateAggregation(ctx, request), ShouldBeNil)
+		})
+
+		Convey("test functions", func() {
+			request.Aggregation.Functions = nil
+			So(validateAggregation(ctx, request), ShouldNotBeNil)
+			request.Aggregation.Functions = []metapb.Aggregation_Function{metapb.Aggregation_MAX, metapb.Aggregation_MAX}
+			So(validateAggregation(ctx, request), ShouldNotBeNil)
+		})
+
+		Convey("test target eventbus", func() {
+			request.Aggregation.TargetEventbus = "test"
+			So(validateAggregation(ctx, request), ShouldNotBeNil)
+			request.Aggregation.TargetEventbus = "aggregated"
+			So(validateAggregation(ctx, request), ShouldBeNil)
+			So(validateSink(ctx, request), ShouldBeNil)
+			request.Sink = "http://example.com"
+			So(validateSink(ctx, request), ShouldNotBeNil)
+		})
+
+		Convey("test pull", func() {
+			request.Pull = true
+			So(validateAggregation(ctx, request), ShouldNotBeNil)
+		})
+	})
+}
+
 func TestValidateSinkAndProtocol(t *testing.T) {
 	ctx := context.Background()
 	Convey("sink is empty", t, func() {
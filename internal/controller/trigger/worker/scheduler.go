@@ -96,6 +96,14 @@ func (s *SubscriptionScheduler) handler(ctx context.Context, subscriptionID vanu
 	if subscription == nil {
 		return nil
 	}
+	if subscription.Pull {
+		// pull subscription isn't assigned to trigger worker, the gateway reads events for consumers.
+		if subscription.Phase == metadata.SubscriptionPhaseRunning {
+			return nil
+		}
+		subscription.Phase = metadata.SubscriptionPhaseRunning
+		return s.subscriptionManager.UpdateSubscription(ctx, subscription)
+	}
 	twAddr := subscription.TriggerWorker
 	if twAddr == "" {
 		for {
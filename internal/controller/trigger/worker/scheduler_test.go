@@ -71,6 +71,19 @@ func TestSubscriptionSchedulerHandler(t *testing.T) {
 			subscriptionManager.EXPECT().UpdateSubscription(ctx, gomock.Any()).AnyTimes().Return(nil)
 			scheduler.handler(ctx, subscriptionID)
 		})
+
+		Convey("test scheduler handler pull subscription", func() {
+			sub := &metadata.Subscription{
+				ID:    subscriptionID,
+				Phase: metadata.SubscriptionPhaseCreated,
+				Pull:  true,
+			}
+			subscriptionManager.EXPECT().GetSubscription(ctx, subscriptionID).Return(sub)
+			subscriptionManager.EXPECT().UpdateSubscription(ctx, sub).Return(nil)
+			So(scheduler.handler(ctx, subscriptionID), ShouldBeNil)
+			So(sub.Phase, ShouldEqual, metadata.SubscriptionPhaseRunning)
+			So(sub.TriggerWorker, ShouldBeEmpty)
+		})
 	})
 }
 
@@ -30,8 +30,8 @@ import (
 	"github.com/linkall-labs/vanus/pkg/errors"
 	"github.com/linkall-labs/vanus/proto/pkg/trigger"
 
+	"github.com/linkall-labs/vanus/pkg/util/tlsconfig"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 type TriggerWorker interface {
@@ -137,6 +137,13 @@ func (tw *triggerWorker) handler(ctx context.Context, subscriptionID vanus.ID) e
 	if err != nil {
 		return err
 	}
+	var checkpoint []byte
+	if sub.Aggregation != nil {
+		checkpoint, err = tw.subscriptionManager.GetCheckpoint(ctx, subscriptionID)
+		if err != nil {
+			return err
+		}
+	}
 	filters := append([]*primitive.SubscriptionFilter(nil), sub.Filters...)
 	if sub.Source != "" {
 		filters = append(filters, &primitive.SubscriptionFilter{
@@ -171,6 +178,8 @@ func (tw *triggerWorker) handler(ctx context.Context, subscriptionID vanus.ID) e
 		Protocol:        sub.Protocol,
 		ProtocolSetting: sub.ProtocolSetting,
 		SinkCredential:  sub.SinkCredential,
+		Aggregation:     sub.Aggregation,
+		Checkpoint:      checkpoint,
 	})
 	if err != nil {
 		return err
@@ -293,7 +302,7 @@ func (tw *triggerWorker) init(ctx context.Context) error {
 	}
 	var err error
 	var opts []grpc.DialOption
-	opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	opts = append(opts, grpc.WithTransportCredentials(tlsconfig.ClientCredentials()))
 	tw.cc, err = grpc.DialContext(ctx, tw.info.Addr, opts...)
 	if err != nil {
 		return errors.ErrTriggerWorker.WithMessage("grpc dial error").Wrap(err)
@@ -0,0 +1,179 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"fmt"
+
+	cepb "cloudevents.io/genproto/v1"
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// ContentTypeProtobuf indicates that the data of event is a protobuf message.
+	ContentTypeProtobuf = "application/protobuf"
+
+	attrDataContentType = "datacontenttype"
+	attrDataSchema      = "dataschema"
+	attrSubject         = "subject"
+	attrTime            = "time"
+)
+
+// ToPbCloudEvent converts event to the CloudEvents protobuf format.
+func ToPbCloudEvent(e *ce.Event) (*cepb.CloudEvent, error) {
+	pe := &cepb.CloudEvent{
+		Id:          e.ID(),
+		Source:      e.Source(),
+		SpecVersion: e.SpecVersion(),
+		Type:        e.Type(),
+		Attributes:  make(map[string]*cepb.CloudEventAttributeValue),
+	}
+	if e.DataContentType() != "" {
+		pe.Attributes[attrDataContentType] = &cepb.CloudEventAttributeValue{
+			Attr: &cepb.CloudEventAttributeValue_CeString{CeString: e.DataContentType()},
+		}
+	}
+	if e.DataSchema() != "" {
+		pe.Attributes[attrDataSchema] = &cepb.CloudEventAttributeValue{
+			Attr: &cepb.CloudEventAttributeValue_CeUri{CeUri: e.DataSchema()},
+		}
+	}
+	if e.Subject() != "" {
+		pe.Attributes[attrSubject] = &cepb.CloudEventAttributeValue{
+			Attr: &cepb.CloudEventAttributeValue_CeString{CeString: e.Subject()},
+		}
+	}
+	if !e.Time().IsZero() {
+		pe.Attributes[attrTime] = &cepb.CloudEventAttributeValue{
+			Attr: &cepb.CloudEventAttributeValue_CeTimestamp{CeTimestamp: timestamppb.New(e.Time())},
+		}
+	}
+	for name, value := range e.Extensions() {
+		attr, err := toPbAttribute(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode attribute %s: %w", name, err)
+		}
+		pe.Attributes[name] = attr
+	}
+	if e.DataContentType() == ContentTypeProtobuf {
+		pe.Data = &cepb.CloudEvent_ProtoData{
+			ProtoData: &anypb.Any{TypeUrl: e.DataSchema(), Value: e.Data()},
+		}
+	} else {
+		pe.Data = &cepb.CloudEvent_BinaryData{BinaryData: e.Data()}
+	}
+	return pe, nil
+}
+
+// FromPbCloudEvent converts the CloudEvents protobuf format to event.
+func FromPbCloudEvent(pe *cepb.CloudEvent) (*ce.Event, error) {
+	if pe == nil {
+		return nil, fmt.Errorf("event is nil")
+	}
+	if pe.SpecVersion != ce.VersionV1 && pe.SpecVersion != ce.VersionV03 {
+		return nil, fmt.Errorf("unsupported specversion: %s", pe.SpecVersion)
+	}
+	e := ce.NewEvent(pe.SpecVersion)
+	e.SetID(pe.Id)
+	e.SetSource(pe.Source)
+	e.SetType(pe.Type)
+	for name, attr := range pe.Attributes {
+		v, err := fromPbAttribute(attr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode attribute %s: %w", name, err)
+		}
+		switch name {
+		case attrDataContentType:
+			e.SetDataContentType(fmt.Sprintf("%v", v))
+		case attrDataSchema:
+			e.SetDataSchema(fmt.Sprintf("%v", v))
+		case attrSubject:
+			e.SetSubject(fmt.Sprintf("%v", v))
+		case attrTime:
+			t, err := types.ToTime(v)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode attribute time: %w", err)
+			}
+			e.SetTime(t)
+		default:
+			e.SetExtension(name, v)
+		}
+	}
+	switch data := pe.Data.(type) {
+	case *cepb.CloudEvent_BinaryData:
+		e.DataEncoded = data.BinaryData
+	case *cepb.CloudEvent_TextData:
+		e.DataEncoded = []byte(data.TextData)
+	case *cepb.CloudEvent_ProtoData:
+		e.SetDataContentType(ContentTypeProtobuf)
+		e.SetDataSchema(data.ProtoData.TypeUrl)
+		e.DataEncoded = data.ProtoData.Value
+	}
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func toPbAttribute(value interface{}) (*cepb.CloudEventAttributeValue, error) {
+	v, err := types.Validate(value)
+	if err != nil {
+		return nil, err
+	}
+	attr := &cepb.CloudEventAttributeValue{}
+	switch vt := v.(type) {
+	case bool:
+		attr.Attr = &cepb.CloudEventAttributeValue_CeBoolean{CeBoolean: vt}
+	case int32:
+		attr.Attr = &cepb.CloudEventAttributeValue_CeInteger{CeInteger: vt}
+	case string:
+		attr.Attr = &cepb.CloudEventAttributeValue_CeString{CeString: vt}
+	case []byte:
+		attr.Attr = &cepb.CloudEventAttributeValue_CeBytes{CeBytes: vt}
+	case types.URI:
+		attr.Attr = &cepb.CloudEventAttributeValue_CeUri{CeUri: vt.String()}
+	case types.URIRef:
+		attr.Attr = &cepb.CloudEventAttributeValue_CeUriRef{CeUriRef: vt.String()}
+	case types.Timestamp:
+		attr.Attr = &cepb.CloudEventAttributeValue_CeTimestamp{CeTimestamp: timestamppb.New(vt.Time)}
+	default:
+		return nil, fmt.Errorf("unsupported attribute type: %T", v)
+	}
+	return attr, nil
+}
+
+func fromPbAttribute(attr *cepb.CloudEventAttributeValue) (interface{}, error) {
+	switch v := attr.GetAttr().(type) {
+	case *cepb.CloudEventAttributeValue_CeBoolean:
+		return v.CeBoolean, nil
+	case *cepb.CloudEventAttributeValue_CeInteger:
+		return v.CeInteger, nil
+	case *cepb.CloudEventAttributeValue_CeString:
+		return v.CeString, nil
+	case *cepb.CloudEventAttributeValue_CeBytes:
+		return v.CeBytes, nil
+	case *cepb.CloudEventAttributeValue_CeUri:
+		return v.CeUri, nil
+	case *cepb.CloudEventAttributeValue_CeUriRef:
+		return v.CeUriRef, nil
+	case *cepb.CloudEventAttributeValue_CeTimestamp:
+		return v.CeTimestamp.AsTime(), nil
+	default:
+		return nil, fmt.Errorf("unsupported attribute type: %T", v)
+	}
+}
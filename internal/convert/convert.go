@@ -15,6 +15,9 @@
 package convert
 
 import (
+	"strings"
+	"time"
+
 	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
 	"github.com/linkall-labs/vanus/internal/primitive"
 	"github.com/linkall-labs/vanus/internal/primitive/info"
@@ -41,6 +44,8 @@ func FromPbSubscriptionRequest(sub *ctrl.SubscriptionRequest) *metadata.Subscrip
 		Name:               sub.Name,
 		Disable:            sub.Disable,
 		Description:        sub.Description,
+		Pull:               sub.Pull,
+		Aggregation:        fromPbAggregation(sub.Aggregation),
 	}
 	return to
 }
@@ -54,6 +59,10 @@ func fromPbProtocol(from pb.Protocol) primitive.Protocol {
 		to = primitive.AwsLambdaProtocol
 	case pb.Protocol_GCLOUD_FUNCTIONS:
 		to = primitive.GCloudFunctions
+	case pb.Protocol_KAFKA:
+		to = primitive.KafkaProtocol
+	case pb.Protocol_GRPC:
+		to = primitive.GRPCProtocol
 	}
 	return to
 }
@@ -67,6 +76,10 @@ func toPbProtocol(from primitive.Protocol) pb.Protocol {
 		to = pb.Protocol_AWS_LAMBDA
 	case primitive.GCloudFunctions:
 		to = pb.Protocol_GCLOUD_FUNCTIONS
+	case primitive.KafkaProtocol:
+		to = pb.Protocol_KAFKA
+	case primitive.GRPCProtocol:
+		to = pb.Protocol_GRPC
 	}
 	return to
 }
@@ -105,6 +118,10 @@ func fromPbSinkCredentialType(from *pb.SinkCredential) *primitive.CredentialType
 		to = primitive.GCloud
 	case pb.SinkCredential_PLAIN:
 		to = primitive.Plain
+	case pb.SinkCredential_KAFKA:
+		to = primitive.Kafka
+	case pb.SinkCredential_TLS:
+		to = primitive.TLS
 	}
 	return &to
 }
@@ -125,6 +142,26 @@ func fromPbSinkCredential(from *pb.SinkCredential) primitive.SinkCredential {
 	case pb.SinkCredential_PLAIN:
 		plain := from.GetPlain()
 		return primitive.NewPlainSinkCredential(plain.GetIdentifier(), plain.GetSecret())
+	case pb.SinkCredential_KAFKA:
+		kafka := from.GetKafka()
+		return &primitive.KafkaSinkCredential{
+			SASLMechanism:      kafka.GetSaslMechanism(),
+			Username:           kafka.GetUsername(),
+			Password:           kafka.GetPassword(),
+			EnableTLS:          kafka.GetEnableTls(),
+			CACert:             kafka.GetCaCert(),
+			ClientCert:         kafka.GetClientCert(),
+			ClientKey:          kafka.GetClientKey(),
+			InsecureSkipVerify: kafka.GetInsecureSkipVerify(),
+		}
+	case pb.SinkCredential_TLS:
+		credential := from.GetTls()
+		return &primitive.TLSSinkCredential{
+			CACert:             credential.GetCaCert(),
+			ClientCert:         credential.GetClientCert(),
+			ClientKey:          credential.GetClientKey(),
+			InsecureSkipVerify: credential.GetInsecureSkipVerify(),
+		}
 	}
 	return nil
 }
@@ -158,6 +195,21 @@ func toPbSinkCredentialByType(credentialType *primitive.CredentialType) *pb.Sink
 				Secret:     primitive.SecretsMask,
 			},
 		}
+	case primitive.Kafka:
+		to.CredentialType = pb.SinkCredential_KAFKA
+		to.Credential = &pb.SinkCredential_Kafka{
+			Kafka: &pb.KafkaCredential{
+				Password:  primitive.SecretsMask,
+				ClientKey: primitive.SecretsMask,
+			},
+		}
+	case primitive.TLS:
+		to.CredentialType = pb.SinkCredential_TLS
+		to.Credential = &pb.SinkCredential_Tls{
+			Tls: &pb.TLSCredential{
+				ClientKey: primitive.SecretsMask,
+			},
+		}
 	}
 	return to
 }
@@ -194,6 +246,32 @@ func toPbSinkCredential(from primitive.SinkCredential) *pb.SinkCredential {
 				Secret:     credential.Secret,
 			},
 		}
+	case primitive.Kafka:
+		credential, _ := from.(*primitive.KafkaSinkCredential)
+		to.CredentialType = pb.SinkCredential_KAFKA
+		to.Credential = &pb.SinkCredential_Kafka{
+			Kafka: &pb.KafkaCredential{
+				SaslMechanism:      credential.SASLMechanism,
+				Username:           credential.Username,
+				Password:           credential.Password,
+				EnableTls:          credential.EnableTLS,
+				CaCert:             credential.CACert,
+				ClientCert:         credential.ClientCert,
+				ClientKey:          credential.ClientKey,
+				InsecureSkipVerify: credential.InsecureSkipVerify,
+			},
+		}
+	case primitive.TLS:
+		credential, _ := from.(*primitive.TLSSinkCredential)
+		to.CredentialType = pb.SinkCredential_TLS
+		to.Credential = &pb.SinkCredential_Tls{
+			Tls: &pb.TLSCredential{
+				CaCert:             credential.CACert,
+				ClientCert:         credential.ClientCert,
+				ClientKey:          credential.ClientKey,
+				InsecureSkipVerify: credential.InsecureSkipVerify,
+			},
+		}
 	}
 	return to
 }
@@ -208,6 +286,13 @@ func fromPbSubscriptionConfig(config *pb.SubscriptionConfig) primitive.Subscript
 		DeliveryTimeout:    config.DeliveryTimeout,
 		DeadLetterEventbus: config.DeadLetterEventbus,
 		OrderedEvent:       config.OrderedEvent,
+		RetryPolicy:        fromPbRetryPolicy(config.RetryPolicy),
+		MaxBatchSize:       config.MaxBatchSize,
+		BatchTimeout:       config.BatchTimeout,
+		VisibilityTimeout:  config.VisibilityTimeout,
+		CircuitBreaker:     fromPbCircuitBreaker(config.CircuitBreaker),
+		MaxConcurrency:     config.MaxConcurrency,
+		OrderKey:           config.OrderKey,
 	}
 	switch config.OffsetType {
 	case pb.SubscriptionConfig_LATEST:
@@ -228,6 +313,13 @@ func toPbSubscriptionConfig(config primitive.SubscriptionConfig) *pb.Subscriptio
 		DeliveryTimeout:    config.DeliveryTimeout,
 		DeadLetterEventbus: config.DeadLetterEventbus,
 		OrderedEvent:       config.OrderedEvent,
+		RetryPolicy:        toPbRetryPolicy(config.RetryPolicy),
+		MaxBatchSize:       config.MaxBatchSize,
+		BatchTimeout:       config.BatchTimeout,
+		VisibilityTimeout:  config.VisibilityTimeout,
+		CircuitBreaker:     toPbCircuitBreaker(config.CircuitBreaker),
+		MaxConcurrency:     config.MaxConcurrency,
+		OrderKey:           config.OrderKey,
 	}
 	switch config.OffsetType {
 	case primitive.LatestOffset:
@@ -241,6 +333,78 @@ func toPbSubscriptionConfig(config primitive.SubscriptionConfig) *pb.Subscriptio
 	return to
 }
 
+func fromPbRetryPolicy(policy *pb.RetryPolicy) *primitive.RetryPolicy {
+	if policy == nil {
+		return nil
+	}
+	to := &primitive.RetryPolicy{
+		InitialDelay: policy.InitialDelay,
+		MaxDelay:     policy.MaxDelay,
+		Multiplier:   policy.Multiplier,
+		Jitter:       policy.Jitter,
+	}
+	switch policy.Strategy {
+	case pb.RetryPolicy_FIXED:
+		to.Strategy = primitive.RetryStrategyFixed
+	case pb.RetryPolicy_LINEAR:
+		to.Strategy = primitive.RetryStrategyLinear
+	case pb.RetryPolicy_EXPONENTIAL:
+		to.Strategy = primitive.RetryStrategyExponential
+	default:
+		to.Strategy = primitive.RetryStrategyDefault
+	}
+	return to
+}
+
+func toPbRetryPolicy(policy *primitive.RetryPolicy) *pb.RetryPolicy {
+	if policy == nil {
+		return nil
+	}
+	to := &pb.RetryPolicy{
+		InitialDelay: policy.InitialDelay,
+		MaxDelay:     policy.MaxDelay,
+		Multiplier:   policy.Multiplier,
+		Jitter:       policy.Jitter,
+	}
+	switch policy.Strategy {
+	case primitive.RetryStrategyFixed:
+		to.Strategy = pb.RetryPolicy_FIXED
+	case primitive.RetryStrategyLinear:
+		to.Strategy = pb.RetryPolicy_LINEAR
+	case primitive.RetryStrategyExponential:
+		to.Strategy = pb.RetryPolicy_EXPONENTIAL
+	default:
+		to.Strategy = pb.RetryPolicy_DEFAULT
+	}
+	return to
+}
+
+func fromPbCircuitBreaker(breaker *pb.CircuitBreaker) *primitive.CircuitBreaker {
+	if breaker == nil {
+		return nil
+	}
+	return &primitive.CircuitBreaker{
+		FailureRatio:   breaker.FailureRatio,
+		MinRequests:    breaker.MinRequests,
+		Window:         breaker.Window,
+		OpenDuration:   breaker.OpenDuration,
+		HalfOpenTrials: breaker.HalfOpenTrials,
+	}
+}
+
+func toPbCircuitBreaker(breaker *primitive.CircuitBreaker) *pb.CircuitBreaker {
+	if breaker == nil {
+		return nil
+	}
+	return &pb.CircuitBreaker{
+		FailureRatio:   breaker.FailureRatio,
+		MinRequests:    breaker.MinRequests,
+		Window:         breaker.Window,
+		OpenDuration:   breaker.OpenDuration,
+		HalfOpenTrials: breaker.HalfOpenTrials,
+	}
+}
+
 func FromPbAddSubscription(sub *pbtrigger.AddSubscriptionRequest) *primitive.Subscription {
 	to := &primitive.Subscription{
 		ID:              vanus.ID(sub.Id),
@@ -253,6 +417,8 @@ func FromPbAddSubscription(sub *pbtrigger.AddSubscriptionRequest) *primitive.Sub
 		Filters:         fromPbFilters(sub.Filters),
 		Transformer:     fromPbTransformer(sub.Transformer),
 		Config:          fromPbSubscriptionConfig(sub.Config),
+		Aggregation:     fromPbAggregation(sub.Aggregation),
+		Checkpoint:      sub.Checkpoint,
 	}
 	return to
 }
@@ -269,6 +435,8 @@ func ToPbAddSubscription(sub *primitive.Subscription) *pbtrigger.AddSubscription
 		Config:           toPbSubscriptionConfig(sub.Config),
 		Protocol:         toPbProtocol(sub.Protocol),
 		ProtocolSettings: toPbProtocolSettings(sub.ProtocolSetting),
+		Aggregation:      ToPbAggregation(sub.Aggregation),
+		Checkpoint:       sub.Checkpoint,
 	}
 	return to
 }
@@ -292,6 +460,8 @@ func ToPbSubscription(sub *metadata.Subscription, offsets info.ListOffsetInfo) *
 		Description:      sub.Description,
 		CreatedAt:        sub.CreatedAt.UnixMilli(),
 		UpdatedAt:        sub.UpdatedAt.UnixMilli(),
+		Pull:             sub.Pull,
+		Aggregation:      ToPbAggregation(sub.Aggregation),
 	}
 	return to
 }
@@ -471,3 +641,118 @@ func ToPbTransformer(transformer *primitive.Transformer) *pb.Transformer {
 		Pipeline: toPbActions(transformer.Pipeline),
 	}
 }
+
+func fromPbAggregation(aggregation *pb.Aggregation) *primitive.Aggregation {
+	if aggregation == nil {
+		return nil
+	}
+	to := &primitive.Aggregation{
+		WindowSize:      aggregation.WindowSize,
+		Slide:           aggregation.Slide,
+		Key:             aggregation.Key,
+		Field:           aggregation.Field,
+		AllowedLateness: aggregation.AllowedLateness,
+		Type:            aggregation.Type,
+		TargetEventbus:  aggregation.TargetEventbus,
+	}
+	switch aggregation.WindowType {
+	case pb.Aggregation_SLIDING:
+		to.WindowType = primitive.SlidingWindow
+	default:
+		to.WindowType = primitive.TumblingWindow
+	}
+	for _, f := range aggregation.Functions {
+		to.Functions = append(to.Functions, primitive.AggregateFunction(strings.ToLower(f.String())))
+	}
+	return to
+}
+
+func ToPbAggregation(aggregation *primitive.Aggregation) *pb.Aggregation {
+	if aggregation == nil {
+		return nil
+	}
+	to := &pb.Aggregation{
+		WindowSize:      aggregation.WindowSize,
+		Slide:           aggregation.Slide,
+		Key:             aggregation.Key,
+		Field:           aggregation.Field,
+		AllowedLateness: aggregation.AllowedLateness,
+		Type:            aggregation.Type,
+		TargetEventbus:  aggregation.TargetEventbus,
+	}
+	switch aggregation.WindowType {
+	case primitive.SlidingWindow:
+		to.WindowType = pb.Aggregation_SLIDING
+	default:
+		to.WindowType = pb.Aggregation_TUMBLING
+	}
+	for _, f := range aggregation.Functions {
+		to.Functions = append(to.Functions, pb.Aggregation_Function(
+			pb.Aggregation_Function_value[strings.ToUpper(string(f))]))
+	}
+	return to
+}
+
+func FromPbDeadLetterOperation(op *pb.DeadLetterOperation) *metadata.DeadLetterOperation {
+	to := &metadata.DeadLetterOperation{
+		ID:             vanus.ID(op.Id),
+		SubscriptionID: vanus.ID(op.SubscriptionId),
+		Type:           metadata.DeadLetterOperationReplay,
+		Reason:         op.GetFilter().GetReason(),
+		StartTime:      op.GetFilter().GetStartTime(),
+		EndTime:        op.GetFilter().GetEndTime(),
+		Selected:       FromPbEventPositions(op.GetFilter().GetEvents()),
+		Events:         FromPbEventPositions(op.Events),
+		Operator:       op.Operator,
+	}
+	if op.Type == pb.DeadLetterOperation_PURGE {
+		to.Type = metadata.DeadLetterOperationPurge
+	}
+	if op.CreatedAt > 0 {
+		to.CreatedAt = time.UnixMilli(op.CreatedAt)
+	}
+	return to
+}
+
+func ToPbDeadLetterOperation(op *metadata.DeadLetterOperation) *pb.DeadLetterOperation {
+	to := &pb.DeadLetterOperation{
+		Id:             uint64(op.ID),
+		SubscriptionId: uint64(op.SubscriptionID),
+		Type:           pb.DeadLetterOperation_REPLAY,
+		Filter: &pb.DeadLetterFilter{
+			Reason:    op.Reason,
+			StartTime: op.StartTime,
+			EndTime:   op.EndTime,
+			Events:    ToPbEventPositions(op.Selected),
+		},
+		Events:    ToPbEventPositions(op.Events),
+		Operator:  op.Operator,
+		CreatedAt: op.CreatedAt.UnixMilli(),
+	}
+	if op.Type == metadata.DeadLetterOperationPurge {
+		to.Type = pb.DeadLetterOperation_PURGE
+	}
+	return to
+}
+
+func FromPbEventPositions(positions []*pb.EventPosition) []metadata.EventPosition {
+	to := make([]metadata.EventPosition, 0, len(positions))
+	for _, p := range positions {
+		to = append(to, metadata.EventPosition{
+			EventlogID: vanus.ID(p.EventlogId),
+			Offset:     p.Offset,
+		})
+	}
+	return to
+}
+
+func ToPbEventPositions(positions []metadata.EventPosition) []*pb.EventPosition {
+	to := make([]*pb.EventPosition, 0, len(positions))
+	for _, p := range positions {
+		to = append(to, &pb.EventPosition{
+			EventlogId: uint64(p.EventlogID),
+			Offset:     p.Offset,
+		})
+	}
+	return to
+}
@@ -0,0 +1,158 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/linkall-labs/vanus/pkg/errors"
+)
+
+const (
+	bearerPrefix = "bearer "
+)
+
+type Identity struct {
+	Subject string
+}
+
+type Resource struct {
+	Kind ResourceKind
+	Name string
+}
+
+func (r Resource) String() string {
+	return fmt.Sprintf("%s/%s", r.Kind, r.Name)
+}
+
+// Permission is required to call an API, nil permission means that any authenticated caller is allowed.
+type Permission struct {
+	Resource Resource
+	Action   Action
+}
+
+type Auth struct {
+	tokens []TokenConfig
+	jwt    *jwtVerifier
+	rules  []Rule
+}
+
+func New(cfg Config) (*Auth, error) {
+	a := &Auth{
+		tokens: cfg.Tokens,
+		rules:  cfg.Rules,
+	}
+	if cfg.JWT != nil {
+		v, err := newJWTVerifier(*cfg.JWT)
+		if err != nil {
+			return nil, err
+		}
+		a.jwt = v
+	}
+	return a, nil
+}
+
+// Authenticate identifies the caller by the static tokens first, then by JWT.
+func (a *Auth) Authenticate(token string) (*Identity, error) {
+	if token == "" {
+		return nil, errors.ErrUnauthenticated.WithMessage("the credential is missing")
+	}
+	for _, t := range a.tokens {
+		if subtle.ConstantTimeCompare([]byte(t.Token), []byte(token)) == 1 {
+			return &Identity{Subject: t.Subject}, nil
+		}
+	}
+	if a.jwt == nil {
+		return nil, errors.ErrUnauthenticated.WithMessage("invalid token")
+	}
+	subject, err := a.jwt.verify(token)
+	if err != nil {
+		return nil, errors.ErrUnauthenticated.WithMessage("invalid token").Wrap(err)
+	}
+	return &Identity{Subject: subject}, nil
+}
+
+func (a *Auth) Authorize(id *Identity, perm *Permission) error {
+	if perm == nil {
+		return nil
+	}
+	for _, rule := range a.rules {
+		if !matchAny(rule.Subjects, id.Subject) {
+			continue
+		}
+		var resources []string
+		switch perm.Resource.Kind {
+		case ResourceEventbus:
+			resources = rule.Eventbuses
+		case ResourceSubscription:
+			resources = rule.Subscriptions
+		}
+		if !matchAny(resources, perm.Resource.Name) {
+			continue
+		}
+		for _, action := range rule.Actions {
+			if action == perm.Action || action == ActionAdmin {
+				return nil
+			}
+		}
+	}
+	return errors.ErrPermissionDenied.WithMessage(
+		fmt.Sprintf("%s isn't allowed to %s %s", id.Subject, perm.Action, perm.Resource))
+}
+
+// Check authenticates the credential and authorizes the permission.
+func (a *Auth) Check(credential string, perm *Permission) (*Identity, error) {
+	id, err := a.Authenticate(ParseBearerToken(credential))
+	if err != nil {
+		return nil, err
+	}
+	if err = a.Authorize(id, perm); err != nil {
+		return nil, err
+	}
+	return id, nil
+}
+
+// ParseBearerToken returns the token of "Bearer <token>".
+func ParseBearerToken(credential string) string {
+	if len(credential) < len(bearerPrefix) || !strings.EqualFold(credential[:len(bearerPrefix)], bearerPrefix) {
+		return ""
+	}
+	return strings.TrimSpace(credential[len(bearerPrefix):])
+}
+
+func matchAny(patterns []string, name string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+type identityKey struct{}
+
+func WithIdentity(ctx context.Context, id *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// IdentityFromContext returns the identity of caller, nil if the authentication is disabled.
+func IdentityFromContext(ctx context.Context) *Identity {
+	id, _ := ctx.Value(identityKey{}).(*Identity)
+	return id
+}
@@ -0,0 +1,227 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func encodeBigInt(i *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(i.Bytes())
+}
+
+func writeJWKS(t *testing.T, rsaKey *rsa.PrivateKey, ecKey *ecdsa.PrivateKey) string {
+	jwks := map[string]interface{}{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA",
+				"kid": "rsa",
+				"n":   encodeBigInt(rsaKey.N),
+				"e":   encodeBigInt(big.NewInt(int64(rsaKey.E))),
+			},
+			{
+				"kty": "EC",
+				"kid": "ec",
+				"crv": "P-256",
+				"x":   encodeBigInt(ecKey.X),
+				"y":   encodeBigInt(ecKey.Y),
+			},
+		},
+	}
+	data, _ := json.Marshal(jwks)
+	file := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(file, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func signToken(method jwt.SigningMethod, kid string, key interface{}, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = kid
+	s, _ := token.SignedString(key)
+	return s
+}
+
+func TestAuth_Authenticate(t *testing.T) {
+	Convey("test authenticate", t, func() {
+		rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+		ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		a, err := New(Config{
+			Enable: true,
+			Tokens: []TokenConfig{{Subject: "app", Token: "secret"}},
+			JWT: &JWTConfig{
+				JWKSFile: writeJWKS(t, rsaKey, ecKey),
+				Issuer:   "vanus",
+				Audience: "gateway",
+			},
+		})
+		So(err, ShouldBeNil)
+		claims := func() jwt.MapClaims {
+			return jwt.MapClaims{
+				"sub": "user",
+				"iss": "vanus",
+				"aud": "gateway",
+				"exp": time.Now().Add(time.Hour).Unix(),
+			}
+		}
+
+		Convey("test static token", func() {
+			id, err := a.Authenticate("secret")
+			So(err, ShouldBeNil)
+			So(id.Subject, ShouldEqual, "app")
+
+			_, err = a.Authenticate("")
+			So(errors.Is(err, errors.ErrUnauthenticated), ShouldBeTrue)
+			_, err = a.Authenticate("unknown")
+			So(errors.Is(err, errors.ErrUnauthenticated), ShouldBeTrue)
+		})
+
+		Convey("test JWT", func() {
+			id, err := a.Authenticate(signToken(jwt.SigningMethodRS256, "rsa", rsaKey, claims()))
+			So(err, ShouldBeNil)
+			So(id.Subject, ShouldEqual, "user")
+			id, err = a.Authenticate(signToken(jwt.SigningMethodES256, "ec", ecKey, claims()))
+			So(err, ShouldBeNil)
+			So(id.Subject, ShouldEqual, "user")
+		})
+
+		Convey("test invalid JWT", func() {
+			otherKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+			_, err = a.Authenticate(signToken(jwt.SigningMethodRS256, "rsa", otherKey, claims()))
+			So(errors.Is(err, errors.ErrUnauthenticated), ShouldBeTrue)
+
+			_, err = a.Authenticate(signToken(jwt.SigningMethodRS256, "unknown", rsaKey, claims()))
+			So(errors.Is(err, errors.ErrUnauthenticated), ShouldBeTrue)
+
+			// the key type doesn't match the signing method.
+			_, err = a.Authenticate(signToken(jwt.SigningMethodRS256, "ec", rsaKey, claims()))
+			So(errors.Is(err, errors.ErrUnauthenticated), ShouldBeTrue)
+
+			expired := claims()
+			expired["exp"] = time.Now().Add(-time.Minute).Unix()
+			_, err = a.Authenticate(signToken(jwt.SigningMethodRS256, "rsa", rsaKey, expired))
+			So(errors.Is(err, errors.ErrUnauthenticated), ShouldBeTrue)
+
+			wrongAudience := claims()
+			wrongAudience["aud"] = "other"
+			_, err = a.Authenticate(signToken(jwt.SigningMethodRS256, "rsa", rsaKey, wrongAudience))
+			So(errors.Is(err, errors.ErrUnauthenticated), ShouldBeTrue)
+
+			noSubject := claims()
+			delete(noSubject, "sub")
+			_, err = a.Authenticate(signToken(jwt.SigningMethodRS256, "rsa", rsaKey, noSubject))
+			So(errors.Is(err, errors.ErrUnauthenticated), ShouldBeTrue)
+		})
+	})
+}
+
+func TestAuth_Authorize(t *testing.T) {
+	Convey("test authorize", t, func() {
+		a, err := New(Config{
+			Enable: true,
+			Rules: []Rule{
+				{Subjects: []string{"app"}, Eventbuses: []string{"order-*"}, Actions: []Action{ActionPublish}},
+				{Subjects: []string{"*"}, Subscriptions: []string{"0000000000000001"}, Actions: []Action{ActionConsume}},
+				{Subjects: []string{"admin"}, Eventbuses: []string{"*"}, Actions: []Action{ActionAdmin}},
+			},
+		})
+		So(err, ShouldBeNil)
+		eventbus := func(name string, action Action) *Permission {
+			return &Permission{Resource: Resource{Kind: ResourceEventbus, Name: name}, Action: action}
+		}
+
+		app := &Identity{Subject: "app"}
+		So(a.Authorize(app, nil), ShouldBeNil)
+		So(a.Authorize(app, eventbus("order-1", ActionPublish)), ShouldBeNil)
+		So(errors.Is(a.Authorize(app, eventbus("order-1", ActionConsume)), errors.ErrPermissionDenied), ShouldBeTrue)
+		So(errors.Is(a.Authorize(app, eventbus("other", ActionPublish)), errors.ErrPermissionDenied), ShouldBeTrue)
+		So(a.Authorize(app, &Permission{
+			Resource: Resource{Kind: ResourceSubscription, Name: "0000000000000001"},
+			Action:   ActionConsume,
+		}), ShouldBeNil)
+
+		admin := &Identity{Subject: "admin"}
+		So(a.Authorize(admin, eventbus("other", ActionPublish)), ShouldBeNil)
+		So(a.Authorize(admin, eventbus("other", ActionAdmin)), ShouldBeNil)
+		So(errors.Is(a.Authorize(admin, &Permission{
+			Resource: Resource{Kind: ResourceSubscription, Name: "0000000000000002"},
+			Action:   ActionConsume,
+		}), errors.ErrPermissionDenied), ShouldBeTrue)
+	})
+}
+
+func TestParseBearerToken(t *testing.T) {
+	Convey("test parse bearer token", t, func() {
+		So(ParseBearerToken("Bearer abc"), ShouldEqual, "abc")
+		So(ParseBearerToken("bearer  abc "), ShouldEqual, "abc")
+		So(ParseBearerToken("Basic abc"), ShouldEqual, "")
+		So(ParseBearerToken(""), ShouldEqual, "")
+	})
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	Convey("test unary server interceptor", t, func() {
+		a, _ := New(Config{
+			Enable: true,
+			Tokens: []TokenConfig{{Subject: "app", Token: "secret"}},
+			Rules: []Rule{
+				{Subjects: []string{"app"}, Eventbuses: []string{"ut"}, Actions: []Action{ActionPublish}},
+			},
+		})
+		policy := func(method string, req interface{}) (*Permission, error) {
+			return &Permission{
+				Resource: Resource{Kind: ResourceEventbus, Name: req.(string)},
+				Action:   ActionPublish,
+			}, nil
+		}
+		interceptor := UnaryServerInterceptor(a, policy)
+		info := &grpc.UnaryServerInfo{FullMethod: "/ut/Publish"}
+		var subject string
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			subject = IdentityFromContext(ctx).Subject
+			return req, nil
+		}
+
+		_, err := interceptor(context.Background(), "ut", info, handler)
+		So(errors.Is(err, errors.ErrUnauthenticated), ShouldBeTrue)
+
+		ctx := metadata.NewIncomingContext(context.Background(),
+			metadata.Pairs(authorizationHeader, "Bearer secret"))
+		_, err = interceptor(ctx, "other", info, handler)
+		So(errors.Is(err, errors.ErrPermissionDenied), ShouldBeTrue)
+
+		_, err = interceptor(ctx, "ut", info, handler)
+		So(err, ShouldBeNil)
+		So(subject, ShouldEqual, "app")
+	})
+}
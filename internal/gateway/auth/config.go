@@ -0,0 +1,63 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+type Action string
+
+const (
+	ActionPublish Action = "publish"
+	ActionConsume Action = "consume"
+	// ActionAdmin grants all actions on the resource.
+	ActionAdmin Action = "admin"
+)
+
+type ResourceKind string
+
+const (
+	ResourceEventbus     ResourceKind = "eventbus"
+	ResourceSubscription ResourceKind = "subscription"
+)
+
+type Config struct {
+	Enable bool          `yaml:"enable"`
+	Tokens []TokenConfig `yaml:"tokens"`
+	JWT    *JWTConfig    `yaml:"jwt"`
+	Rules  []Rule        `yaml:"rules"`
+}
+
+// TokenConfig is a static API token, the caller who presents the token is identified as the subject.
+type TokenConfig struct {
+	Subject string `yaml:"subject"`
+	Token   string `yaml:"token"`
+}
+
+// JWTConfig validates the bearer tokens which are signed by the keys in local JWKS file.
+type JWTConfig struct {
+	JWKSFile string `yaml:"jwks_file"`
+	Issuer   string `yaml:"issuer"`
+	Audience string `yaml:"audience"`
+	// SubjectClaim is the claim used as the subject of caller, "sub" by default.
+	SubjectClaim string `yaml:"subject_claim"`
+}
+
+// Rule grants the actions on the matched eventbuses and subscriptions to the matched subjects.
+// The subjects, eventbuses and subscriptions are shell patterns, e.g. "*" or "order-*", the
+// subscriptions are matched by the ID as shown by vsctl.
+type Rule struct {
+	Subjects      []string `yaml:"subjects"`
+	Eventbuses    []string `yaml:"eventbuses"`
+	Subscriptions []string `yaml:"subscriptions"`
+	Actions       []Action `yaml:"actions"`
+}
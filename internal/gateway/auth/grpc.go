@@ -0,0 +1,107 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+
+	"github.com/linkall-labs/vanus/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	authorizationHeader = "authorization"
+)
+
+// PolicyFunc returns the permission which is required by the request of method. The stream
+// methods are authorized for every received message.
+type PolicyFunc func(method string, req interface{}) (*Permission, error)
+
+func UnaryServerInterceptor(a *Auth, policy PolicyFunc) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		id, err := a.Authenticate(ParseBearerToken(credentialFromContext(ctx)))
+		if err != nil {
+			return nil, err
+		}
+		perm, err := policy(info.FullMethod, req)
+		if err != nil {
+			return nil, err
+		}
+		if err = a.Authorize(id, perm); err != nil {
+			return nil, err
+		}
+		return handler(WithIdentity(ctx, id), req)
+	}
+}
+
+func StreamServerInterceptor(a *Auth, policy PolicyFunc) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		id, err := a.Authenticate(ParseBearerToken(credentialFromContext(stream.Context())))
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authorizedStream{
+			ServerStream: stream,
+			ctx:          WithIdentity(stream.Context(), id),
+			auth:         a,
+			id:           id,
+			method:       info.FullMethod,
+			policy:       policy,
+		})
+	}
+}
+
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	auth   *Auth
+	id     *Identity
+	method string
+	policy PolicyFunc
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authorizedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	perm, err := s.policy(s.method, m)
+	if err != nil {
+		return err
+	}
+	return s.auth.Authorize(s.id, perm)
+}
+
+func credentialFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(authorizationHeader)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// ErrUnknownMethod is returned by PolicyFunc if the method isn't covered by the policy, the
+// methods which aren't known are denied.
+var ErrUnknownMethod = errors.ErrPermissionDenied.WithMessage("the method isn't allowed")
@@ -0,0 +1,126 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/observability/log"
+)
+
+// BatchEventResult is the result of an event in batch mode, the results of a batch
+// are responded in the same order as events.
+type BatchEventResult struct {
+	EventID string `json:"event_id,omitempty"`
+	BusName string `json:"eventbus_name,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// batchMiddleware handles the request with content type application/cloudevents-batch+json,
+// all events of a batch are appended to the eventbus with one batched write.
+func (ga *ceGateway) batchMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !isBatchRequest(req) {
+			next.ServeHTTP(w, req)
+			return
+		}
+		ebName := getEventBusFromPath(&cehttp.RequestData{URL: req.URL})
+		if ebName == "" {
+			http.Error(w, "invalid eventbus name", http.StatusBadRequest)
+			return
+		}
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var events []v2.Event
+		if err = json.Unmarshal(body, &events); err != nil {
+			http.Error(w, fmt.Sprintf("invalid batch events: %s", err), http.StatusBadRequest)
+			return
+		}
+		if len(events) == 0 {
+			http.Error(w, "empty batch events", http.StatusBadRequest)
+			return
+		}
+		results, status := ga.receiveBatch(req.Context(), ebName, events)
+		w.Header().Set("Content-Type", v2.ApplicationJSON)
+		w.WriteHeader(status)
+		if err = json.NewEncoder(w).Encode(results); err != nil {
+			log.Warning(req.Context(), "write batch response failed", map[string]interface{}{
+				log.KeyError: err,
+			})
+		}
+	})
+}
+
+func (ga *ceGateway) receiveBatch(ctx context.Context, ebName string, events []v2.Event) ([]BatchEventResult, int) {
+	_ctx, span := ga.tracer.Start(ctx, "receiveBatch")
+	defer span.End()
+
+	results := make([]BatchEventResult, len(events))
+	// delayed events are sent to the timer eventbus
+	batch := make([]*v2.Event, len(events))
+	targets := make([]string, len(events))
+	for idx := range events {
+		event := &events[idx]
+		extensions := event.Extensions()
+		if err := checkExtension(extensions); err != nil {
+			results[idx].Error = err.Error()
+			return results, http.StatusBadRequest
+		}
+		if err := ga.proxySrv.ValidateSchema(_ctx, ebName, event); err != nil {
+			results[idx].Error = err.Error()
+			return results, http.StatusBadRequest
+		}
+		target := ebName
+		event.SetExtension(primitive.XVanusEventbus, ebName)
+		if eventTime, ok := extensions[primitive.XVanusDeliveryTime]; ok {
+			if _, err := types.ParseTime(fmt.Sprintf("%v", eventTime)); err != nil {
+				results[idx].Error = "invalid delivery time"
+				return results, http.StatusBadRequest
+			}
+			target = primitive.TimerEventbusName
+		}
+		batch[idx] = event
+		targets[idx] = target
+	}
+
+	status := http.StatusOK
+	for idx, res := range ga.appender.AppendGrouped(_ctx, batch, targets) {
+		results[idx].BusName = res.Eventbus
+		if res.Err != nil {
+			results[idx].Error = res.Err.Error()
+			status = http.StatusInternalServerError
+			continue
+		}
+		results[idx].EventID = res.EventID
+	}
+	return results, status
+}
+
+func isBatchRequest(req *http.Request) bool {
+	contentType := req.Header.Get("Content-Type")
+	return strings.HasPrefix(contentType, v2.ApplicationCloudEventsBatchJSON)
+}
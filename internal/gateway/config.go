@@ -15,10 +15,11 @@
 package gateway
 
 import (
+	"github.com/linkall-labs/vanus/internal/gateway/auth"
 	"github.com/linkall-labs/vanus/internal/gateway/proxy"
 	"github.com/linkall-labs/vanus/internal/primitive"
 	"github.com/linkall-labs/vanus/observability"
-	"google.golang.org/grpc/credentials/insecure"
+	"github.com/linkall-labs/vanus/pkg/util/tlsconfig"
 )
 
 type Config struct {
@@ -26,6 +27,11 @@ type Config struct {
 	Observability        observability.Config `yaml:"observability"`
 	ControllerAddr       []string             `yaml:"controllers"`
 	GRPCReflectionEnable bool                 `yaml:"grpc_reflection_enable"`
+	Auth                 auth.Config          `yaml:"auth"`
+	TLS                  tlsconfig.Config     `yaml:"tls"`
+	// IdempotentPublish skips the published events which have been appended recently, an event
+	// is identified by its producer id and producer sequence, or its source and id.
+	IdempotentPublish bool `yaml:"idempotent_publish"`
 }
 
 func (c Config) GetProxyConfig() proxy.Config {
@@ -34,7 +40,9 @@ func (c Config) GetProxyConfig() proxy.Config {
 		ProxyPort:              c.Port,
 		CloudEventReceiverPort: c.GetCloudEventReceiverPort(),
 		GRPCReflectionEnable:   c.GRPCReflectionEnable,
-		Credentials:            insecure.NewCredentials(),
+		Credentials:            tlsconfig.ServerCredentials(),
+		Auth:                   c.Auth,
+		IdempotentPublish:      c.IdempotentPublish,
 	}
 }
 
@@ -20,7 +20,6 @@ import (
 	"net"
 	"net/http"
 	"strings"
-	"sync"
 
 	v2 "github.com/cloudevents/sdk-go/v2"
 	"github.com/cloudevents/sdk-go/v2/client"
@@ -29,11 +28,12 @@ import (
 	"github.com/cloudevents/sdk-go/v2/types"
 	"github.com/google/uuid"
 	eb "github.com/linkall-labs/vanus/client"
-	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/gateway/auth"
 	"github.com/linkall-labs/vanus/internal/gateway/proxy"
 	"github.com/linkall-labs/vanus/internal/primitive"
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/observability/tracing"
+	"github.com/linkall-labs/vanus/pkg/errors"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -43,6 +43,7 @@ const (
 
 var (
 	requestDataFromContext = cehttp.RequestDataFromContext
+	checkExtension         = primitive.CheckExtension
 )
 
 type EventData struct {
@@ -52,9 +53,8 @@ type EventData struct {
 
 type ceGateway struct {
 	// ceClient  v2.Client
-	busWriter  sync.Map
 	config     Config
-	client     eb.Client
+	appender   *proxy.EventAppender
 	proxySrv   *proxy.ControllerProxy
 	tracer     *tracing.Tracer
 	ceListener net.Listener
@@ -63,7 +63,7 @@ type ceGateway struct {
 func NewGateway(config Config) *ceGateway {
 	return &ceGateway{
 		config:   config,
-		client:   eb.Connect(config.ControllerAddr),
+		appender: proxy.NewEventAppender(eb.Connect(config.ControllerAddr), config.IdempotentPublish),
 		proxySrv: proxy.NewControllerProxy(config.GetProxyConfig()),
 		tracer:   tracing.NewTracer("cloudevents", trace.SpanKindServer),
 	}
@@ -94,7 +94,17 @@ func (ga *ceGateway) startCloudEventsReceiver(ctx context.Context) error {
 		return err
 	}
 
-	c, err := client.NewHTTP(cehttp.WithListener(ls), cehttp.WithRequestDataAtContextMiddleware())
+	opts := []cehttp.Option{cehttp.WithListener(ls), cehttp.WithRequestDataAtContextMiddleware(),
+		cehttp.WithMiddleware(ga.batchMiddleware)}
+	if ga.config.Auth.Enable {
+		a, err := auth.New(ga.config.Auth)
+		if err != nil {
+			return err
+		}
+		// the middleware added later wraps the former, so the batch requests are authorized too.
+		opts = append(opts, cehttp.WithMiddleware(authMiddleware(a)))
+	}
+	c, err := client.NewHTTP(opts...)
 	if err != nil {
 		return err
 	}
@@ -122,6 +132,9 @@ func (ga *ceGateway) receive(ctx context.Context, event v2.Event) (*v2.Event, pr
 	if err != nil {
 		return nil, v2.NewHTTPResult(http.StatusBadRequest, err.Error())
 	}
+	if err = ga.proxySrv.ValidateSchema(_ctx, ebName, &event); err != nil {
+		return nil, v2.NewHTTPResult(http.StatusBadRequest, err.Error())
+	}
 
 	event.SetExtension(primitive.XVanusEventbus, ebName)
 	if eventTime, ok := extensions[primitive.XVanusDeliveryTime]; ok {
@@ -136,12 +149,7 @@ func (ga *ceGateway) receive(ctx context.Context, event v2.Event) (*v2.Event, pr
 		ebName = primitive.TimerEventbusName
 	}
 
-	v, exist := ga.busWriter.Load(ebName)
-	if !exist {
-		v, _ = ga.busWriter.LoadOrStore(ebName, ga.client.Eventbus(ctx, ebName).Writer())
-	}
-	writer, _ := v.(api.BusWriter)
-	eventID, err := writer.AppendOne(_ctx, &event)
+	eventID, err := ga.appender.Writer(ctx, ebName).AppendOne(_ctx, &event, ga.appender.Options()...)
 	if err != nil {
 		log.Warning(_ctx, "append to failed", map[string]interface{}{
 			log.KeyError: err,
@@ -160,20 +168,30 @@ func (ga *ceGateway) receive(ctx context.Context, event v2.Event) (*v2.Event, pr
 	return resEvent, v2.ResultACK
 }
 
-func checkExtension(extensions map[string]interface{}) error {
-	if len(extensions) == 0 {
-		return nil
-	}
-	for name := range extensions {
-		if name == primitive.XVanusDeliveryTime {
-			continue
-		}
-		// event attribute can not prefix with vanus system use
-		if strings.HasPrefix(name, primitive.XVanus) {
-			return fmt.Errorf("invalid ce attribute [%s] perfix %s", name, primitive.XVanus)
-		}
+// authMiddleware requires the caller has the permission to publish to the eventbus.
+func authMiddleware(a *auth.Auth) cehttp.Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			ebName := getEventBusFromPath(&cehttp.RequestData{URL: req.URL})
+			if ebName == "" {
+				http.Error(w, "invalid eventbus name", http.StatusBadRequest)
+				return
+			}
+			id, err := a.Check(req.Header.Get("Authorization"), &auth.Permission{
+				Resource: auth.Resource{Kind: auth.ResourceEventbus, Name: ebName},
+				Action:   auth.ActionPublish,
+			})
+			if err != nil {
+				status := http.StatusForbidden
+				if errors.Is(err, errors.ErrUnauthenticated) {
+					status = http.StatusUnauthorized
+				}
+				http.Error(w, err.Error(), status)
+				return
+			}
+			next.ServeHTTP(w, req.WithContext(auth.WithIdentity(req.Context(), id)))
+		})
 	}
-	return nil
 }
 
 func getEventBusFromPath(reqData *cehttp.RequestData) string {
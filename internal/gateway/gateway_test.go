@@ -15,14 +15,20 @@
 package gateway
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 	"time"
 
 	"github.com/linkall-labs/vanus/client"
 	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/gateway/auth"
+	"github.com/linkall-labs/vanus/internal/gateway/proxy"
 	"github.com/linkall-labs/vanus/internal/primitive"
 
 	ce "github.com/cloudevents/sdk-go/v2"
@@ -117,6 +123,10 @@ func TestGateway_checkExtension(t *testing.T) {
 		e.SetExtension(primitive.XVanusDeliveryTime, "test")
 		err = checkExtension(e.Extensions())
 		So(err, ShouldBeNil)
+		e.SetExtension(primitive.XVanusProducerID, "producer")
+		e.SetExtension(primitive.XVanusProducerSeq, 1)
+		err = checkExtension(e.Extensions())
+		So(err, ShouldBeNil)
 		e.SetExtension(primitive.XVanus+"fortest", "test")
 		err = checkExtension(e.Extensions())
 		So(err, ShouldNotBeNil)
@@ -168,7 +178,7 @@ func TestGateway_EventID(t *testing.T) {
 	ga := NewGateway(cfg)
 	defer ga.Stop()
 
-	ga.client = mockClient
+	ga.appender = proxy.NewEventAppender(mockClient, false)
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	_ = ga.startCloudEventsReceiver(ctx)
@@ -201,3 +211,117 @@ func TestGateway_EventID(t *testing.T) {
 		So(ed.EventID, ShouldEqual, eventID)
 	})
 }
+
+func TestGateway_BatchEvents(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+	busName := "test"
+
+	mockClient := client.NewMockClient(ctrl)
+	mockEventbus := api.NewMockEventbus(ctrl)
+	mockBusWriter := api.NewMockBusWriter(ctrl)
+	mockClient.EXPECT().Eventbus(Any(), Any()).AnyTimes().Return(mockEventbus)
+	mockEventbus.EXPECT().Writer().AnyTimes().Return(mockBusWriter)
+
+	ga := NewGateway(Config{ControllerAddr: []string{"127.0.0.1:2048"}})
+	ga.appender = proxy.NewEventAppender(mockClient, false)
+	handler := ga.batchMiddleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	newEvent := func(id string) ce.Event {
+		event := ce.NewEvent()
+		event.SetID(id)
+		event.SetSource("example/uri")
+		event.SetType("example.type")
+		_ = event.SetData(ce.ApplicationJSON, map[string]string{"hello": "world"})
+		return event
+	}
+	post := func(contentType string, events []ce.Event) (int, []BatchEventResult) {
+		data, err := json.Marshal(events)
+		So(err, ShouldBeNil)
+		req := httptest.NewRequest(http.MethodPost, "/gateway/"+busName, bytes.NewReader(data))
+		req.Header.Set("Content-Type", contentType)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		var results []BatchEventResult
+		_ = json.NewDecoder(w.Body).Decode(&results)
+		return w.Code, results
+	}
+
+	Convey("test non-batch request is passed through", t, func() {
+		status, _ := post(ce.ApplicationCloudEventsJSON, nil)
+		So(status, ShouldEqual, http.StatusNoContent)
+	})
+
+	Convey("test put batch events", t, func() {
+		mockBusWriter.EXPECT().AppendMany(Any(), Any()).Times(1).DoAndReturn(
+			func(_ context.Context, events []*ce.Event, _ ...api.WriteOption) ([]string, error) {
+				So(events, ShouldHaveLength, 2)
+				So(events[0].Extensions()[primitive.XVanusEventbus], ShouldEqual, busName)
+				return []string{"AA", "BB"}, nil
+			})
+		status, results := post(ce.ApplicationCloudEventsBatchJSON, []ce.Event{newEvent("1"), newEvent("2")})
+		So(status, ShouldEqual, http.StatusOK)
+		So(results, ShouldHaveLength, 2)
+		So(results[0].EventID, ShouldEqual, "AA")
+		So(results[1].EventID, ShouldEqual, "BB")
+		So(results[1].BusName, ShouldEqual, busName)
+	})
+
+	Convey("test put batch events with partial failure", t, func() {
+		mockBusWriter.EXPECT().AppendMany(Any(), Any()).Times(1).Return([]string{"AA", ""},
+			&api.BatchAppendError{Total: 2, Errors: map[int]error{1: fmt.Errorf("test")}})
+		status, results := post(ce.ApplicationCloudEventsBatchJSON, []ce.Event{newEvent("1"), newEvent("2")})
+		So(status, ShouldEqual, http.StatusInternalServerError)
+		So(results, ShouldHaveLength, 2)
+		So(results[0].EventID, ShouldEqual, "AA")
+		So(results[0].Error, ShouldBeEmpty)
+		So(results[1].Error, ShouldEqual, "test")
+	})
+
+	Convey("test put batch events with invalid extension", t, func() {
+		e := newEvent("1")
+		e.SetExtension(primitive.XVanus+"fortest", "test")
+		status, _ := post(ce.ApplicationCloudEventsBatchJSON, []ce.Event{e})
+		So(status, ShouldEqual, http.StatusBadRequest)
+	})
+
+	Convey("test put empty batch", t, func() {
+		status, _ := post(ce.ApplicationCloudEventsBatchJSON, []ce.Event{})
+		So(status, ShouldEqual, http.StatusBadRequest)
+	})
+}
+
+func TestGateway_authMiddleware(t *testing.T) {
+	Convey("test auth middleware", t, func() {
+		a, err := auth.New(auth.Config{
+			Enable: true,
+			Tokens: []auth.TokenConfig{{Subject: "app", Token: "secret"}},
+			Rules: []auth.Rule{
+				{Subjects: []string{"app"}, Eventbuses: []string{"ut"}, Actions: []auth.Action{auth.ActionPublish}},
+			},
+		})
+		So(err, ShouldBeNil)
+		var subject string
+		handler := authMiddleware(a)(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			subject = auth.IdentityFromContext(req.Context()).Subject
+			w.WriteHeader(http.StatusOK)
+		}))
+		serve := func(path, credential string) int {
+			req := httptest.NewRequest(http.MethodPost, path, nil)
+			if credential != "" {
+				req.Header.Set("Authorization", credential)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+			return w.Code
+		}
+
+		So(serve("/gateway/ut", ""), ShouldEqual, http.StatusUnauthorized)
+		So(serve("/gateway/ut", "Bearer unknown"), ShouldEqual, http.StatusUnauthorized)
+		So(serve("/gateway/other", "Bearer secret"), ShouldEqual, http.StatusForbidden)
+		So(serve("/gateway/ut", "Bearer secret"), ShouldEqual, http.StatusOK)
+		So(subject, ShouldEqual, "app")
+	})
+}
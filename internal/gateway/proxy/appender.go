@@ -0,0 +1,110 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	stderrors "errors"
+	"sync"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	eb "github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/option"
+	"github.com/linkall-labs/vanus/observability/log"
+)
+
+// EventAppender appends the events published by users, it's shared by the CloudEvents receiver
+// and the Publish API, so that they append events in the same way. The writers of eventbuses
+// are cached.
+type EventAppender struct {
+	client     eb.Client
+	idempotent bool
+	writers    sync.Map
+}
+
+// AppendResult is the result of an event appended by EventAppender.AppendGrouped.
+type AppendResult struct {
+	Eventbus string
+	EventID  string
+	Err      error
+}
+
+func NewEventAppender(client eb.Client, idempotent bool) *EventAppender {
+	return &EventAppender{
+		client:     client,
+		idempotent: idempotent,
+	}
+}
+
+// Writer returns the cached writer of eventbus.
+func (a *EventAppender) Writer(ctx context.Context, ebName string) api.BusWriter {
+	v, exist := a.writers.Load(ebName)
+	if !exist {
+		v, _ = a.writers.LoadOrStore(ebName, a.client.Eventbus(ctx, ebName).Writer())
+	}
+	writer, _ := v.(api.BusWriter)
+	return writer
+}
+
+// Options returns the options to append the events published by users.
+func (a *EventAppender) Options() []api.WriteOption {
+	if a.idempotent {
+		return []api.WriteOption{option.WithIdempotence()}
+	}
+	return nil
+}
+
+// AppendGrouped appends events[i] to eventbus targets[i], the events of the same eventbus are
+// appended with one batched write. The events which are nil are skipped, and their results are
+// left empty.
+func (a *EventAppender) AppendGrouped(ctx context.Context,
+	events []*v2.Event, targets []string) []AppendResult {
+	results := make([]AppendResult, len(events))
+	groups := make(map[string][]int)
+	for idx, event := range events {
+		if event == nil {
+			continue
+		}
+		groups[targets[idx]] = append(groups[targets[idx]], idx)
+	}
+
+	for target, indexes := range groups {
+		batch := make([]*v2.Event, len(indexes))
+		for i, idx := range indexes {
+			batch[i] = events[idx]
+		}
+		eids, err := a.Writer(ctx, target).AppendMany(ctx, batch, a.Options()...)
+		var batchErr *api.BatchAppendError
+		if err != nil && !stderrors.As(err, &batchErr) {
+			log.Warning(ctx, "append published events failed", map[string]interface{}{
+				log.KeyError: err,
+				"eventbus":   target,
+			})
+		}
+		for i, idx := range indexes {
+			results[idx].Eventbus = target
+			switch {
+			case batchErr != nil && batchErr.Errors[i] != nil:
+				results[idx].Err = batchErr.Errors[i]
+			case err != nil && batchErr == nil:
+				results[idx].Err = err
+			default:
+				results[idx].EventID = eids[i]
+			}
+		}
+	}
+	return results
+}
@@ -0,0 +1,72 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	stdCtx "context"
+	"errors"
+	"testing"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestEventAppender_AppendGrouped(t *testing.T) {
+	Convey("test append events grouped by eventbus", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockClient := client.NewMockClient(ctrl)
+		eb1 := api.NewMockEventbus(ctrl)
+		eb2 := api.NewMockEventbus(ctrl)
+		w1 := api.NewMockBusWriter(ctrl)
+		w2 := api.NewMockBusWriter(ctrl)
+		mockClient.EXPECT().Eventbus(gomock.Any(), "eb1").Times(1).Return(eb1)
+		mockClient.EXPECT().Eventbus(gomock.Any(), "eb2").Times(1).Return(eb2)
+		eb1.EXPECT().Writer().Times(1).Return(w1)
+		eb2.EXPECT().Writer().Times(1).Return(w2)
+
+		newEvent := func(id string) *v2.Event {
+			e := v2.NewEvent()
+			e.SetID(id)
+			return &e
+		}
+		events := []*v2.Event{newEvent("1"), nil, newEvent("3"), newEvent("4")}
+		targets := []string{"eb1", "", "eb2", "eb1"}
+
+		w1.EXPECT().AppendMany(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+			func(_ stdCtx.Context, events []*v2.Event, _ ...api.WriteOption) ([]string, error) {
+				So(events, ShouldHaveLength, 2)
+				return []string{"a", ""}, &api.BatchAppendError{Total: 2, Errors: map[int]error{1: errors.New("test")}}
+			})
+		w2.EXPECT().AppendMany(gomock.Any(), gomock.Any()).Times(1).Return(nil, errors.New("unavailable"))
+
+		So(NewEventAppender(mockClient, true).Options(), ShouldHaveLength, 1)
+		a := NewEventAppender(mockClient, false)
+		results := a.AppendGrouped(stdCtx.Background(), events, targets)
+		So(results, ShouldHaveLength, 4)
+		So(results[0], ShouldResemble, AppendResult{Eventbus: "eb1", EventID: "a"})
+		So(results[1], ShouldResemble, AppendResult{})
+		So(results[2].Eventbus, ShouldEqual, "eb2")
+		So(results[2].Err.Error(), ShouldEqual, "unavailable")
+		So(results[3].Eventbus, ShouldEqual, "eb1")
+		So(results[3].Err.Error(), ShouldEqual, "test")
+
+		// the writers are cached.
+		So(a.Writer(stdCtx.Background(), "eb1"), ShouldEqual, w1)
+	})
+}
@@ -0,0 +1,117 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"strings"
+
+	"github.com/linkall-labs/vanus/internal/gateway/auth"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+)
+
+const (
+	proxyServicePrefix = "/linkall.vanus.proxy.ControllerProxy/"
+	allResources       = "*"
+)
+
+func eventbusPermission(name string, action auth.Action) *auth.Permission {
+	return &auth.Permission{
+		Resource: auth.Resource{Kind: auth.ResourceEventbus, Name: name},
+		Action:   action,
+	}
+}
+
+func subscriptionPermission(id uint64, action auth.Action) *auth.Permission {
+	return &auth.Permission{
+		Resource: auth.Resource{Kind: auth.ResourceSubscription, Name: vanus.NewIDFromUint64(id).String()},
+		Action:   action,
+	}
+}
+
+// authPolicy returns the permission required by the methods of ControllerProxy, the methods
+// which list all resources only require the caller is authenticated.
+func authPolicy(method string, req interface{}) (*auth.Permission, error) {
+	if !strings.HasPrefix(method, proxyServicePrefix) {
+		return nil, auth.ErrUnknownMethod
+	}
+	switch r := req.(type) {
+	case *ctrlpb.CreateEventBusRequest:
+		return eventbusPermission(r.Name, auth.ActionAdmin), nil
+	case *ctrlpb.UpdateEventBusRequest:
+		return eventbusPermission(r.Name, auth.ActionAdmin), nil
+	case *metapb.EventBus:
+		if strings.TrimPrefix(method, proxyServicePrefix) == "GetEventBus" {
+			return eventbusPermission(r.Name, auth.ActionConsume), nil
+		}
+		return eventbusPermission(r.Name, auth.ActionAdmin), nil
+	case *ctrlpb.ListSegmentRequest:
+		// the request has no eventbus name.
+		return eventbusPermission(allResources, auth.ActionAdmin), nil
+	case *ctrlpb.CreateSubscriptionRequest:
+		return eventbusPermission(r.GetSubscription().GetEventBus(), auth.ActionConsume), nil
+	case *ctrlpb.UpdateSubscriptionRequest:
+		return subscriptionPermission(r.Id, auth.ActionAdmin), nil
+	case *ctrlpb.DeleteSubscriptionRequest:
+		return subscriptionPermission(r.Id, auth.ActionAdmin), nil
+	case *ctrlpb.GetSubscriptionRequest:
+		return subscriptionPermission(r.Id, auth.ActionConsume), nil
+	case *proxypb.LookupOffsetRequest:
+		return eventbusPermission(r.Eventbus, auth.ActionConsume), nil
+	case *proxypb.GetEventRequest:
+		return eventbusPermission(r.Eventbus, auth.ActionConsume), nil
+	case *proxypb.ValidateSubscriptionRequest:
+		return eventbusPermission(r.Eventbus, auth.ActionConsume), nil
+	case *proxypb.PublishRequest:
+		return eventbusPermission(r.Eventbus, auth.ActionPublish), nil
+	case *proxypb.PullRequest:
+		return subscriptionPermission(r.SubscriptionId, auth.ActionConsume), nil
+	case *proxypb.AckRequest:
+		return subscriptionPermission(r.SubscriptionId, auth.ActionConsume), nil
+	case *proxypb.ScheduleEventRequest:
+		return eventbusPermission(r.Eventbus, auth.ActionPublish), nil
+	case *proxypb.CancelScheduledEventRequest:
+		return eventbusPermission(r.Eventbus, auth.ActionPublish), nil
+	case *proxypb.ListScheduledEventsRequest:
+		return eventbusPermission(r.Eventbus, auth.ActionConsume), nil
+	case *ctrlpb.RegisterSchemaRequest:
+		return eventbusPermission(r.Eventbus, auth.ActionAdmin), nil
+	case *ctrlpb.GetSchemaRequest:
+		return eventbusPermission(r.Eventbus, auth.ActionConsume), nil
+	case *ctrlpb.ListSchemaRequest:
+		return eventbusPermission(r.Eventbus, auth.ActionConsume), nil
+	case *ctrlpb.DeleteSchemaRequest:
+		return eventbusPermission(r.Eventbus, auth.ActionAdmin), nil
+	case *ctrlpb.DrainVolumeRequest, *ctrlpb.GetVolumeDrainStatusRequest:
+		// the operations of cluster affect all eventbuses.
+		return eventbusPermission(allResources, auth.ActionAdmin), nil
+	case *proxypb.GetSubscriptionLagRequest:
+		return subscriptionPermission(r.SubscriptionId, auth.ActionConsume), nil
+	case *proxypb.ListDeadLetterEventRequest:
+		return subscriptionPermission(r.SubscriptionId, auth.ActionConsume), nil
+	case *ctrlpb.ListDeadLetterOperationRequest:
+		return subscriptionPermission(r.SubscriptionId, auth.ActionConsume), nil
+	case *proxypb.DeadLetterOperationRequest:
+		// replaying or purging changes what the subscription delivers.
+		return subscriptionPermission(r.SubscriptionId, auth.ActionAdmin), nil
+	}
+	switch strings.TrimPrefix(method, proxyServicePrefix) {
+	case "ListEventBus", "ListSubscription", "ClusterInfo":
+		return nil, nil
+	}
+	return nil, auth.ErrUnknownMethod
+}
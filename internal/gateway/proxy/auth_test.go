@@ -0,0 +1,70 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/linkall-labs/vanus/internal/gateway/auth"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestAuthPolicy(t *testing.T) {
+	Convey("test auth policy of proxy methods", t, func() {
+		perm, err := authPolicy(proxyServicePrefix+"Publish", &proxypb.PublishRequest{Eventbus: "ut"})
+		So(err, ShouldBeNil)
+		So(perm, ShouldResemble, eventbusPermission("ut", auth.ActionPublish))
+
+		perm, err = authPolicy(proxyServicePrefix+"GetEventBus", &metapb.EventBus{Name: "ut"})
+		So(err, ShouldBeNil)
+		So(perm.Action, ShouldEqual, auth.ActionConsume)
+		perm, err = authPolicy(proxyServicePrefix+"DeleteEventBus", &metapb.EventBus{Name: "ut"})
+		So(err, ShouldBeNil)
+		So(perm.Action, ShouldEqual, auth.ActionAdmin)
+
+		perm, err = authPolicy(proxyServicePrefix+"Pull", &proxypb.PullRequest{SubscriptionId: 1})
+		So(err, ShouldBeNil)
+		So(perm.Resource, ShouldResemble, auth.Resource{Kind: auth.ResourceSubscription, Name: "0000000000000001"})
+
+		perm, err = authPolicy(proxyServicePrefix+"CreateSubscription", &ctrlpb.CreateSubscriptionRequest{
+			Subscription: &ctrlpb.SubscriptionRequest{EventBus: "ut"},
+		})
+		So(err, ShouldBeNil)
+		So(perm, ShouldResemble, eventbusPermission("ut", auth.ActionConsume))
+
+		perm, err = authPolicy(proxyServicePrefix+"ListDeadLetterEvent",
+			&proxypb.ListDeadLetterEventRequest{SubscriptionId: 1})
+		So(err, ShouldBeNil)
+		So(perm, ShouldResemble, subscriptionPermission(1, auth.ActionConsume))
+		perm, err = authPolicy(proxyServicePrefix+"ReplayDeadLetterEvent",
+			&proxypb.DeadLetterOperationRequest{SubscriptionId: 1})
+		So(err, ShouldBeNil)
+		So(perm, ShouldResemble, subscriptionPermission(1, auth.ActionAdmin))
+
+		perm, err = authPolicy(proxyServicePrefix+"ListEventBus", &emptypb.Empty{})
+		So(err, ShouldBeNil)
+		So(perm, ShouldBeNil)
+
+		_, err = authPolicy(proxyServicePrefix+"Unknown", &emptypb.Empty{})
+		So(errors.Is(err, errors.ErrPermissionDenied), ShouldBeTrue)
+		_, err = authPolicy("/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo", nil)
+		So(errors.Is(err, errors.ErrPermissionDenied), ShouldBeTrue)
+	})
+}
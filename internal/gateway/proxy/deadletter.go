@@ -0,0 +1,382 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	stdErr "errors"
+	"strings"
+	"time"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/option"
+	"github.com/linkall-labs/vanus/client/pkg/policy"
+	"github.com/linkall-labs/vanus/internal/convert"
+	"github.com/linkall-labs/vanus/internal/gateway/auth"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+)
+
+const (
+	defaultDeadLetterLimit = 100
+	maximumDeadLetterLimit = 1000
+	deadLetterReadBatch    = 64
+)
+
+type eventPosition struct {
+	eventlogID uint64
+	offset     uint64
+}
+
+type deadLetterEvent struct {
+	position eventPosition
+	event    *v2.Event
+	time     time.Time
+}
+
+// deadLetterScan is the dead-lettered events of a subscription to look through, the events were
+// purged are invisible, and the events were replayed are invisible too if skipReplayed is set.
+type deadLetterScan struct {
+	subscriptionID string
+	eventbus       string
+	filter         *metapb.DeadLetterFilter
+	selected       map[eventPosition]bool
+	purged         map[eventPosition]bool
+	replayed       map[eventPosition]bool
+	skipReplayed   bool
+}
+
+func (cp *ControllerProxy) ListDeadLetterEvent(ctx context.Context,
+	req *proxypb.ListDeadLetterEventRequest) (*proxypb.ListDeadLetterEventResponse, error) {
+	scan, err := cp.newDeadLetterScan(ctx, req.GetSubscriptionId(), req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	events, err := cp.scanDeadLetter(ctx, scan, deadLetterLimit(req.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+	res := &proxypb.ListDeadLetterEventResponse{
+		Events: make([]*proxypb.DeadLetterEvent, 0, len(events)),
+	}
+	for _, e := range events {
+		pe, err := convert.ToPbCloudEvent(e.event)
+		if err != nil {
+			return nil, errors.ErrInternal.WithMessage("convert event failed").Wrap(err)
+		}
+		de := &proxypb.DeadLetterEvent{
+			Position: &metapb.EventPosition{EventlogId: e.position.eventlogID, Offset: e.position.offset},
+			Event:    pe,
+			Replayed: scan.replayed[e.position],
+		}
+		de.Reason, _ = types.ToString(e.event.Extensions()[primitive.DeadLetterReason])
+		de.LastDeliveryError, _ = types.ToString(e.event.Extensions()[primitive.LastDeliveryError])
+		if !e.time.IsZero() {
+			de.DeadLetterTime = e.time.UnixMilli()
+		}
+		res.Events = append(res.Events, de)
+	}
+	return res, nil
+}
+
+// ReplayDeadLetterEvent sends the matched events to the retry eventbus, so they are delivered to
+// the sink of subscription again with a fresh retry budget. The events which have been replayed
+// are skipped unless IncludeReplayed is set.
+func (cp *ControllerProxy) ReplayDeadLetterEvent(ctx context.Context,
+	req *proxypb.DeadLetterOperationRequest) (*metapb.DeadLetterOperation, error) {
+	scan, err := cp.newDeadLetterScan(ctx, req.GetSubscriptionId(), req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	scan.skipReplayed = !req.GetIncludeReplayed()
+	events, err := cp.scanDeadLetter(ctx, scan, deadLetterLimit(req.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 {
+		return nil, errors.ErrResourceNotFound.WithMessage("no dead letter event matched")
+	}
+	batch := make([]*v2.Event, len(events))
+	for i := range events {
+		batch[i] = replayEvent(events[i].event, scan.subscriptionID)
+	}
+	_, err = cp.getBusWriter(ctx, primitive.RetryEventbusName).AppendMany(ctx, batch)
+	failed := map[int]error{}
+	if err != nil {
+		var batchErr *api.BatchAppendError
+		if !stdErr.As(err, &batchErr) || len(batchErr.Errors) == len(batch) {
+			return nil, errors.ErrInternal.WithMessage("replay dead letter event failed").Wrap(err)
+		}
+		failed = batchErr.Errors
+		log.Warning(ctx, "replay some of dead letter events failed", map[string]interface{}{
+			log.KeyError:          err,
+			log.KeySubscriptionID: scan.subscriptionID,
+		})
+	}
+	replayed := make([]*metapb.EventPosition, 0, len(events))
+	for i, e := range events {
+		if _, ok := failed[i]; ok {
+			continue
+		}
+		replayed = append(replayed, &metapb.EventPosition{EventlogId: e.position.eventlogID, Offset: e.position.offset})
+	}
+	return cp.recordDeadLetterOperation(ctx, req, metapb.DeadLetterOperation_REPLAY, replayed)
+}
+
+// PurgeDeadLetterEvent hides the matched events, the events are kept in the dead letter eventbus
+// until they expire, but they can't be listed or replayed anymore.
+func (cp *ControllerProxy) PurgeDeadLetterEvent(ctx context.Context,
+	req *proxypb.DeadLetterOperationRequest) (*metapb.DeadLetterOperation, error) {
+	scan, err := cp.newDeadLetterScan(ctx, req.GetSubscriptionId(), req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	events, err := cp.scanDeadLetter(ctx, scan, deadLetterLimit(req.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 {
+		return nil, errors.ErrResourceNotFound.WithMessage("no dead letter event matched")
+	}
+	purged := make([]*metapb.EventPosition, 0, len(events))
+	for _, e := range events {
+		purged = append(purged, &metapb.EventPosition{EventlogId: e.position.eventlogID, Offset: e.position.offset})
+	}
+	return cp.recordDeadLetterOperation(ctx, req, metapb.DeadLetterOperation_PURGE, purged)
+}
+
+func (cp *ControllerProxy) ListDeadLetterOperation(ctx context.Context,
+	req *ctrlpb.ListDeadLetterOperationRequest) (*ctrlpb.ListDeadLetterOperationResponse, error) {
+	if req.GetSubscriptionId() == 0 {
+		return nil, errors.ErrInvalidRequest.WithMessage("the subscription id can't be empty")
+	}
+	return cp.triggerCtrl.ListDeadLetterOperation(ctx, req)
+}
+
+func (cp *ControllerProxy) recordDeadLetterOperation(ctx context.Context, req *proxypb.DeadLetterOperationRequest,
+	typ metapb.DeadLetterOperation_Type, events []*metapb.EventPosition) (*metapb.DeadLetterOperation, error) {
+	op := &metapb.DeadLetterOperation{
+		SubscriptionId: req.GetSubscriptionId(),
+		Type:           typ,
+		Filter:         req.GetFilter(),
+		Events:         events,
+	}
+	if id := auth.IdentityFromContext(ctx); id != nil {
+		op.Operator = id.Subject
+	}
+	return cp.triggerCtrl.CreateDeadLetterOperation(ctx, op)
+}
+
+func (cp *ControllerProxy) newDeadLetterScan(ctx context.Context, subscriptionID uint64,
+	filter *metapb.DeadLetterFilter) (*deadLetterScan, error) {
+	if subscriptionID == 0 {
+		return nil, errors.ErrInvalidRequest.WithMessage("the subscription id can't be empty")
+	}
+	if filter.GetStartTime() > 0 && filter.GetEndTime() > 0 && filter.GetStartTime() > filter.GetEndTime() {
+		return nil, errors.ErrInvalidRequest.WithMessage("the start time is after the end time")
+	}
+	sub, err := cp.triggerCtrl.GetSubscription(ctx, &ctrlpb.GetSubscriptionRequest{Id: subscriptionID})
+	if err != nil {
+		return nil, err
+	}
+	ops, err := cp.triggerCtrl.ListDeadLetterOperation(ctx, &ctrlpb.ListDeadLetterOperationRequest{
+		SubscriptionId: subscriptionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	scan := &deadLetterScan{
+		subscriptionID: vanus.NewIDFromUint64(subscriptionID).String(),
+		eventbus:       sub.GetConfig().GetDeadLetterEventbus(),
+		filter:         filter,
+		selected:       toPositionSet(filter.GetEvents()),
+		purged:         map[eventPosition]bool{},
+		replayed:       map[eventPosition]bool{},
+	}
+	if scan.eventbus == "" {
+		scan.eventbus = primitive.DeadLetterEventbusName
+	}
+	for _, op := range ops.GetOperations() {
+		set := scan.replayed
+		if op.GetType() == metapb.DeadLetterOperation_PURGE {
+			set = scan.purged
+		}
+		for _, p := range op.GetEvents() {
+			set[eventPosition{eventlogID: p.GetEventlogId(), offset: p.GetOffset()}] = true
+		}
+	}
+	return scan, nil
+}
+
+// scanDeadLetter reads the eventlogs of dead letter eventbus from the start time of filter, and
+// returns at most limit events which belong to the subscription and match the filter.
+func (cp *ControllerProxy) scanDeadLetter(ctx context.Context, scan *deadLetterScan,
+	limit int) ([]deadLetterEvent, error) {
+	bus := cp.client.Eventbus(ctx, scan.eventbus)
+	logs, err := bus.ListLog(ctx)
+	if err != nil {
+		return nil, errors.ErrInternal.WithMessage("list eventlog failed").Wrap(err)
+	}
+	result := make([]deadLetterEvent, 0)
+	for _, l := range logs {
+		if len(result) >= limit {
+			break
+		}
+		start, end, err := scanRange(ctx, l, scan.filter.GetStartTime())
+		if err != nil {
+			return nil, errors.ErrInternal.WithMessage("get the offset of eventlog failed").Wrap(err)
+		}
+		for off := start; off < end && len(result) < limit; {
+			events, first, _, err := bus.Reader(
+				option.WithDisablePolling(),
+				option.WithReadPolicy(policy.NewManuallyReadPolicy(l, off)),
+				option.WithBatchSize(deadLetterReadBatch),
+			).Read(ctx)
+			if err != nil {
+				if errors.Is(err, errors.ErrOffsetOnEnd) || errors.Is(err, errors.ErrOffsetOverflow) {
+					break
+				}
+				return nil, errors.ErrInternal.WithMessage("read dead letter event failed").Wrap(err)
+			}
+			if len(events) == 0 {
+				break
+			}
+			stop := false
+			for i, e := range events {
+				t := deadLetterTime(e)
+				if end := scan.filter.GetEndTime(); end > 0 && !t.IsZero() && t.UnixMilli() > end {
+					// the events are appended in time order, the rest are all out of range.
+					stop = true
+					break
+				}
+				pos := eventPosition{eventlogID: l.ID(), offset: uint64(first) + uint64(i)}
+				if !scan.match(pos, e, t) {
+					continue
+				}
+				result = append(result, deadLetterEvent{position: pos, event: e, time: t})
+				if len(result) >= limit {
+					break
+				}
+			}
+			if stop {
+				break
+			}
+			off = first + int64(len(events))
+		}
+	}
+	return result, nil
+}
+
+func (s *deadLetterScan) match(pos eventPosition, e *v2.Event, t time.Time) bool {
+	if s.purged[pos] || (s.skipReplayed && s.replayed[pos]) {
+		return false
+	}
+	if len(s.selected) > 0 && !s.selected[pos] {
+		return false
+	}
+	if id, _ := types.ToString(e.Extensions()[primitive.XVanusSubscriptionID]); id != s.subscriptionID {
+		return false
+	}
+	if reason := s.filter.GetReason(); reason != "" {
+		if v, _ := types.ToString(e.Extensions()[primitive.DeadLetterReason]); v != reason {
+			return false
+		}
+	}
+	if start := s.filter.GetStartTime(); start > 0 && t.UnixMilli() < start {
+		return false
+	}
+	return true
+}
+
+// scanRange returns the range [start, end) of offsets to read, start is the first event which
+// was appended after timestamp if timestamp isn't 0.
+func scanRange(ctx context.Context, l api.Eventlog, timestamp int64) (int64, int64, error) {
+	start, err := l.EarliestOffset(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	end, err := l.LatestOffset(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	if timestamp > 0 {
+		off, err := l.QueryOffsetByTime(ctx, timestamp)
+		if err != nil {
+			return 0, 0, err
+		}
+		if off > start {
+			start = off
+		}
+	}
+	return start, end, nil
+}
+
+// deadLetterTime is the time when the event was stored in dead letter eventbus.
+func deadLetterTime(e *v2.Event) time.Time {
+	v, ok := e.Extensions()[segpb.XVanusStime]
+	if !ok {
+		v, ok = e.Extensions()[primitive.LastDeliveryTime]
+	}
+	if !ok {
+		return time.Time{}
+	}
+	t, err := types.ToTime(v)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// replayEvent copies e without the extensions added by vanus and the dead letter, so it's
+// treated as a fresh event of the subscription by the retry eventbus.
+func replayEvent(e *v2.Event, subscriptionID string) *v2.Event {
+	event := e.Clone()
+	for k := range event.Extensions() {
+		switch {
+		case strings.HasPrefix(k, primitive.XVanus), k == primitive.DeadLetterReason,
+			k == primitive.LastDeliveryError, k == primitive.LastDeliveryTime:
+			// setting nil removes the extension.
+			event.SetExtension(k, nil)
+		}
+	}
+	event.SetExtension(primitive.XVanusSubscriptionID, subscriptionID)
+	return &event
+}
+
+func toPositionSet(positions []*metapb.EventPosition) map[eventPosition]bool {
+	set := make(map[eventPosition]bool, len(positions))
+	for _, p := range positions {
+		set[eventPosition{eventlogID: p.GetEventlogId(), offset: p.GetOffset()}] = true
+	}
+	return set
+}
+
+func deadLetterLimit(limit uint32) int {
+	switch {
+	case limit == 0:
+		return defaultDeadLetterLimit
+	case limit > maximumDeadLetterLimit:
+		return maximumDeadLetterLimit
+	}
+	return int(limit)
+}
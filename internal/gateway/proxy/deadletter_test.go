@@ -0,0 +1,229 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	stdCtx "context"
+	"testing"
+	"time"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/gateway/auth"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func newDeadLetterTestEvent(id, subID, reason string, stime time.Time) *v2.Event {
+	e := v2.NewEvent()
+	e.SetID(id)
+	e.SetSource("ut")
+	e.SetType("ut")
+	e.SetExtension(primitive.XVanusSubscriptionID, subID)
+	e.SetExtension(primitive.XVanusRetryAttempts, 3)
+	e.SetExtension(primitive.DeadLetterReason, reason)
+	e.SetExtension(primitive.LastDeliveryError, "sink error")
+	e.SetExtension(segpb.XVanusStime, stime)
+	return &e
+}
+
+func TestControllerProxy_DeadLetter(t *testing.T) {
+	Convey("test dead letter", t, func() {
+		cp := NewControllerProxy(Config{
+			Endpoints: []string{"127.0.0.1:20001",
+				"127.0.0.1:20002", "127.0.0.1:20003"},
+		})
+		ctx := stdCtx.Background()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockClient := client.NewMockClient(ctrl)
+		cp.client = mockClient
+		cp.appender = NewEventAppender(mockClient, false)
+		triggerCtrl := ctrlpb.NewMockTriggerControllerClient(ctrl)
+		cp.triggerCtrl = triggerCtrl
+
+		Convey("test invalid request", func() {
+			_, err := cp.ListDeadLetterEvent(ctx, &proxypb.ListDeadLetterEventRequest{})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+			_, err = cp.ListDeadLetterEvent(ctx, &proxypb.ListDeadLetterEventRequest{
+				SubscriptionId: 1,
+				Filter:         &metapb.DeadLetterFilter{StartTime: 2, EndTime: 1},
+			})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+		})
+
+		now := time.UnixMilli(time.Now().UnixMilli())
+		subID := "0000000000000001"
+		events := []*v2.Event{
+			newDeadLetterTestEvent("1", subID, "unprocessable", now.Add(-3*time.Minute)),
+			newDeadLetterTestEvent("2", "0000000000000002", "unprocessable", now.Add(-2*time.Minute)),
+			newDeadLetterTestEvent("3", subID, "nomoreretry", now.Add(-time.Minute)),
+			newDeadLetterTestEvent("4", subID, "unprocessable", now),
+		}
+		triggerCtrl.EXPECT().GetSubscription(gomock.Any(), gomock.Any()).AnyTimes().Return(&metapb.Subscription{
+			Id:       1,
+			EventBus: "ut",
+		}, nil)
+		triggerCtrl.EXPECT().ListDeadLetterOperation(gomock.Any(), gomock.Any()).AnyTimes().Return(
+			&ctrlpb.ListDeadLetterOperationResponse{Operations: []*metapb.DeadLetterOperation{
+				{Type: metapb.DeadLetterOperation_PURGE, Events: []*metapb.EventPosition{{EventlogId: 1, Offset: 13}}},
+				{Type: metapb.DeadLetterOperation_REPLAY, Events: []*metapb.EventPosition{{EventlogId: 1, Offset: 10}}},
+			}}, nil)
+		bus := api.NewMockEventbus(ctrl)
+		mockClient.EXPECT().Eventbus(gomock.Any(), primitive.DeadLetterEventbusName).AnyTimes().Return(bus)
+		l := api.NewMockEventlog(ctrl)
+		l.EXPECT().ID().AnyTimes().Return(uint64(1))
+		l.EXPECT().EarliestOffset(gomock.Any()).AnyTimes().Return(int64(10), nil)
+		l.EXPECT().LatestOffset(gomock.Any()).AnyTimes().Return(int64(14), nil)
+		bus.EXPECT().ListLog(gomock.Any()).AnyTimes().Return([]api.Eventlog{l}, nil)
+		busReader := api.NewMockBusReader(ctrl)
+		bus.EXPECT().Reader(gomock.Any()).AnyTimes().Return(busReader)
+		busReader.EXPECT().Read(gomock.Any()).AnyTimes().Return(events, int64(10), uint64(1), nil)
+
+		Convey("test list dead letter event", func() {
+			res, err := cp.ListDeadLetterEvent(ctx, &proxypb.ListDeadLetterEventRequest{SubscriptionId: 1})
+			So(err, ShouldBeNil)
+			// event 2 belongs to other subscription, event 4 was purged.
+			So(res.Events, ShouldHaveLength, 2)
+			So(res.Events[0].Event.Id, ShouldEqual, "1")
+			So(res.Events[0].Replayed, ShouldBeTrue)
+			So(res.Events[0].Reason, ShouldEqual, "unprocessable")
+			So(res.Events[0].LastDeliveryError, ShouldEqual, "sink error")
+			So(res.Events[0].DeadLetterTime, ShouldEqual, now.Add(-3*time.Minute).UnixMilli())
+			So(res.Events[1].Position.Offset, ShouldEqual, 12)
+			So(res.Events[1].Replayed, ShouldBeFalse)
+
+			res, err = cp.ListDeadLetterEvent(ctx, &proxypb.ListDeadLetterEventRequest{
+				SubscriptionId: 1,
+				Filter:         &metapb.DeadLetterFilter{Reason: "nomoreretry"},
+			})
+			So(err, ShouldBeNil)
+			So(res.Events, ShouldHaveLength, 1)
+			So(res.Events[0].Event.Id, ShouldEqual, "3")
+
+			res, err = cp.ListDeadLetterEvent(ctx, &proxypb.ListDeadLetterEventRequest{
+				SubscriptionId: 1,
+				Filter:         &metapb.DeadLetterFilter{EndTime: now.Add(-2 * time.Minute).UnixMilli()},
+			})
+			So(err, ShouldBeNil)
+			So(res.Events, ShouldHaveLength, 1)
+			So(res.Events[0].Event.Id, ShouldEqual, "1")
+
+			res, err = cp.ListDeadLetterEvent(ctx, &proxypb.ListDeadLetterEventRequest{SubscriptionId: 1, Limit: 1})
+			So(err, ShouldBeNil)
+			So(res.Events, ShouldHaveLength, 1)
+		})
+
+		Convey("test replay dead letter event", func() {
+			writer := api.NewMockBusWriter(ctrl)
+			retryBus := api.NewMockEventbus(ctrl)
+			retryBus.EXPECT().Writer().AnyTimes().Return(writer)
+			mockClient.EXPECT().Eventbus(gomock.Any(), primitive.RetryEventbusName).AnyTimes().Return(retryBus)
+			writer.EXPECT().AppendMany(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ stdCtx.Context, events []*v2.Event, _ ...api.WriteOption) ([]string, error) {
+					So(events, ShouldHaveLength, 1)
+					ext := events[0].Extensions()
+					So(ext[primitive.XVanusSubscriptionID], ShouldEqual, subID)
+					So(ext, ShouldNotContainKey, primitive.XVanusRetryAttempts)
+					So(ext, ShouldNotContainKey, primitive.DeadLetterReason)
+					So(ext, ShouldNotContainKey, segpb.XVanusStime)
+					return []string{"3"}, nil
+				})
+			triggerCtrl.EXPECT().CreateDeadLetterOperation(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ stdCtx.Context, op *metapb.DeadLetterOperation, _ ...interface{}) (*metapb.DeadLetterOperation, error) {
+					So(op.Type, ShouldEqual, metapb.DeadLetterOperation_REPLAY)
+					So(op.Operator, ShouldEqual, "admin")
+					So(op.Events, ShouldHaveLength, 1)
+					So(op.Events[0].Offset, ShouldEqual, 12)
+					return op, nil
+				})
+			_, err := cp.ReplayDeadLetterEvent(auth.WithIdentity(ctx, &auth.Identity{Subject: "admin"}),
+				&proxypb.DeadLetterOperationRequest{
+					SubscriptionId: 1,
+					Filter: &metapb.DeadLetterFilter{
+						Events: []*metapb.EventPosition{{EventlogId: 1, Offset: 12}},
+					},
+				})
+			So(err, ShouldBeNil)
+			// the original event isn't changed.
+			So(events[2].Extensions(), ShouldContainKey, primitive.DeadLetterReason)
+		})
+
+		Convey("test skip replayed dead letter event", func() {
+			writer := api.NewMockBusWriter(ctrl)
+			retryBus := api.NewMockEventbus(ctrl)
+			retryBus.EXPECT().Writer().AnyTimes().Return(writer)
+			mockClient.EXPECT().Eventbus(gomock.Any(), primitive.RetryEventbusName).AnyTimes().Return(retryBus)
+			writer.EXPECT().AppendMany(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(
+				func(_ stdCtx.Context, events []*v2.Event, _ ...api.WriteOption) ([]string, error) {
+					ids := make([]string, len(events))
+					for i := range events {
+						ids[i] = events[i].ID()
+					}
+					return ids, nil
+				})
+			triggerCtrl.EXPECT().CreateDeadLetterOperation(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(
+				func(_ stdCtx.Context, op *metapb.DeadLetterOperation, _ ...interface{}) (*metapb.DeadLetterOperation, error) {
+					return op, nil
+				})
+
+			// event 1 at offset 10 has been replayed.
+			op, err := cp.ReplayDeadLetterEvent(ctx, &proxypb.DeadLetterOperationRequest{SubscriptionId: 1})
+			So(err, ShouldBeNil)
+			So(op.Events, ShouldHaveLength, 1)
+			So(op.Events[0].Offset, ShouldEqual, 12)
+
+			selected := &metapb.DeadLetterFilter{Events: []*metapb.EventPosition{{EventlogId: 1, Offset: 10}}}
+			_, err = cp.ReplayDeadLetterEvent(ctx, &proxypb.DeadLetterOperationRequest{
+				SubscriptionId: 1,
+				Filter:         selected,
+			})
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+
+			op, err = cp.ReplayDeadLetterEvent(ctx, &proxypb.DeadLetterOperationRequest{
+				SubscriptionId:  1,
+				IncludeReplayed: true,
+			})
+			So(err, ShouldBeNil)
+			So(op.Events, ShouldHaveLength, 2)
+			So(op.Events[0].Offset, ShouldEqual, 10)
+			So(op.Events[1].Offset, ShouldEqual, 12)
+		})
+
+		Convey("test purge dead letter event", func() {
+			triggerCtrl.EXPECT().CreateDeadLetterOperation(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ stdCtx.Context, op *metapb.DeadLetterOperation, _ ...interface{}) (*metapb.DeadLetterOperation, error) {
+					So(op.Type, ShouldEqual, metapb.DeadLetterOperation_PURGE)
+					So(op.Events, ShouldHaveLength, 2)
+					return op, nil
+				})
+			_, err := cp.PurgeDeadLetterEvent(ctx, &proxypb.DeadLetterOperationRequest{SubscriptionId: 1})
+			So(err, ShouldBeNil)
+
+			_, err = cp.PurgeDeadLetterEvent(ctx, &proxypb.DeadLetterOperationRequest{
+				SubscriptionId: 1,
+				Filter:         &metapb.DeadLetterFilter{Reason: "unknown"},
+			})
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+		})
+	})
+}
@@ -16,17 +16,12 @@ package proxy
 
 import (
 	"context"
-	"errors"
 
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
-var (
-	errMethodNotImplemented = errors.New("the method hasn't implemented")
-)
-
 func (cp *ControllerProxy) CreateEventBus(ctx context.Context,
 	req *ctrlpb.CreateEventBusRequest) (*metapb.EventBus, error) {
 	return cp.eventbusCtrl.CreateEventBus(ctx, req)
@@ -47,9 +42,9 @@ func (cp *ControllerProxy) ListEventBus(ctx context.Context,
 	return cp.eventbusCtrl.ListEventBus(ctx, req)
 }
 
-func (cp *ControllerProxy) UpdateEventBus(_ context.Context,
-	_ *ctrlpb.UpdateEventBusRequest) (*metapb.EventBus, error) {
-	return nil, errMethodNotImplemented
+func (cp *ControllerProxy) UpdateEventBus(ctx context.Context,
+	req *ctrlpb.UpdateEventBusRequest) (*metapb.EventBus, error) {
+	return cp.eventbusCtrl.UpdateEventBus(ctx, req)
 }
 
 func (cp *ControllerProxy) ListSegment(ctx context.Context,
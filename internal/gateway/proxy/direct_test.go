@@ -29,12 +29,15 @@ func TestControllerProxy_ProxyMethod(t *testing.T) {
 		eventbusCtrl.EXPECT().DeleteEventBus(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
 		eventbusCtrl.EXPECT().GetEventBus(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
 		eventbusCtrl.EXPECT().ListEventBus(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+		updated := &metapb.EventBus{Name: "test"}
+		eventbusCtrl.EXPECT().UpdateEventBus(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(updated, nil)
 		_, _ = cp.CreateEventBus(stdCtx.Background(), &ctrlpb.CreateEventBusRequest{})
 		_, _ = cp.DeleteEventBus(stdCtx.Background(), &metapb.EventBus{})
 		_, _ = cp.GetEventBus(stdCtx.Background(), &metapb.EventBus{})
 		_, _ = cp.ListEventBus(stdCtx.Background(), &emptypb.Empty{})
-		_, err := cp.UpdateEventBus(stdCtx.Background(), &ctrlpb.UpdateEventBusRequest{})
-		So(err, ShouldEqual, errMethodNotImplemented)
+		eb, err := cp.UpdateEventBus(stdCtx.Background(), &ctrlpb.UpdateEventBusRequest{})
+		So(err, ShouldBeNil)
+		So(eb, ShouldEqual, updated)
 
 		eventlogCtrl := ctrlpb.NewMockEventLogControllerClient(ctrl)
 		cp.eventlogCtrl = eventlogCtrl
@@ -0,0 +1,62 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+
+	"github.com/linkall-labs/vanus/internal/convert"
+	"github.com/linkall-labs/vanus/internal/trigger/offset"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+)
+
+// GetSubscriptionLag compares the offsets committed in controller with the latest offsets of
+// eventlogs, so the lag is behind the trigger worker by at most one commit interval.
+func (cp *ControllerProxy) GetSubscriptionLag(ctx context.Context,
+	req *proxypb.GetSubscriptionLagRequest) (*proxypb.GetSubscriptionLagResponse, error) {
+	if req.GetSubscriptionId() == 0 {
+		return nil, errors.ErrInvalidRequest.WithMessage("the subscription id can't be empty")
+	}
+	sub, err := cp.triggerCtrl.GetSubscription(ctx, &ctrlpb.GetSubscriptionRequest{Id: req.GetSubscriptionId()})
+	if err != nil {
+		return nil, err
+	}
+	lags, err := offset.CalculateLag(ctx, cp.client.Eventbus(ctx, sub.GetEventBus()),
+		convert.FromPbOffsetInfos(sub.GetOffsets()))
+	if err != nil {
+		return nil, errors.ErrInternal.WithMessage("calculate lag failed").Wrap(err)
+	}
+	res := &proxypb.GetSubscriptionLagResponse{
+		SubscriptionId: req.GetSubscriptionId(),
+		Eventbus:       sub.GetEventBus(),
+		Lags:           make([]*proxypb.EventlogLag, 0, len(lags)),
+	}
+	for _, lag := range lags {
+		l := &proxypb.EventlogLag{
+			EventlogId:      lag.EventlogID.Uint64(),
+			CommittedOffset: lag.Committed,
+			LatestOffset:    lag.Latest,
+			Lag:             lag.Lag,
+		}
+		if !lag.OldestUnconsumedTime.IsZero() {
+			l.OldestUnconsumedTime = lag.OldestUnconsumedTime.UnixMilli()
+		}
+		res.Lags = append(res.Lags, l)
+		res.TotalLag += lag.Lag
+	}
+	return res, nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	stdCtx "context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestControllerProxy_GetSubscriptionLag(t *testing.T) {
+	Convey("test get subscription lag", t, func() {
+		cp := NewControllerProxy(Config{
+			Endpoints: []string{"127.0.0.1:20001",
+				"127.0.0.1:20002", "127.0.0.1:20003"},
+		})
+		ctx := stdCtx.Background()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockClient := client.NewMockClient(ctrl)
+		cp.client = mockClient
+		triggerCtrl := ctrlpb.NewMockTriggerControllerClient(ctrl)
+		cp.triggerCtrl = triggerCtrl
+
+		Convey("test invalid request", func() {
+			_, err := cp.GetSubscriptionLag(ctx, &proxypb.GetSubscriptionLagRequest{})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+		})
+
+		Convey("test get lag", func() {
+			triggerCtrl.EXPECT().GetSubscription(gomock.Any(), gomock.Any()).Return(&metapb.Subscription{
+				Id:       1,
+				EventBus: "ut",
+				Offsets: []*metapb.OffsetInfo{
+					{EventLogId: 1, Offset: 10},
+					{EventLogId: 2, Offset: 20},
+				},
+			}, nil)
+			bus := api.NewMockEventbus(ctrl)
+			mockClient.EXPECT().Eventbus(gomock.Any(), "ut").Return(bus)
+			logs := make([]api.Eventlog, 2)
+			for i := range logs {
+				l := api.NewMockEventlog(ctrl)
+				l.EXPECT().ID().AnyTimes().Return(uint64(i + 1))
+				l.EXPECT().LatestOffset(gomock.Any()).Return(int64(20), nil)
+				logs[i] = l
+			}
+			bus.EXPECT().ListLog(gomock.Any()).Return(logs, nil)
+			busReader := api.NewMockBusReader(ctrl)
+			bus.EXPECT().Reader(gomock.Any()).Return(busReader)
+			busReader.EXPECT().Read(gomock.Any()).Return(nil, int64(0), uint64(0), errors.ErrOffsetOverflow)
+
+			res, err := cp.GetSubscriptionLag(ctx, &proxypb.GetSubscriptionLagRequest{SubscriptionId: 1})
+			So(err, ShouldBeNil)
+			So(res.Eventbus, ShouldEqual, "ut")
+			So(res.TotalLag, ShouldEqual, 10)
+			So(res.Lags, ShouldHaveLength, 2)
+			So(res.Lags[0].Lag, ShouldEqual, 10)
+			So(res.Lags[1].Lag, ShouldEqual, 0)
+		})
+	})
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/linkall-labs/vanus/client/pkg/option"
 	"github.com/linkall-labs/vanus/client/pkg/policy"
 	"github.com/linkall-labs/vanus/internal/convert"
+	"github.com/linkall-labs/vanus/internal/gateway/auth"
 	"github.com/linkall-labs/vanus/internal/primitive/interceptor/errinterceptor"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/internal/trigger/filter"
@@ -38,6 +39,7 @@ import (
 	"github.com/linkall-labs/vanus/observability/tracing"
 	"github.com/linkall-labs/vanus/pkg/cluster"
 	"github.com/linkall-labs/vanus/pkg/errors"
+	"github.com/linkall-labs/vanus/pkg/util/tlsconfig"
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
@@ -45,7 +47,6 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -66,6 +67,8 @@ type Config struct {
 	CloudEventReceiverPort int
 	Credentials            credentials.TransportCredentials
 	GRPCReflectionEnable   bool
+	Auth                   auth.Config
+	IdempotentPublish      bool
 }
 
 type ControllerProxy struct {
@@ -75,20 +78,30 @@ type ControllerProxy struct {
 	eventbusCtrl ctrlpb.EventBusControllerClient
 	eventlogCtrl ctrlpb.EventLogControllerClient
 	triggerCtrl  ctrlpb.TriggerControllerClient
+	segmentCtrl  ctrlpb.SegmentControllerClient
 	grpcSrv      *grpc.Server
 	ctrl         cluster.Cluster
+	appender     *EventAppender
+	pullers      map[vanus.ID]*puller
+	pullerLock   sync.Mutex
+	schemas      sync.Map
+	stop         context.CancelFunc
 }
 
 func NewControllerProxy(cfg Config) *ControllerProxy {
-	ctrl := cluster.NewClusterController(cfg.Endpoints, insecure.NewCredentials())
+	ctrl := cluster.NewClusterController(cfg.Endpoints, tlsconfig.ClientCredentials())
+	client := eb.Connect(cfg.Endpoints)
 	return &ControllerProxy{
 		cfg:          cfg,
 		ctrl:         ctrl,
-		client:       eb.Connect(cfg.Endpoints),
+		client:       client,
+		appender:     NewEventAppender(client, cfg.IdempotentPublish),
 		tracer:       tracing.NewTracer("controller-proxy", trace.SpanKindServer),
 		eventbusCtrl: ctrl.EventbusService().RawClient(),
 		eventlogCtrl: ctrl.EventlogService().RawClient(),
 		triggerCtrl:  ctrl.TriggerService().RawClient(),
+		segmentCtrl:  ctrl.SegmentService().RawClient(),
+		pullers:      map[vanus.ID]*puller{},
 	}
 }
 
@@ -103,18 +116,33 @@ func (cp *ControllerProxy) Start() error {
 		},
 	)
 
-	cp.grpcSrv = grpc.NewServer(
-		grpc.ChainStreamInterceptor(
-			errinterceptor.StreamServerInterceptor(),
-			recovery.StreamServerInterceptor(recoveryOpt),
-			otelgrpc.StreamServerInterceptor(),
-		),
-		grpc.ChainUnaryInterceptor(
-			errinterceptor.UnaryServerInterceptor(),
-			recovery.UnaryServerInterceptor(recoveryOpt),
-			otelgrpc.UnaryServerInterceptor(),
-		),
+	streamInterceptors := []grpc.StreamServerInterceptor{errinterceptor.StreamServerInterceptor()}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{errinterceptor.UnaryServerInterceptor()}
+	if cp.cfg.Auth.Enable {
+		a, err := auth.New(cp.cfg.Auth)
+		if err != nil {
+			return err
+		}
+		streamInterceptors = append(streamInterceptors, auth.StreamServerInterceptor(a, authPolicy))
+		unaryInterceptors = append(unaryInterceptors, auth.UnaryServerInterceptor(a, authPolicy))
+	}
+	streamInterceptors = append(streamInterceptors,
+		recovery.StreamServerInterceptor(recoveryOpt),
+		otelgrpc.StreamServerInterceptor(),
 	)
+	unaryInterceptors = append(unaryInterceptors,
+		recovery.UnaryServerInterceptor(recoveryOpt),
+		otelgrpc.UnaryServerInterceptor(),
+	)
+
+	opts := []grpc.ServerOption{
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+	}
+	if cp.cfg.Credentials != nil {
+		opts = append(opts, grpc.Creds(cp.cfg.Credentials))
+	}
+	cp.grpcSrv = grpc.NewServer(opts...)
 
 	// for debug in developing stage
 	if cp.cfg.GRPCReflectionEnable {
@@ -137,6 +165,9 @@ func (cp *ControllerProxy) Start() error {
 		}
 		wg.Done()
 	}()
+	var ctx context.Context
+	ctx, cp.stop = context.WithCancel(context.Background())
+	go cp.gcPullers(ctx)
 	log.Info(context.Background(), "the grpc proxy ready to work", nil)
 	return nil
 }
@@ -145,6 +176,10 @@ func (cp *ControllerProxy) Stop() {
 	if cp.grpcSrv != nil {
 		cp.grpcSrv.GracefulStop()
 	}
+	if cp.stop != nil {
+		cp.stop()
+	}
+	cp.closePullers()
 }
 
 func (cp *ControllerProxy) ClusterInfo(_ context.Context, _ *emptypb.Empty) (*proxypb.ClusterInfoResponse, error) {
@@ -154,6 +189,16 @@ func (cp *ControllerProxy) ClusterInfo(_ context.Context, _ *emptypb.Empty) (*pr
 	}, nil
 }
 
+func (cp *ControllerProxy) DrainVolume(ctx context.Context,
+	req *ctrlpb.DrainVolumeRequest) (*ctrlpb.VolumeDrainStatus, error) {
+	return cp.segmentCtrl.DrainVolume(ctx, req)
+}
+
+func (cp *ControllerProxy) GetVolumeDrainStatus(ctx context.Context,
+	req *ctrlpb.GetVolumeDrainStatusRequest) (*ctrlpb.VolumeDrainStatus, error) {
+	return cp.segmentCtrl.GetVolumeDrainStatus(ctx, req)
+}
+
 func (cp *ControllerProxy) LookupOffset(ctx context.Context,
 	req *proxypb.LookupOffsetRequest) (*proxypb.LookupOffsetResponse, error) {
 	elList := make([]api.Eventlog, 0)
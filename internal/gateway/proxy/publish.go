@@ -0,0 +1,156 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/convert"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+)
+
+const (
+	// publishWindowSize is the maximum number of events which are received but not
+	// responded in a stream, the stream stops receiving when the window is full.
+	publishWindowSize            = 256
+	maximumNumberPerPublishBatch = 64
+)
+
+func (cp *ControllerProxy) Publish(stream proxypb.ControllerProxy_PublishServer) error {
+	ctx := stream.Context()
+	requests := make(chan *proxypb.PublishRequest, publishWindowSize)
+	recvErr := make(chan error, 1)
+	go func() {
+		defer close(requests)
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				if !stderrors.Is(err, io.EOF) {
+					recvErr <- err
+				}
+				return
+			}
+			select {
+			case requests <- req:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for req := range requests {
+		batch := cp.collectPublishBatch(req, requests)
+		for _, res := range cp.publishBatch(ctx, batch) {
+			if err := stream.Send(res); err != nil {
+				return err
+			}
+		}
+	}
+
+	select {
+	case err := <-recvErr:
+		return err
+	default:
+		return nil
+	}
+}
+
+// collectPublishBatch takes the requests which have been received to append them together.
+func (cp *ControllerProxy) collectPublishBatch(first *proxypb.PublishRequest,
+	requests <-chan *proxypb.PublishRequest) []*proxypb.PublishRequest {
+	batch := []*proxypb.PublishRequest{first}
+	for len(batch) < maximumNumberPerPublishBatch {
+		select {
+		case req, ok := <-requests:
+			if !ok {
+				return batch
+			}
+			batch = append(batch, req)
+		default:
+			return batch
+		}
+	}
+	return batch
+}
+
+func (cp *ControllerProxy) publishBatch(ctx context.Context,
+	requests []*proxypb.PublishRequest) []*proxypb.PublishResponse {
+	results := make([]*proxypb.PublishResponse, len(requests))
+	events := make([]*v2.Event, len(requests))
+	targets := make([]string, len(requests))
+	for idx, req := range requests {
+		results[idx] = &proxypb.PublishResponse{RequestId: req.RequestId}
+		target, event, err := parsePublishRequest(req)
+		if err != nil {
+			results[idx].Error = err.Error()
+			continue
+		}
+		if err = cp.ValidateSchema(ctx, req.GetEventbus(), event); err != nil {
+			results[idx].Error = err.Error()
+			continue
+		}
+		results[idx].Eventbus = target
+		events[idx] = event
+		targets[idx] = target
+	}
+
+	for idx, res := range cp.appender.AppendGrouped(ctx, events, targets) {
+		if events[idx] == nil {
+			continue
+		}
+		if res.Err != nil {
+			results[idx].Error = res.Err.Error()
+			continue
+		}
+		results[idx].EventId = res.EventID
+	}
+	return results
+}
+
+// parsePublishRequest validates the request like the CloudEvents receiver of gateway,
+// and returns the eventbus which the event will be appended to.
+func parsePublishRequest(req *proxypb.PublishRequest) (string, *v2.Event, error) {
+	if req.GetEventbus() == "" {
+		return "", nil, errInvalidEventbus
+	}
+	event, err := convert.FromPbCloudEvent(req.GetEvent())
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid event: %w", err)
+	}
+	extensions := event.Extensions()
+	if err = primitive.CheckExtension(extensions); err != nil {
+		return "", nil, err
+	}
+	target := req.GetEventbus()
+	event.SetExtension(primitive.XVanusEventbus, req.GetEventbus())
+	if eventTime, ok := extensions[primitive.XVanusDeliveryTime]; ok {
+		if _, err = types.ParseTime(fmt.Sprintf("%v", eventTime)); err != nil {
+			return "", nil, fmt.Errorf("invalid delivery time")
+		}
+		target = primitive.TimerEventbusName
+	}
+	return target, event, nil
+}
+
+func (cp *ControllerProxy) getBusWriter(ctx context.Context, ebName string) api.BusWriter {
+	return cp.appender.Writer(ctx, ebName)
+}
@@ -0,0 +1,143 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	stdCtx "context"
+	"testing"
+	"time"
+
+	cepb "cloudevents.io/genproto/v1"
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func newPbEvent(id string, extensions map[string]string) *cepb.CloudEvent {
+	e := &cepb.CloudEvent{
+		Id:          id,
+		Source:      "ut",
+		SpecVersion: "1.0",
+		Type:        "ut",
+		Attributes:  map[string]*cepb.CloudEventAttributeValue{},
+		Data:        &cepb.CloudEvent_BinaryData{BinaryData: []byte("data")},
+	}
+	for k, v := range extensions {
+		e.Attributes[k] = &cepb.CloudEventAttributeValue{
+			Attr: &cepb.CloudEventAttributeValue_CeString{CeString: v},
+		}
+	}
+	return e
+}
+
+func TestControllerProxy_Publish(t *testing.T) {
+	Convey("test publish by stream", t, func() {
+		cp := NewControllerProxy(Config{
+			Endpoints: []string{"127.0.0.1:20001",
+				"127.0.0.1:20002", "127.0.0.1:20003"},
+			CloudEventReceiverPort: 18080,
+			ProxyPort:              18083,
+			Credentials:            insecure.NewCredentials(),
+		})
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockClient := client.NewMockClient(ctrl)
+		cp.client = mockClient
+		cp.appender = NewEventAppender(mockClient, false)
+		utEB := api.NewMockEventbus(ctrl)
+		timerEB := api.NewMockEventbus(ctrl)
+		utWriter := api.NewMockBusWriter(ctrl)
+		timerWriter := api.NewMockBusWriter(ctrl)
+		mockClient.EXPECT().Eventbus(gomock.Any(), "ut").AnyTimes().Return(utEB)
+		mockClient.EXPECT().Eventbus(gomock.Any(), primitive.TimerEventbusName).AnyTimes().Return(timerEB)
+		utEB.EXPECT().Writer().AnyTimes().Return(utWriter)
+		timerEB.EXPECT().Writer().AnyTimes().Return(timerWriter)
+
+		var buses []interface{}
+		utWriter.EXPECT().AppendMany(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+			func(_ stdCtx.Context, events []*v2.Event, _ ...api.WriteOption) ([]string, error) {
+				eids := make([]string, len(events))
+				for i, e := range events {
+					buses = append(buses, e.Extensions()[primitive.XVanusEventbus])
+					eids[i] = "eid-" + e.ID()
+				}
+				return eids, nil
+			})
+		timerWriter.EXPECT().AppendMany(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+			func(_ stdCtx.Context, events []*v2.Event, _ ...api.WriteOption) ([]string, error) {
+				eids := make([]string, len(events))
+				for i, e := range events {
+					eids[i] = "timer-" + e.ID()
+				}
+				return eids, nil
+			})
+
+		So(cp.Start(), ShouldBeNil)
+		defer cp.Stop()
+
+		conn, err := grpc.Dial("127.0.0.1:18083", grpc.WithTransportCredentials(insecure.NewCredentials()))
+		So(err, ShouldBeNil)
+		defer func() {
+			_ = conn.Close()
+		}()
+		ctx, cancel := stdCtx.WithTimeout(stdCtx.Background(), 10*time.Second)
+		defer cancel()
+		stream, err := proxypb.NewControllerProxyClient(conn).Publish(ctx)
+		So(err, ShouldBeNil)
+
+		requests := []*proxypb.PublishRequest{
+			{RequestId: 1, Eventbus: "ut", Event: newPbEvent("1", nil)},
+			{RequestId: 2, Eventbus: "", Event: newPbEvent("2", nil)},
+			{RequestId: 3, Eventbus: "ut", Event: newPbEvent("3", map[string]string{"xvanusfoo": "bar"})},
+			{RequestId: 4, Eventbus: "ut", Event: newPbEvent("4",
+				map[string]string{primitive.XVanusDeliveryTime: "2022-12-12T08:31:54Z"})},
+			{RequestId: 5, Eventbus: "ut", Event: newPbEvent("5",
+				map[string]string{primitive.XVanusDeliveryTime: "invalid"})},
+			{RequestId: 6, Eventbus: "ut", Event: &cepb.CloudEvent{Id: "6"}},
+		}
+		for _, req := range requests {
+			So(stream.Send(req), ShouldBeNil)
+		}
+		So(stream.CloseSend(), ShouldBeNil)
+
+		results := make([]*proxypb.PublishResponse, 0)
+		for {
+			res, err := stream.Recv()
+			if err != nil {
+				break
+			}
+			results = append(results, res)
+		}
+		So(results, ShouldHaveLength, len(requests))
+		for i, res := range results {
+			So(res.RequestId, ShouldEqual, requests[i].RequestId)
+		}
+		So(results[0].EventId, ShouldEqual, "eid-1")
+		So(results[0].Error, ShouldBeEmpty)
+		So(results[1].Error, ShouldNotBeEmpty)
+		So(results[2].Error, ShouldNotBeEmpty)
+		So(results[3].EventId, ShouldEqual, "timer-4")
+		So(results[3].Eventbus, ShouldEqual, primitive.TimerEventbusName)
+		So(results[4].Error, ShouldNotBeEmpty)
+		So(results[5].Error, ShouldNotBeEmpty)
+		So(buses, ShouldResemble, []interface{}{"ut"})
+	})
+}
@@ -0,0 +1,411 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/convert"
+	pInfo "github.com/linkall-labs/vanus/internal/primitive/info"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/trigger/filter"
+	"github.com/linkall-labs/vanus/internal/trigger/info"
+	"github.com/linkall-labs/vanus/internal/trigger/offset"
+	"github.com/linkall-labs/vanus/internal/trigger/reader"
+	"github.com/linkall-labs/vanus/internal/trigger/transform"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	"github.com/linkall-labs/vanus/pkg/util"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	defaultPullVisibilityTimeout = 30 * time.Second
+	defaultNumberPerPullRequest  = 16
+	maximumNumberPerPullRequest  = 64
+	maximumPullWaitTimeout       = 30 * time.Second
+	pullBufferSize               = 256
+	pullCommitInterval           = time.Second
+	pullRefreshInterval          = 30 * time.Second
+	pullerIdleTimeout            = 5 * time.Minute
+	pullerGCInterval             = time.Minute
+)
+
+var (
+	// newReader is replaced in testing.
+	newReader = reader.NewReader
+)
+
+func (cp *ControllerProxy) Pull(ctx context.Context, req *proxypb.PullRequest) (*proxypb.PullResponse, error) {
+	p, err := cp.getPuller(ctx, vanus.ID(req.SubscriptionId))
+	if err != nil {
+		return nil, err
+	}
+	num := int(req.MaxEvents)
+	if num <= 0 {
+		num = defaultNumberPerPullRequest
+	} else if num > maximumNumberPerPullRequest {
+		num = maximumNumberPerPullRequest
+	}
+	wait := time.Duration(req.WaitTimeout) * time.Millisecond
+	if wait > maximumPullWaitTimeout {
+		wait = maximumPullWaitTimeout
+	}
+	events := p.pull(ctx, num, wait)
+	res := &proxypb.PullResponse{Events: make([]*proxypb.PulledEvent, 0, len(events))}
+	for _, e := range events {
+		pe, err := convert.ToPbCloudEvent(e.record.Event)
+		if err != nil {
+			// it can't be acked by consumer, skip it.
+			p.ack([]string{e.ackID})
+			log.Warning(ctx, "convert pulled event failed", map[string]interface{}{
+				log.KeySubscriptionID: req.SubscriptionId,
+				log.KeyError:          err,
+			})
+			continue
+		}
+		res.Events = append(res.Events, &proxypb.PulledEvent{
+			AckId:           e.ackID,
+			Event:           pe,
+			DeliveryAttempt: e.attempts,
+		})
+	}
+	return res, nil
+}
+
+func (cp *ControllerProxy) Ack(ctx context.Context, req *proxypb.AckRequest) (*emptypb.Empty, error) {
+	p, err := cp.getPuller(ctx, vanus.ID(req.SubscriptionId))
+	if err != nil {
+		return nil, err
+	}
+	p.ack(req.AckIds)
+	return &emptypb.Empty{}, nil
+}
+
+func (cp *ControllerProxy) getPuller(ctx context.Context, id vanus.ID) (*puller, error) {
+	cp.pullerLock.Lock()
+	defer cp.pullerLock.Unlock()
+	if p, exist := cp.pullers[id]; exist {
+		if !p.closed() {
+			return p, nil
+		}
+		p.close()
+		delete(cp.pullers, id)
+	}
+	sub, err := cp.triggerCtrl.GetSubscription(ctx, &ctrlpb.GetSubscriptionRequest{Id: id.Uint64()})
+	if err != nil {
+		return nil, err
+	}
+	if !sub.Pull {
+		return nil, errors.ErrInvalidRequest.WithMessage("subscription isn't pull subscription")
+	}
+	p := newPuller(cp, sub)
+	p.start()
+	cp.pullers[id] = p
+	return p, nil
+}
+
+// gcPullers closes the pullers which haven't been used for a while, the progress
+// is kept by committed offsets, so the puller can be recreated by next request.
+func (cp *ControllerProxy) gcPullers(ctx context.Context) {
+	util.UntilWithContext(ctx, func(ctx context.Context) {
+		cp.pullerLock.Lock()
+		defer cp.pullerLock.Unlock()
+		for id, p := range cp.pullers {
+			if p.closed() || p.idle() > pullerIdleTimeout {
+				p.close()
+				delete(cp.pullers, id)
+			}
+		}
+	}, pullerGCInterval)
+}
+
+func (cp *ControllerProxy) closePullers() {
+	cp.pullerLock.Lock()
+	defer cp.pullerLock.Unlock()
+	for id, p := range cp.pullers {
+		p.close()
+		delete(cp.pullers, id)
+	}
+}
+
+type pulledEvent struct {
+	record   info.EventRecord
+	ackID    string
+	deadline time.Time
+	attempts uint32
+}
+
+// puller reads events of a pull subscription, the events which are pulled by consumer
+// are kept in flight until they are acked or redelivered after visibility timeout.
+type puller struct {
+	cp             *ControllerProxy
+	subscriptionID vanus.ID
+	reader         reader.Reader
+	events         chan info.EventRecord
+	offsetManager  *offset.SubscriptionOffset
+	inflight       map[string]*pulledEvent
+	filter         filter.Filter
+	transformer    *transform.Transformer
+	visibility     time.Duration
+	updatedAt      int64
+	lastActive     time.Time
+	lock           sync.Mutex
+	ctx            context.Context
+	stop           context.CancelFunc
+	wg             sync.WaitGroup
+}
+
+func newPuller(cp *ControllerProxy, sub *metapb.Subscription) *puller {
+	p := &puller{
+		cp:             cp,
+		subscriptionID: vanus.ID(sub.Id),
+		events:         make(chan info.EventRecord, pullBufferSize),
+		offsetManager:  offset.NewSubscriptionOffset(vanus.ID(sub.Id)),
+		inflight:       make(map[string]*pulledEvent),
+		lastActive:     time.Now(),
+	}
+	p.ctx, p.stop = context.WithCancel(context.Background())
+	p.update(sub)
+	cfg := convert.FromPbSubscriptionRequest(&ctrlpb.SubscriptionRequest{Config: sub.Config}).Config
+	var offsetTimestamp int64
+	if cfg.OffsetTimestamp != nil {
+		offsetTimestamp = int64(*cfg.OffsetTimestamp)
+	}
+	offsets := make(reader.EventLogOffset)
+	for _, o := range convert.FromPbOffsetInfos(sub.Offsets) {
+		offsets[o.EventLogID] = o.Offset
+	}
+	p.reader = newReader(reader.Config{
+		EventBusName:    sub.EventBus,
+		Client:          cp.client,
+		SubscriptionID:  p.subscriptionID,
+		OffsetType:      cfg.OffsetType,
+		OffsetTimestamp: offsetTimestamp,
+		Offset:          offsets,
+	}, p.events)
+	return p
+}
+
+// update applies the filters, transformer and config of subscription.
+func (p *puller) update(sub *metapb.Subscription) {
+	s := convert.FromPbSubscriptionRequest(&ctrlpb.SubscriptionRequest{
+		Filters:     sub.Filters,
+		Transformer: sub.Transformer,
+		Config:      sub.Config,
+	})
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.filter = filter.GetFilter(s.Filters)
+	p.transformer = transform.NewTransformer(s.Transformer)
+	p.visibility = defaultPullVisibilityTimeout
+	if s.Config.VisibilityTimeout > 0 {
+		p.visibility = time.Duration(s.Config.VisibilityTimeout) * time.Millisecond
+	}
+	p.updatedAt = sub.UpdatedAt
+}
+
+func (p *puller) start() {
+	_ = p.reader.Start()
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		p.run()
+	}()
+}
+
+func (p *puller) run() {
+	commitTicker := time.NewTicker(pullCommitInterval)
+	defer commitTicker.Stop()
+	refreshTicker := time.NewTicker(pullRefreshInterval)
+	defer refreshTicker.Stop()
+	for {
+		select {
+		case <-p.ctx.Done():
+			p.commit(context.Background(), true)
+			return
+		case <-commitTicker.C:
+			p.commit(p.ctx, false)
+		case <-refreshTicker.C:
+			p.refresh()
+		}
+	}
+}
+
+func (p *puller) refresh() {
+	sub, err := p.cp.triggerCtrl.GetSubscription(p.ctx, &ctrlpb.GetSubscriptionRequest{Id: p.subscriptionID.Uint64()})
+	if err != nil {
+		if errors.Is(err, errors.ErrResourceNotFound) {
+			log.Info(p.ctx, "pull subscription has been deleted", map[string]interface{}{
+				log.KeySubscriptionID: p.subscriptionID,
+			})
+			p.stop()
+		}
+		return
+	}
+	if sub.UpdatedAt != p.updatedAt {
+		p.update(sub)
+	}
+}
+
+func (p *puller) commit(ctx context.Context, force bool) {
+	offsets := p.offsetManager.GetCommit()
+	if len(offsets) == 0 {
+		return
+	}
+	_, err := p.cp.triggerCtrl.CommitOffset(ctx, &ctrlpb.CommitOffsetRequest{
+		SubscriptionInfo: []*metapb.SubscriptionInfo{convert.ToPbSubscriptionInfo(pInfo.SubscriptionInfo{
+			SubscriptionID: p.subscriptionID,
+			Offsets:        offsets,
+		})},
+		ForceCommit: force,
+	})
+	if err != nil {
+		log.Warning(ctx, "commit offset of pull subscription failed", map[string]interface{}{
+			log.KeySubscriptionID: p.subscriptionID,
+			log.KeyError:          err,
+		})
+	}
+}
+
+func (p *puller) pull(ctx context.Context, num int, wait time.Duration) []*pulledEvent {
+	p.lock.Lock()
+	p.lastActive = time.Now()
+	p.lock.Unlock()
+	result := p.expired(num)
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	for len(result) < num {
+		var record info.EventRecord
+		if len(result) > 0 {
+			// there are events to return, don't wait.
+			select {
+			case record = <-p.events:
+			default:
+				return result
+			}
+		} else {
+			select {
+			case record = <-p.events:
+			case <-timer.C:
+				return result
+			case <-ctx.Done():
+				return result
+			case <-p.ctx.Done():
+				return result
+			}
+		}
+		if e := p.process(ctx, record); e != nil {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+// expired returns the events which are in flight but not acked in visibility timeout.
+func (p *puller) expired(num int) []*pulledEvent {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	now := time.Now()
+	result := make([]*pulledEvent, 0, num)
+	for _, e := range p.inflight {
+		if len(result) >= num {
+			break
+		}
+		if now.Before(e.deadline) {
+			continue
+		}
+		e.deadline = now.Add(p.visibility)
+		e.attempts++
+		result = append(result, e)
+	}
+	return result
+}
+
+func (p *puller) process(ctx context.Context, record info.EventRecord) *pulledEvent {
+	p.offsetManager.EventReceive(record.OffsetInfo)
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if filter.Run(p.filter, *record.Event) == filter.FailFilter {
+		p.offsetManager.EventCommit(record.OffsetInfo)
+		return nil
+	}
+	if p.transformer != nil {
+		// transform will change event which lost origin event
+		event := record.Event.Clone()
+		if err := p.transformer.Execute(&event); err != nil {
+			p.offsetManager.EventCommit(record.OffsetInfo)
+			log.Warning(ctx, "transform pulled event failed, skip it", map[string]interface{}{
+				log.KeySubscriptionID: p.subscriptionID,
+				log.KeyError:          err,
+			})
+			return nil
+		}
+		record.Event = &event
+	}
+	e := &pulledEvent{
+		record:   record,
+		ackID:    encodeAckID(record.OffsetInfo),
+		deadline: time.Now().Add(p.visibility),
+		attempts: 1,
+	}
+	p.inflight[e.ackID] = e
+	return e
+}
+
+func (p *puller) ack(ackIDs []string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.lastActive = time.Now()
+	for _, id := range ackIDs {
+		e, exist := p.inflight[id]
+		if !exist {
+			// it has been acked or the puller has been recreated
+			continue
+		}
+		delete(p.inflight, id)
+		p.offsetManager.EventCommit(e.record.OffsetInfo)
+	}
+}
+
+func (p *puller) idle() time.Duration {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return time.Since(p.lastActive)
+}
+
+func (p *puller) closed() bool {
+	return p.ctx.Err() != nil
+}
+
+func (p *puller) close() {
+	p.reader.Close()
+	p.stop()
+	p.wg.Wait()
+}
+
+func encodeAckID(o pInfo.OffsetInfo) string {
+	buf := make([]byte, 16)
+	binary.BigEndian.PutUint64(buf[0:8], o.EventLogID.Uint64())
+	binary.BigEndian.PutUint64(buf[8:16], o.Offset)
+	return base64.StdEncoding.EncodeToString(buf)
+}
@@ -0,0 +1,143 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	stdCtx "context"
+	"fmt"
+	"testing"
+	"time"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/golang/mock/gomock"
+	pInfo "github.com/linkall-labs/vanus/internal/primitive/info"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/trigger/info"
+	"github.com/linkall-labs/vanus/internal/trigger/reader"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func newEventRecord(offset uint64, source string) info.EventRecord {
+	e := v2.NewEvent()
+	e.SetID(fmt.Sprintf("%d", offset))
+	e.SetSource(source)
+	e.SetType("ut")
+	return info.EventRecord{
+		Event:      &e,
+		OffsetInfo: pInfo.OffsetInfo{EventLogID: vanus.ID(1), Offset: offset},
+	}
+}
+
+func TestControllerProxy_Pull(t *testing.T) {
+	Convey("test pull subscription", t, func() {
+		cp := NewControllerProxy(Config{
+			Endpoints: []string{"127.0.0.1:20001",
+				"127.0.0.1:20002", "127.0.0.1:20003"},
+			CloudEventReceiverPort: 18080,
+			ProxyPort:              18084,
+			Credentials:            insecure.NewCredentials(),
+		})
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockTriggerCtrl := ctrlpb.NewMockTriggerControllerClient(ctrl)
+		cp.triggerCtrl = mockTriggerCtrl
+		mockReader := reader.NewMockReader(ctrl)
+		mockReader.EXPECT().Start().AnyTimes().Return(nil)
+		mockReader.EXPECT().Close().AnyTimes()
+		var events chan<- info.EventRecord
+		newReader = func(_ reader.Config, ch chan<- info.EventRecord) reader.Reader {
+			events = ch
+			return mockReader
+		}
+		defer func() {
+			newReader = reader.NewReader
+		}()
+		defer cp.closePullers()
+		ctx := stdCtx.Background()
+		sub := &metapb.Subscription{
+			Id:       1,
+			EventBus: "ut",
+			Pull:     true,
+			Filters: []*metapb.Filter{
+				{Exact: map[string]string{"source": "ut"}},
+			},
+			Config: &metapb.SubscriptionConfig{VisibilityTimeout: 100},
+		}
+
+		Convey("test not pull subscription", func() {
+			mockTriggerCtrl.EXPECT().GetSubscription(gomock.Any(), gomock.Any()).Return(&metapb.Subscription{Id: 2}, nil)
+			_, err := cp.Pull(ctx, &proxypb.PullRequest{SubscriptionId: 2})
+			So(err, ShouldNotBeNil)
+		})
+
+		mockTriggerCtrl.EXPECT().GetSubscription(gomock.Any(), gomock.Any()).AnyTimes().Return(sub, nil)
+		mockTriggerCtrl.EXPECT().CommitOffset(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
+
+		Convey("test pull with wait timeout", func() {
+			res, err := cp.Pull(ctx, &proxypb.PullRequest{SubscriptionId: 1, WaitTimeout: 10})
+			So(err, ShouldBeNil)
+			So(res.Events, ShouldHaveLength, 0)
+		})
+
+		Convey("test pull, ack and redelivery", func() {
+			_, err := cp.Pull(ctx, &proxypb.PullRequest{SubscriptionId: 1, WaitTimeout: 1})
+			So(err, ShouldBeNil)
+			events <- newEventRecord(0, "ut")
+			events <- newEventRecord(1, "filtered")
+			events <- newEventRecord(2, "ut")
+			res, err := cp.Pull(ctx, &proxypb.PullRequest{SubscriptionId: 1, MaxEvents: 10, WaitTimeout: 100})
+			So(err, ShouldBeNil)
+			So(res.Events, ShouldHaveLength, 2)
+			So(res.Events[0].Event.Id, ShouldEqual, "0")
+			So(res.Events[0].DeliveryAttempt, ShouldEqual, 1)
+			So(res.Events[1].Event.Id, ShouldEqual, "2")
+
+			_, err = cp.Ack(ctx, &proxypb.AckRequest{SubscriptionId: 1, AckIds: []string{res.Events[0].AckId}})
+			So(err, ShouldBeNil)
+			p := cp.pullers[vanus.ID(1)]
+			So(p.offsetManager.GetCommit(), ShouldResemble, pInfo.ListOffsetInfo{
+				{EventLogID: vanus.ID(1), Offset: 2},
+			})
+
+			time.Sleep(150 * time.Millisecond)
+			res, err = cp.Pull(ctx, &proxypb.PullRequest{SubscriptionId: 1, MaxEvents: 10, WaitTimeout: 10})
+			So(err, ShouldBeNil)
+			So(res.Events, ShouldHaveLength, 1)
+			So(res.Events[0].Event.Id, ShouldEqual, "2")
+			So(res.Events[0].DeliveryAttempt, ShouldEqual, 2)
+
+			_, err = cp.Ack(ctx, &proxypb.AckRequest{SubscriptionId: 1, AckIds: []string{res.Events[0].AckId}})
+			So(err, ShouldBeNil)
+			So(p.offsetManager.GetCommit(), ShouldResemble, pInfo.ListOffsetInfo{
+				{EventLogID: vanus.ID(1), Offset: 3},
+			})
+		})
+	})
+}
+
+func TestEncodeAckID(t *testing.T) {
+	Convey("test encode ack id", t, func() {
+		id1 := encodeAckID(pInfo.OffsetInfo{EventLogID: vanus.ID(1), Offset: 1})
+		id2 := encodeAckID(pInfo.OffsetInfo{EventLogID: vanus.ID(1), Offset: 2})
+		id3 := encodeAckID(pInfo.OffsetInfo{EventLogID: vanus.ID(2), Offset: 1})
+		So(id1, ShouldNotEqual, id2)
+		So(id1, ShouldNotEqual, id3)
+		So(id1, ShouldEqual, encodeAckID(pInfo.OffsetInfo{EventLogID: vanus.ID(1), Offset: 1}))
+	})
+}
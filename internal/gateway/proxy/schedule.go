@@ -0,0 +1,115 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/convert"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ScheduleEvent registers the schedule in controller, then sends the event to timer. The timer
+// checks the schedule before delivering, so the event won't be delivered if it was cancelled.
+func (cp *ControllerProxy) ScheduleEvent(ctx context.Context,
+	req *proxypb.ScheduleEventRequest) (*metapb.ScheduledEvent, error) {
+	if req.GetEventbus() == "" {
+		return nil, errors.ErrInvalidRequest.WithMessage(errInvalidEventbus.Error())
+	}
+	event, err := convert.FromPbCloudEvent(req.GetEvent())
+	if err != nil {
+		return nil, errors.ErrInvalidRequest.WithMessage("invalid event").Wrap(err)
+	}
+	if err = primitive.CheckExtension(event.Extensions()); err != nil {
+		return nil, errors.ErrInvalidRequest.WithMessage(err.Error())
+	}
+	if err = cp.ValidateSchema(ctx, req.GetEventbus(), event); err != nil {
+		return nil, err
+	}
+
+	var deliveryTime time.Time
+	switch {
+	case req.GetDeliveryTime() > 0:
+		deliveryTime = time.UnixMilli(req.GetDeliveryTime())
+		if deliveryTime.Before(time.Now()) {
+			return nil, errors.ErrInvalidRequest.WithMessage("delivery time can't be in the past")
+		}
+	case req.GetDelay() > 0:
+		deliveryTime = time.Now().Add(time.Duration(req.GetDelay()) * time.Millisecond)
+	default:
+		return nil, errors.ErrInvalidRequest.WithMessage("one of delivery time and delay must be set")
+	}
+
+	schedule, err := cp.eventbusCtrl.CreateScheduledEvent(ctx, &metapb.ScheduledEvent{
+		Eventbus:     req.GetEventbus(),
+		EventId:      event.ID(),
+		DeliveryTime: deliveryTime.UnixMilli(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	event.SetExtension(primitive.XVanusEventbus, req.GetEventbus())
+	event.SetExtension(primitive.XVanusDeliveryTime, deliveryTime.UTC().Format(time.RFC3339Nano))
+	event.SetExtension(primitive.XVanusScheduleID, strconv.FormatUint(schedule.Id, 10))
+	if _, err = cp.getBusWriter(ctx, primitive.TimerEventbusName).AppendOne(ctx, event); err != nil {
+		// the event won't be delivered, remove the schedule.
+		if _, _err := cp.eventbusCtrl.DeleteScheduledEvent(ctx, &ctrlpb.DeleteScheduledEventRequest{
+			Eventbus: schedule.Eventbus,
+			Id:       schedule.Id,
+		}); _err != nil {
+			log.Warning(ctx, "delete scheduled event failed", map[string]interface{}{
+				log.KeyError:  _err,
+				"eventbus":    schedule.Eventbus,
+				"schedule_id": schedule.Id,
+			})
+		}
+		return nil, err
+	}
+	return schedule, nil
+}
+
+func (cp *ControllerProxy) CancelScheduledEvent(ctx context.Context,
+	req *proxypb.CancelScheduledEventRequest) (*emptypb.Empty, error) {
+	if req.GetEventbus() == "" {
+		return nil, errors.ErrInvalidRequest.WithMessage(errInvalidEventbus.Error())
+	}
+	return cp.eventbusCtrl.DeleteScheduledEvent(ctx, &ctrlpb.DeleteScheduledEventRequest{
+		Eventbus: req.GetEventbus(),
+		Id:       req.GetId(),
+	})
+}
+
+func (cp *ControllerProxy) ListScheduledEvents(ctx context.Context,
+	req *proxypb.ListScheduledEventsRequest) (*proxypb.ListScheduledEventsResponse, error) {
+	if req.GetEventbus() == "" {
+		return nil, errors.ErrInvalidRequest.WithMessage(errInvalidEventbus.Error())
+	}
+	res, err := cp.eventbusCtrl.ListScheduledEvent(ctx, &ctrlpb.ListScheduledEventRequest{
+		Eventbus: req.GetEventbus(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &proxypb.ListScheduledEventsResponse{Events: res.Events}, nil
+}
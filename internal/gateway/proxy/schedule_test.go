@@ -0,0 +1,145 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	stdCtx "context"
+	"testing"
+	"time"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+)
+
+func TestControllerProxy_ScheduleEvent(t *testing.T) {
+	Convey("test schedule event", t, func() {
+		cp := NewControllerProxy(Config{
+			Endpoints: []string{"127.0.0.1:20001",
+				"127.0.0.1:20002", "127.0.0.1:20003"},
+		})
+		ctx := stdCtx.Background()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockClient := client.NewMockClient(ctrl)
+		cp.client = mockClient
+		cp.appender = NewEventAppender(mockClient, false)
+		eventbusCtrl := ctrlpb.NewMockEventBusControllerClient(ctrl)
+		cp.eventbusCtrl = eventbusCtrl
+		timerEB := api.NewMockEventbus(ctrl)
+		timerWriter := api.NewMockBusWriter(ctrl)
+		mockClient.EXPECT().Eventbus(gomock.Any(), primitive.TimerEventbusName).AnyTimes().Return(timerEB)
+		timerEB.EXPECT().Writer().AnyTimes().Return(timerWriter)
+
+		Convey("test invalid request", func() {
+			_, err := cp.ScheduleEvent(ctx, &proxypb.ScheduleEventRequest{
+				Event: newPbEvent("1", nil),
+				Delay: 1000,
+			})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+			_, err = cp.ScheduleEvent(ctx, &proxypb.ScheduleEventRequest{
+				Eventbus: "ut",
+				Event:    newPbEvent("1", nil),
+			})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+			_, err = cp.ScheduleEvent(ctx, &proxypb.ScheduleEventRequest{
+				Eventbus: "ut",
+				Event:    newPbEvent("1", map[string]string{primitive.XVanusScheduleID: "1"}),
+				Delay:    1000,
+			})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+			_, err = cp.ScheduleEvent(ctx, &proxypb.ScheduleEventRequest{
+				Eventbus:     "ut",
+				Event:        newPbEvent("1", nil),
+				DeliveryTime: time.Now().Add(-time.Minute).UnixMilli(),
+			})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+		})
+
+		Convey("test schedule event with delay", func() {
+			now := time.Now()
+			eventbusCtrl.EXPECT().CreateScheduledEvent(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+				func(_ stdCtx.Context, in *metapb.ScheduledEvent, _ ...grpc.CallOption) (*metapb.ScheduledEvent, error) {
+					So(in.Eventbus, ShouldEqual, "ut")
+					So(in.EventId, ShouldEqual, "1")
+					So(in.DeliveryTime, ShouldBeGreaterThanOrEqualTo, now.Add(time.Minute).UnixMilli())
+					return &metapb.ScheduledEvent{Id: 100, Eventbus: in.Eventbus, EventId: in.EventId,
+						DeliveryTime: in.DeliveryTime}, nil
+				})
+			var appended *v2.Event
+			timerWriter.EXPECT().AppendOne(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+				func(_ stdCtx.Context, e *v2.Event, _ ...api.WriteOption) (string, error) {
+					appended = e
+					return "eid", nil
+				})
+			res, err := cp.ScheduleEvent(ctx, &proxypb.ScheduleEventRequest{
+				Eventbus: "ut",
+				Event:    newPbEvent("1", nil),
+				Delay:    uint64(time.Minute.Milliseconds()),
+			})
+			So(err, ShouldBeNil)
+			So(res.Id, ShouldEqual, 100)
+			extensions := appended.Extensions()
+			So(extensions[primitive.XVanusEventbus], ShouldEqual, "ut")
+			So(extensions[primitive.XVanusScheduleID], ShouldEqual, "100")
+			deliveryTime, err := types.ParseTime(extensions[primitive.XVanusDeliveryTime].(string))
+			So(err, ShouldBeNil)
+			So(deliveryTime.UnixMilli(), ShouldEqual, res.DeliveryTime)
+		})
+
+		Convey("test remove the schedule if append failed", func() {
+			eventbusCtrl.EXPECT().CreateScheduledEvent(gomock.Any(), gomock.Any()).Times(1).
+				Return(&metapb.ScheduledEvent{Id: 100, Eventbus: "ut"}, nil)
+			timerWriter.EXPECT().AppendOne(gomock.Any(), gomock.Any()).Times(1).Return("", errors.ErrNotWritable)
+			eventbusCtrl.EXPECT().DeleteScheduledEvent(gomock.Any(), &ctrlpb.DeleteScheduledEventRequest{
+				Eventbus: "ut",
+				Id:       100,
+			}).Times(1).Return(nil, nil)
+			_, err := cp.ScheduleEvent(ctx, &proxypb.ScheduleEventRequest{
+				Eventbus:     "ut",
+				Event:        newPbEvent("1", nil),
+				DeliveryTime: time.Now().Add(time.Minute).UnixMilli(),
+			})
+			So(errors.Is(err, errors.ErrNotWritable), ShouldBeTrue)
+		})
+
+		Convey("test cancel and list scheduled events", func() {
+			eventbusCtrl.EXPECT().DeleteScheduledEvent(gomock.Any(), &ctrlpb.DeleteScheduledEventRequest{
+				Eventbus: "ut",
+				Id:       100,
+			}).Times(1).Return(nil, nil)
+			_, err := cp.CancelScheduledEvent(ctx, &proxypb.CancelScheduledEventRequest{Eventbus: "ut", Id: 100})
+			So(err, ShouldBeNil)
+
+			eventbusCtrl.EXPECT().ListScheduledEvent(gomock.Any(), &ctrlpb.ListScheduledEventRequest{
+				Eventbus: "ut",
+			}).Times(1).Return(&ctrlpb.ListScheduledEventResponse{
+				Events: []*metapb.ScheduledEvent{{Id: 1}, {Id: 2}},
+			}, nil)
+			res, err := cp.ListScheduledEvents(ctx, &proxypb.ListScheduledEventsRequest{Eventbus: "ut"})
+			So(err, ShouldBeNil)
+			So(res.Events, ShouldHaveLength, 2)
+		})
+	})
+}
@@ -0,0 +1,160 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"strings"
+	"time"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/internal/primitive/schema"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	// schemaCacheTTL bounds how long a deleted schema is still used to validate events.
+	schemaCacheTTL = time.Minute
+)
+
+type cachedSchema struct {
+	schema   *schema.JSONSchema
+	expireAt time.Time
+}
+
+func (cp *ControllerProxy) RegisterSchema(ctx context.Context,
+	req *ctrlpb.RegisterSchemaRequest) (*metapb.Schema, error) {
+	if req.GetEventbus() == "" {
+		return nil, errors.ErrInvalidRequest.WithMessage(errInvalidEventbus.Error())
+	}
+	if req.GetType() == "" {
+		return nil, errors.ErrInvalidRequest.WithMessage("the type of schema can't be empty")
+	}
+	return cp.eventbusCtrl.RegisterSchema(ctx, req)
+}
+
+func (cp *ControllerProxy) GetSchema(ctx context.Context, req *ctrlpb.GetSchemaRequest) (*metapb.Schema, error) {
+	if req.GetEventbus() == "" {
+		return nil, errors.ErrInvalidRequest.WithMessage(errInvalidEventbus.Error())
+	}
+	if req.GetType() == "" {
+		return nil, errors.ErrInvalidRequest.WithMessage("the type of schema can't be empty")
+	}
+	return cp.eventbusCtrl.GetSchema(ctx, req)
+}
+
+func (cp *ControllerProxy) ListSchema(ctx context.Context,
+	req *ctrlpb.ListSchemaRequest) (*ctrlpb.ListSchemaResponse, error) {
+	if req.GetEventbus() == "" {
+		return nil, errors.ErrInvalidRequest.WithMessage(errInvalidEventbus.Error())
+	}
+	return cp.eventbusCtrl.ListSchema(ctx, req)
+}
+
+func (cp *ControllerProxy) DeleteSchema(ctx context.Context, req *ctrlpb.DeleteSchemaRequest) (*emptypb.Empty, error) {
+	if req.GetEventbus() == "" {
+		return nil, errors.ErrInvalidRequest.WithMessage(errInvalidEventbus.Error())
+	}
+	if req.GetType() == "" {
+		return nil, errors.ErrInvalidRequest.WithMessage("the type of schema can't be empty")
+	}
+	return cp.eventbusCtrl.DeleteSchema(ctx, req)
+}
+
+// ValidateSchema checks the data of event conforms to the schema referred by its dataschema.
+// The event is accepted if its dataschema doesn't refer to a registered schema.
+func (cp *ControllerProxy) ValidateSchema(ctx context.Context, eventbus string, event *v2.Event) error {
+	uri := event.DataSchema()
+	if !schema.IsURI(uri) {
+		return nil
+	}
+	ebName, eventType, version, ok := schema.ParseURI(uri)
+	if !ok {
+		return errors.ErrInvalidRequest.WithMessage(fmt.Sprintf("invalid dataschema %s", uri))
+	}
+	if ebName != eventbus {
+		return errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("the dataschema %s doesn't belong to eventbus %s", uri, eventbus))
+	}
+	if eventType != event.Type() {
+		return errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("the dataschema %s doesn't belong to type %s", uri, event.Type()))
+	}
+	if ct := event.DataContentType(); !isJSONContentType(ct) {
+		return errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("the datacontenttype %s can't be validated by JSON Schema", ct))
+	}
+
+	s, err := cp.getSchema(ctx, uri, eventbus, eventType, version)
+	if err != nil {
+		return err
+	}
+	if err = s.Validate(event.Data()); err != nil {
+		return errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("the data doesn't conform to %s: %s", uri, err))
+	}
+	return nil
+}
+
+// getSchema returns the compiled schema, the versions are immutable so they are cached.
+func (cp *ControllerProxy) getSchema(ctx context.Context, uri, eventbus, eventType string,
+	version uint32) (*schema.JSONSchema, error) {
+	if v, ok := cp.schemas.Load(uri); ok {
+		cached, _ := v.(*cachedSchema)
+		if time.Now().Before(cached.expireAt) {
+			return cached.schema, nil
+		}
+	}
+
+	res, err := cp.eventbusCtrl.GetSchema(ctx, &ctrlpb.GetSchemaRequest{
+		Eventbus: eventbus,
+		Type:     eventType,
+		Version:  version,
+	})
+	if err != nil {
+		if errors.Is(err, errors.ErrResourceNotFound) {
+			return nil, errors.ErrInvalidRequest.WithMessage(fmt.Sprintf("the dataschema %s doesn't exist", uri))
+		}
+		return nil, err
+	}
+	if res.Format != metapb.Schema_JSON_SCHEMA {
+		return nil, errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("the format %s of dataschema %s isn't supported yet", res.Format, uri))
+	}
+	s, err := schema.CompileJSONSchema([]byte(res.Definition))
+	if err != nil {
+		return nil, errors.ErrInternal.WithMessage("compile schema failed").Wrap(err)
+	}
+	cp.schemas.Store(uri, &cachedSchema{schema: s, expireAt: time.Now().Add(schemaCacheTTL)})
+	return s, nil
+}
+
+// isJSONContentType reports whether the data is JSON, the data without datacontenttype is
+// treated as JSON according to the CloudEvents spec.
+func isJSONContentType(ct string) bool {
+	if ct == "" {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(ct)
+	if err != nil {
+		return false
+	}
+	return mediaType == v2.ApplicationJSON || mediaType == "text/json" || strings.HasSuffix(mediaType, "+json")
+}
@@ -0,0 +1,97 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	stdCtx "context"
+	"testing"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/primitive/schema"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestControllerProxy_ValidateSchema(t *testing.T) {
+	Convey("test validate schema", t, func() {
+		cp := NewControllerProxy(Config{
+			Endpoints: []string{"127.0.0.1:20001",
+				"127.0.0.1:20002", "127.0.0.1:20003"},
+		})
+		ctx := stdCtx.Background()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		eventbusCtrl := ctrlpb.NewMockEventBusControllerClient(ctrl)
+		cp.eventbusCtrl = eventbusCtrl
+
+		uri := schema.URI("ut", "order.created", 1)
+		newEvent := func(data string) *v2.Event {
+			e := v2.NewEvent()
+			e.SetID("1")
+			e.SetSource("ut")
+			e.SetType("order.created")
+			e.SetDataSchema(uri)
+			_ = e.SetData(v2.ApplicationJSON, []byte(data))
+			return &e
+		}
+
+		Convey("test event without registered schema", func() {
+			e := newEvent(`{}`)
+			e.SetDataSchema("https://example.com/schema.json")
+			So(cp.ValidateSchema(ctx, "ut", e), ShouldBeNil)
+		})
+
+		Convey("test mismatched dataschema", func() {
+			err := cp.ValidateSchema(ctx, "other", newEvent(`{}`))
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+			e := newEvent(`{}`)
+			e.SetType("order.deleted")
+			err = cp.ValidateSchema(ctx, "ut", e)
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+			e = newEvent(`{}`)
+			_ = e.SetData("text/plain", []byte("text"))
+			err = cp.ValidateSchema(ctx, "ut", e)
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+		})
+
+		Convey("test validate data", func() {
+			eventbusCtrl.EXPECT().GetSchema(gomock.Any(), &ctrlpb.GetSchemaRequest{
+				Eventbus: "ut",
+				Type:     "order.created",
+				Version:  1,
+			}).Times(1).Return(&metapb.Schema{
+				Format:     metapb.Schema_JSON_SCHEMA,
+				Definition: `{"type":"object","properties":{"id":{"type":"string"}},"required":["id"]}`,
+			}, nil)
+			So(cp.ValidateSchema(ctx, "ut", newEvent(`{"id":"1"}`)), ShouldBeNil)
+			// the compiled schema is cached.
+			err := cp.ValidateSchema(ctx, "ut", newEvent(`{"id":1}`))
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+			So(err.Error(), ShouldContainSubstring, "$.id: expected string, but got integer")
+		})
+
+		Convey("test schema not found", func() {
+			eventbusCtrl.EXPECT().GetSchema(gomock.Any(), gomock.Any()).Times(1).
+				Return(nil, errors.ErrResourceNotFound)
+			err := cp.ValidateSchema(ctx, "ut", newEvent(`{"id":"1"}`))
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+		})
+	})
+}
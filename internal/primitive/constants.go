@@ -25,6 +25,11 @@ const (
 	XVanusDeliveryTime   = XVanus + "deliverytime"
 	XVanusRetryAttempts  = XVanus + "retryattempts"
 	XVanusSubscriptionID = XVanus + "subscriptionid"
+	XVanusScheduleID     = XVanus + "scheduleid"
+	// XVanusProducerID and XVanusProducerSeq identify the event for idempotent publishing instead
+	// of source and id.
+	XVanusProducerID  = XVanus + "producerid"
+	XVanusProducerSeq = XVanus + "producerseq"
 
 	LastDeliveryTime  = "lastdeliverytime"
 	LastDeliveryError = "lastdeliveryerror"
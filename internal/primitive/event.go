@@ -0,0 +1,39 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package primitive
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CheckExtension checks the extensions of event received from users, the prefix
+// of extension name is reserved by vanus except for the delivery time.
+func CheckExtension(extensions map[string]interface{}) error {
+	if len(extensions) == 0 {
+		return nil
+	}
+	for name := range extensions {
+		switch name {
+		case XVanusDeliveryTime, XVanusProducerID, XVanusProducerSeq:
+			continue
+		}
+		// event attribute can not prefix with vanus system use
+		if strings.HasPrefix(name, XVanus) {
+			return fmt.Errorf("invalid ce attribute [%s] perfix %s", name, XVanus)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,267 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"fmt"
+	"sort"
+)
+
+type Compatibility int
+
+const (
+	// Backward means the data written with the previous version is valid for the new version.
+	Backward Compatibility = iota
+	// Forward means the data written with the new version is valid for the previous version.
+	Forward
+	// Full means both backward and forward.
+	Full
+	// None skips the compatibility check.
+	None
+)
+
+func (c Compatibility) String() string {
+	switch c {
+	case Backward:
+		return "backward"
+	case Forward:
+		return "forward"
+	case Full:
+		return "full"
+	case None:
+		return "none"
+	}
+	return fmt.Sprintf("Compatibility(%d)", int(c))
+}
+
+// CheckCompatibility checks the new version of schema against the previous one. The check is
+// conservative, a change which can't be proved compatible is reported.
+func CheckCompatibility(prev, next *JSONSchema, c Compatibility) error {
+	switch c {
+	case Backward:
+		if err := subset(prev, next, "$"); err != nil {
+			return fmt.Errorf("not backward compatible: %w", err)
+		}
+	case Forward:
+		if err := subset(next, prev, "$"); err != nil {
+			return fmt.Errorf("not forward compatible: %w", err)
+		}
+	case Full:
+		if err := subset(prev, next, "$"); err != nil {
+			return fmt.Errorf("not backward compatible: %w", err)
+		}
+		if err := subset(next, prev, "$"); err != nil {
+			return fmt.Errorf("not forward compatible: %w", err)
+		}
+	case None:
+	default:
+		return fmt.Errorf("unknown compatibility %s", c)
+	}
+	return nil
+}
+
+var anySchema = &JSONSchema{}
+
+// subset checks every value valid for a is valid for b.
+func subset(a, b *JSONSchema, path string) error {
+	if a == nil {
+		a = anySchema
+	}
+	if b == nil || a.never {
+		return nil
+	}
+	if b.never {
+		return fmt.Errorf("%s: value is not allowed", path)
+	}
+
+	if err := subsetTypes(a, b, path); err != nil {
+		return err
+	}
+	if b.enum != nil {
+		if a.enum == nil {
+			return fmt.Errorf("%s: enum is added", path)
+		}
+		for _, v := range a.enum {
+			if !containsValue(b.enum, v) {
+				return fmt.Errorf("%s: enum value %v is removed", path, v)
+			}
+		}
+	}
+
+	if a.allows(typeNumber) || a.allows(typeInteger) {
+		if err := subsetNumber(a, b, path); err != nil {
+			return err
+		}
+	}
+	if a.allows(typeString) {
+		if err := subsetString(a, b, path); err != nil {
+			return err
+		}
+	}
+	if a.allows(typeArray) {
+		if err := subsetArray(a, b, path); err != nil {
+			return err
+		}
+	}
+	if a.allows(typeObject) {
+		if err := subsetObject(a, b, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *JSONSchema) allows(t string) bool {
+	if s.types == nil {
+		return true
+	}
+	return s.types[t] || (t == typeInteger && s.types[typeNumber])
+}
+
+func subsetTypes(a, b *JSONSchema, path string) error {
+	if b.types == nil {
+		return nil
+	}
+	if a.types == nil {
+		return fmt.Errorf("%s: type is restricted to %s", path, typeNames(b.types))
+	}
+	for t := range a.types {
+		if !b.allows(t) {
+			return fmt.Errorf("%s: type %s is removed", path, t)
+		}
+	}
+	return nil
+}
+
+func subsetNumber(a, b *JSONSchema, path string) error {
+	if b.minimum != nil && !lowerBoundCovered(a, *b.minimum, false) {
+		return fmt.Errorf("%s: minimum is raised", path)
+	}
+	if b.exclusiveMinimum != nil && !lowerBoundCovered(a, *b.exclusiveMinimum, true) {
+		return fmt.Errorf("%s: exclusiveMinimum is raised", path)
+	}
+	if b.maximum != nil && !upperBoundCovered(a, *b.maximum, false) {
+		return fmt.Errorf("%s: maximum is lowered", path)
+	}
+	if b.exclusiveMaximum != nil && !upperBoundCovered(a, *b.exclusiveMaximum, true) {
+		return fmt.Errorf("%s: exclusiveMaximum is lowered", path)
+	}
+	return nil
+}
+
+// lowerBoundCovered checks every number allowed by s is greater than (or equal to) bound.
+func lowerBoundCovered(s *JSONSchema, bound float64, exclusive bool) bool {
+	if s.minimum != nil && (*s.minimum > bound || (!exclusive && *s.minimum == bound)) {
+		return true
+	}
+	return s.exclusiveMinimum != nil && *s.exclusiveMinimum >= bound
+}
+
+// upperBoundCovered checks every number allowed by s is less than (or equal to) bound.
+func upperBoundCovered(s *JSONSchema, bound float64, exclusive bool) bool {
+	if s.maximum != nil && (*s.maximum < bound || (!exclusive && *s.maximum == bound)) {
+		return true
+	}
+	return s.exclusiveMaximum != nil && *s.exclusiveMaximum <= bound
+}
+
+func subsetString(a, b *JSONSchema, path string) error {
+	if !minCovered(a.minLength, b.minLength) {
+		return fmt.Errorf("%s: minLength is raised", path)
+	}
+	if !maxCovered(a.maxLength, b.maxLength) {
+		return fmt.Errorf("%s: maxLength is lowered", path)
+	}
+	if b.pattern != nil && (a.pattern == nil || a.pattern.String() != b.pattern.String()) {
+		return fmt.Errorf("%s: pattern is changed", path)
+	}
+	return nil
+}
+
+func subsetArray(a, b *JSONSchema, path string) error {
+	if !minCovered(a.minItems, b.minItems) {
+		return fmt.Errorf("%s: minItems is raised", path)
+	}
+	if !maxCovered(a.maxItems, b.maxItems) {
+		return fmt.Errorf("%s: maxItems is lowered", path)
+	}
+	return subset(a.items, b.items, path+"[*]")
+}
+
+func subsetObject(a, b *JSONSchema, path string) error {
+	for _, name := range b.required {
+		if !contains(a.required, name) {
+			return fmt.Errorf("%s: property %q becomes required", path, name)
+		}
+	}
+	for _, name := range sortedNames(b.properties) {
+		bp := b.properties[name]
+		ap, ok := a.properties[name]
+		if !ok {
+			if a.noAdditional {
+				continue
+			}
+			ap = a.additional
+		}
+		if err := subset(ap, bp, path+"."+name); err != nil {
+			return err
+		}
+	}
+	for _, name := range sortedNames(a.properties) {
+		ap := a.properties[name]
+		if _, ok := b.properties[name]; ok {
+			continue
+		}
+		if b.noAdditional {
+			return fmt.Errorf("%s: property %q is removed", path, name)
+		}
+		if err := subset(ap, b.additional, path+"."+name); err != nil {
+			return err
+		}
+	}
+	if !a.noAdditional {
+		if b.noAdditional {
+			return fmt.Errorf("%s: additional properties are disallowed", path)
+		}
+		return subset(a.additional, b.additional, path+".*")
+	}
+	return nil
+}
+
+func minCovered(a, b *int) bool {
+	return b == nil || *b == 0 || (a != nil && *a >= *b)
+}
+
+func maxCovered(a, b *int) bool {
+	return b == nil || (a != nil && *a <= *b)
+}
+
+func sortedNames(props map[string]*JSONSchema) []string {
+	names := make([]string, 0, len(props))
+	for name := range props {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,116 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func mustCompile(definition string) *JSONSchema {
+	s, err := CompileJSONSchema([]byte(definition))
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+func TestCheckCompatibility(t *testing.T) {
+	Convey("check compatibility", t, func() {
+		v1 := mustCompile(`{
+			"type": "object",
+			"properties": {"id": {"type": "string"}},
+			"required": ["id"],
+			"additionalProperties": false
+		}`)
+
+		Convey("add optional property", func() {
+			v2 := mustCompile(`{
+				"type": "object",
+				"properties": {"id": {"type": "string"}, "name": {"type": "string"}},
+				"required": ["id"],
+				"additionalProperties": false
+			}`)
+			So(CheckCompatibility(v1, v2, Backward), ShouldBeNil)
+			err := CheckCompatibility(v1, v2, Forward)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, `not forward compatible: $: property "name" is removed`)
+			So(CheckCompatibility(v1, v2, Full), ShouldNotBeNil)
+			So(CheckCompatibility(v1, v2, None), ShouldBeNil)
+		})
+
+		Convey("add required property", func() {
+			v2 := mustCompile(`{
+				"type": "object",
+				"properties": {"id": {"type": "string"}, "name": {"type": "string"}},
+				"required": ["id", "name"],
+				"additionalProperties": false
+			}`)
+			err := CheckCompatibility(v1, v2, Backward)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, `not backward compatible: $: property "name" becomes required`)
+			So(CheckCompatibility(v1, v2, Forward), ShouldNotBeNil)
+		})
+
+		Convey("add property to open content model", func() {
+			open := mustCompile(`{"type": "object", "properties": {"id": {"type": "string"}}}`)
+			v2 := mustCompile(`{
+				"type": "object",
+				"properties": {"id": {"type": "string"}, "name": {"type": "string"}}
+			}`)
+			// the previous version allows any value of name.
+			So(CheckCompatibility(open, v2, Backward), ShouldNotBeNil)
+			So(CheckCompatibility(open, v2, Forward), ShouldBeNil)
+		})
+
+		Convey("change constraints", func() {
+			So(CheckCompatibility(mustCompile(`{"type": "integer"}`), mustCompile(`{"type": "number"}`),
+				Full), ShouldNotBeNil)
+			So(CheckCompatibility(mustCompile(`{"type": "integer"}`), mustCompile(`{"type": "number"}`),
+				Backward), ShouldBeNil)
+			So(CheckCompatibility(mustCompile(`{"maxLength": 5}`), mustCompile(`{"maxLength": 3}`),
+				Backward), ShouldNotBeNil)
+			So(CheckCompatibility(mustCompile(`{"minimum": 1}`), mustCompile(`{"exclusiveMinimum": 0}`),
+				Backward), ShouldBeNil)
+			So(CheckCompatibility(mustCompile(`{"enum": ["a", "b"]}`), mustCompile(`{"enum": ["a"]}`),
+				Backward), ShouldNotBeNil)
+			So(CheckCompatibility(mustCompile(`{"enum": ["a"]}`), mustCompile(`{"enum": ["a", "b"]}`),
+				Backward), ShouldBeNil)
+			So(CheckCompatibility(mustCompile(`{"type": "array", "items": {"type": "string"}}`),
+				mustCompile(`{"type": "array"}`), Backward), ShouldBeNil)
+		})
+	})
+}
+
+func TestURI(t *testing.T) {
+	Convey("schema uri", t, func() {
+		uri := URI("orders", "com.example/order.created", 3)
+		So(uri, ShouldEqual, "vanus://schemas/orders/com.example%2Forder.created/3")
+		So(IsURI(uri), ShouldBeTrue)
+		eb, typ, v, ok := ParseURI(uri)
+		So(ok, ShouldBeTrue)
+		So(eb, ShouldEqual, "orders")
+		So(typ, ShouldEqual, "com.example/order.created")
+		So(v, ShouldEqual, 3)
+
+		_, _, _, ok = ParseURI("https://example.com/schema.json")
+		So(ok, ShouldBeFalse)
+		_, _, _, ok = ParseURI("vanus://schemas/orders/type/0")
+		So(ok, ShouldBeFalse)
+		_, _, _, ok = ParseURI("vanus://schemas/orders/type")
+		So(ok, ShouldBeFalse)
+	})
+}
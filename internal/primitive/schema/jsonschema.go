@@ -0,0 +1,374 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
+
+const (
+	typeNull    = "null"
+	typeBoolean = "boolean"
+	typeObject  = "object"
+	typeArray   = "array"
+	typeNumber  = "number"
+	typeInteger = "integer"
+	typeString  = "string"
+)
+
+var (
+	validTypes = map[string]bool{
+		typeNull: true, typeBoolean: true, typeObject: true, typeArray: true,
+		typeNumber: true, typeInteger: true, typeString: true,
+	}
+	// annotations don't affect validation.
+	annotationKeywords = map[string]bool{
+		"$schema": true, "$id": true, "$comment": true, "title": true, "description": true,
+		"default": true, "examples": true, "format": true, "deprecated": true,
+		"readOnly": true, "writeOnly": true,
+	}
+)
+
+// JSONSchema is a compiled JSON Schema. Only a subset of keywords is supported, a schema
+// using the others, like $ref or the combinators, fails to compile rather than is ignored.
+type JSONSchema struct {
+	// never is true for the boolean schema false.
+	never bool
+	// types is nil if any type is allowed.
+	types            map[string]bool
+	enum             []interface{}
+	minimum          *float64
+	maximum          *float64
+	exclusiveMinimum *float64
+	exclusiveMaximum *float64
+	minLength        *int
+	maxLength        *int
+	pattern          *regexp.Regexp
+	items            *JSONSchema
+	minItems         *int
+	maxItems         *int
+	properties       map[string]*JSONSchema
+	required         []string
+	// additional is nil if additionalProperties isn't set, the undeclared properties are
+	// rejected if noAdditional is true.
+	additional   *JSONSchema
+	noAdditional bool
+}
+
+// CompileJSONSchema parses the definition of JSON Schema.
+func CompileJSONSchema(definition []byte) (*JSONSchema, error) {
+	var v interface{}
+	if err := json.Unmarshal(definition, &v); err != nil {
+		return nil, fmt.Errorf("invalid json: %w", err)
+	}
+	return compile(v, "$")
+}
+
+func compile(v interface{}, path string) (*JSONSchema, error) {
+	switch val := v.(type) {
+	case bool:
+		return &JSONSchema{never: !val}, nil
+	case map[string]interface{}:
+		s := &JSONSchema{}
+		// compile keywords in order to report the same error for the same definition.
+		keys := make([]string, 0, len(val))
+		for k := range val {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if err := s.compileKeyword(k, val[k], path); err != nil {
+				return nil, err
+			}
+		}
+		return s, nil
+	default:
+		return nil, fmt.Errorf("%s: schema must be an object or a boolean", path)
+	}
+}
+
+func (s *JSONSchema) compileKeyword(k string, v interface{}, path string) error {
+	var err error
+	switch k {
+	case "type":
+		s.types, err = compileTypes(v, path)
+	case "enum":
+		values, ok := v.([]interface{})
+		if !ok || len(values) == 0 {
+			return fmt.Errorf("%s: enum must be a non-empty array", path)
+		}
+		s.enum = values
+	case "const":
+		s.enum = []interface{}{v}
+	case "minimum":
+		s.minimum, err = compileNumber(k, v, path)
+	case "maximum":
+		s.maximum, err = compileNumber(k, v, path)
+	case "exclusiveMinimum":
+		s.exclusiveMinimum, err = compileNumber(k, v, path)
+	case "exclusiveMaximum":
+		s.exclusiveMaximum, err = compileNumber(k, v, path)
+	case "minLength":
+		s.minLength, err = compileCount(k, v, path)
+	case "maxLength":
+		s.maxLength, err = compileCount(k, v, path)
+	case "minItems":
+		s.minItems, err = compileCount(k, v, path)
+	case "maxItems":
+		s.maxItems, err = compileCount(k, v, path)
+	case "pattern":
+		str, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("%s: pattern must be a string", path)
+		}
+		if s.pattern, err = regexp.Compile(str); err != nil {
+			return fmt.Errorf("%s: invalid pattern: %w", path, err)
+		}
+	case "items":
+		s.items, err = compile(v, path+"[*]")
+	case "properties":
+		props, ok := v.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s: properties must be an object", path)
+		}
+		s.properties = make(map[string]*JSONSchema, len(props))
+		for name, prop := range props {
+			if s.properties[name], err = compile(prop, path+"."+name); err != nil {
+				return err
+			}
+		}
+	case "required":
+		names, ok := v.([]interface{})
+		if !ok {
+			return fmt.Errorf("%s: required must be an array of strings", path)
+		}
+		for _, name := range names {
+			str, ok := name.(string)
+			if !ok {
+				return fmt.Errorf("%s: required must be an array of strings", path)
+			}
+			s.required = append(s.required, str)
+		}
+	case "additionalProperties":
+		if b, ok := v.(bool); ok {
+			s.noAdditional = !b
+			return nil
+		}
+		s.additional, err = compile(v, path+".*")
+	default:
+		if !annotationKeywords[k] {
+			return fmt.Errorf("%s: unsupported keyword %q", path, k)
+		}
+	}
+	return err
+}
+
+func compileTypes(v interface{}, path string) (map[string]bool, error) {
+	var names []interface{}
+	switch val := v.(type) {
+	case string:
+		names = []interface{}{val}
+	case []interface{}:
+		names = val
+	default:
+		return nil, fmt.Errorf("%s: type must be a string or an array of strings", path)
+	}
+	types := make(map[string]bool, len(names))
+	for _, name := range names {
+		str, _ := name.(string)
+		if !validTypes[str] {
+			return nil, fmt.Errorf("%s: invalid type %v", path, name)
+		}
+		types[str] = true
+	}
+	return types, nil
+}
+
+func compileNumber(k string, v interface{}, path string) (*float64, error) {
+	n, ok := v.(float64)
+	if !ok {
+		return nil, fmt.Errorf("%s: %s must be a number", path, k)
+	}
+	return &n, nil
+}
+
+func compileCount(k string, v interface{}, path string) (*int, error) {
+	n, ok := v.(float64)
+	if !ok || n < 0 || n != math.Trunc(n) {
+		return nil, fmt.Errorf("%s: %s must be a non-negative integer", path, k)
+	}
+	i := int(n)
+	return &i, nil
+}
+
+// Validate checks the JSON document data conforms to the schema.
+func (s *JSONSchema) Validate(data []byte) error {
+	var v interface{}
+	if len(data) != 0 {
+		if err := json.Unmarshal(data, &v); err != nil {
+			return fmt.Errorf("invalid json: %w", err)
+		}
+	}
+	return s.validate(v, "$")
+}
+
+func (s *JSONSchema) validate(v interface{}, path string) error {
+	if s.never {
+		return fmt.Errorf("%s: value is not allowed", path)
+	}
+	t := typeOf(v)
+	if s.types != nil && !s.types[t] && !(t == typeInteger && s.types[typeNumber]) {
+		return fmt.Errorf("%s: expected %s, but got %s", path, typeNames(s.types), t)
+	}
+	if s.enum != nil && !containsValue(s.enum, v) {
+		return fmt.Errorf("%s: value is not one of enum", path)
+	}
+
+	switch val := v.(type) {
+	case float64:
+		return s.validateNumber(val, path)
+	case string:
+		return s.validateString(val, path)
+	case []interface{}:
+		return s.validateArray(val, path)
+	case map[string]interface{}:
+		return s.validateObject(val, path)
+	}
+	return nil
+}
+
+func (s *JSONSchema) validateNumber(n float64, path string) error {
+	if s.minimum != nil && n < *s.minimum {
+		return fmt.Errorf("%s: must be >= %v", path, *s.minimum)
+	}
+	if s.maximum != nil && n > *s.maximum {
+		return fmt.Errorf("%s: must be <= %v", path, *s.maximum)
+	}
+	if s.exclusiveMinimum != nil && n <= *s.exclusiveMinimum {
+		return fmt.Errorf("%s: must be > %v", path, *s.exclusiveMinimum)
+	}
+	if s.exclusiveMaximum != nil && n >= *s.exclusiveMaximum {
+		return fmt.Errorf("%s: must be < %v", path, *s.exclusiveMaximum)
+	}
+	return nil
+}
+
+func (s *JSONSchema) validateString(str string, path string) error {
+	l := utf8.RuneCountInString(str)
+	if s.minLength != nil && l < *s.minLength {
+		return fmt.Errorf("%s: length must be >= %d", path, *s.minLength)
+	}
+	if s.maxLength != nil && l > *s.maxLength {
+		return fmt.Errorf("%s: length must be <= %d", path, *s.maxLength)
+	}
+	if s.pattern != nil && !s.pattern.MatchString(str) {
+		return fmt.Errorf("%s: doesn't match pattern %q", path, s.pattern.String())
+	}
+	return nil
+}
+
+func (s *JSONSchema) validateArray(arr []interface{}, path string) error {
+	if s.minItems != nil && len(arr) < *s.minItems {
+		return fmt.Errorf("%s: must have at least %d items", path, *s.minItems)
+	}
+	if s.maxItems != nil && len(arr) > *s.maxItems {
+		return fmt.Errorf("%s: must have at most %d items", path, *s.maxItems)
+	}
+	if s.items != nil {
+		for i, item := range arr {
+			if err := s.items.validate(item, path+"["+strconv.Itoa(i)+"]"); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *JSONSchema) validateObject(obj map[string]interface{}, path string) error {
+	for _, name := range s.required {
+		if _, ok := obj[name]; !ok {
+			return fmt.Errorf("%s: missing required property %q", path, name)
+		}
+	}
+	names := make([]string, 0, len(obj))
+	for name := range obj {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		prop, ok := s.properties[name]
+		switch {
+		case ok:
+		case s.noAdditional:
+			return fmt.Errorf("%s: property %q is not allowed", path, name)
+		case s.additional != nil:
+			prop = s.additional
+		default:
+			continue
+		}
+		if err := prop.validate(obj[name], path+"."+name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func typeOf(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return typeNull
+	case bool:
+		return typeBoolean
+	case float64:
+		if val == math.Trunc(val) {
+			return typeInteger
+		}
+		return typeNumber
+	case string:
+		return typeString
+	case []interface{}:
+		return typeArray
+	default:
+		return typeObject
+	}
+}
+
+func typeNames(types map[string]bool) string {
+	names := make([]string, 0, len(types))
+	for t := range types {
+		names = append(names, t)
+	}
+	sort.Strings(names)
+	if len(names) == 1 {
+		return names[0]
+	}
+	return fmt.Sprintf("one of %v", names)
+}
+
+func containsValue(values []interface{}, v interface{}) bool {
+	for _, value := range values {
+		if reflect.DeepEqual(value, v) {
+			return true
+		}
+	}
+	return false
+}
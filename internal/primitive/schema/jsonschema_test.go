@@ -0,0 +1,103 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCompileJSONSchema(t *testing.T) {
+	Convey("compile json schema", t, func() {
+		Convey("supported keywords", func() {
+			s, err := CompileJSONSchema([]byte(`{
+				"$schema": "https://json-schema.org/draft/2020-12/schema",
+				"title": "order",
+				"type": "object",
+				"properties": {
+					"id": {"type": "string", "minLength": 1, "pattern": "^o-"},
+					"amount": {"type": "number", "minimum": 0},
+					"tags": {"type": "array", "items": {"type": "string"}, "maxItems": 2}
+				},
+				"required": ["id"],
+				"additionalProperties": false
+			}`))
+			So(err, ShouldBeNil)
+			So(s.properties, ShouldHaveLength, 3)
+			So(s.noAdditional, ShouldBeTrue)
+		})
+
+		Convey("unsupported keyword", func() {
+			_, err := CompileJSONSchema([]byte(`{"properties": {"a": {"$ref": "#/defs/a"}}}`))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, `$.a: unsupported keyword "$ref"`)
+		})
+
+		Convey("invalid definition", func() {
+			_, err := CompileJSONSchema([]byte(`{"type": "bytes"}`))
+			So(err, ShouldNotBeNil)
+			_, err = CompileJSONSchema([]byte(`{"minLength": -1}`))
+			So(err, ShouldNotBeNil)
+			_, err = CompileJSONSchema([]byte(`{"pattern": "("}`))
+			So(err, ShouldNotBeNil)
+			_, err = CompileJSONSchema([]byte(`[]`))
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestJSONSchema_Validate(t *testing.T) {
+	Convey("validate json", t, func() {
+		s, err := CompileJSONSchema([]byte(`{
+			"type": "object",
+			"properties": {
+				"id": {"type": "string", "minLength": 1, "pattern": "^o-"},
+				"count": {"type": "integer", "exclusiveMinimum": 0, "maximum": 10},
+				"status": {"enum": ["new", "paid"]},
+				"tags": {"type": "array", "items": {"type": "string"}, "maxItems": 2}
+			},
+			"required": ["id"],
+			"additionalProperties": {"type": "boolean"}
+		}`))
+		So(err, ShouldBeNil)
+
+		So(s.Validate([]byte(`{"id": "o-1", "count": 3, "status": "new", "tags": ["a"], "x": true}`)), ShouldBeNil)
+
+		cases := map[string]string{
+			`{"count": 1}`:                 `$: missing required property "id"`,
+			`{"id": 1}`:                    "$.id: expected string, but got integer",
+			`{"id": "a-1"}`:                `$.id: doesn't match pattern "^o-"`,
+			`{"id": "o-1", "count": 1.5}`:  "$.count: expected integer, but got number",
+			`{"id": "o-1", "count": 0}`:    "$.count: must be > 0",
+			`{"id": "o-1", "count": 11}`:   "$.count: must be <= 10",
+			`{"id": "o-1", "status": "x"}`: "$.status: value is not one of enum",
+			`{"id": "o-1", "tags": [1]}`:   "$.tags[0]: expected string, but got integer",
+			`{"id": "o-1", "x": 1}`:        "$.x: expected boolean, but got integer",
+			`[]`:                           "$: expected object, but got array",
+			``:                             "$: expected object, but got null",
+		}
+		for data, msg := range cases {
+			err = s.Validate([]byte(data))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, msg)
+		}
+
+		err = s.Validate([]byte(`{"id": "o-1", "tags": ["a", "b", "c"]}`))
+		So(err, ShouldNotBeNil)
+		err = s.Validate([]byte(`{`))
+		So(err, ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,57 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// uriPrefix is the prefix of the dataschema which refers to a registered schema, the full
+// form is vanus://schemas/<eventbus>/<escaped type>/<version>.
+const uriPrefix = "vanus://schemas/"
+
+// URI returns the dataschema of events conforming to the version of schema.
+func URI(eventbus, eventType string, version uint32) string {
+	return fmt.Sprintf("%s%s/%s/%d", uriPrefix, eventbus, url.PathEscape(eventType), version)
+}
+
+// ParseURI parses the dataschema made by URI, ok is false if it doesn't refer to a
+// registered schema.
+func ParseURI(uri string) (eventbus, eventType string, version uint32, ok bool) {
+	if !strings.HasPrefix(uri, uriPrefix) {
+		return "", "", 0, false
+	}
+	parts := strings.Split(strings.TrimPrefix(uri, uriPrefix), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" {
+		return "", "", 0, false
+	}
+	eventType, err := url.PathUnescape(parts[1])
+	if err != nil {
+		return "", "", 0, false
+	}
+	v, err := strconv.ParseUint(parts[2], 10, 32)
+	if err != nil || v == 0 {
+		return "", "", 0, false
+	}
+	return parts[0], eventType, uint32(v), true
+}
+
+// IsURI reports whether the dataschema refers to a registered schema.
+func IsURI(uri string) bool {
+	return strings.HasPrefix(uri, uriPrefix)
+}
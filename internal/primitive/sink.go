@@ -0,0 +1,96 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package primitive
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const (
+	KafkaModeBinary     = "binary"
+	KafkaModeStructured = "structured"
+
+	kafkaQueryPartitionKey = "partition_key"
+	kafkaQueryMode         = "mode"
+
+	GRPCScheme    = "grpc"
+	GRPCTLSScheme = "grpcs"
+)
+
+// KafkaSink is parsed from sink like kafka://broker1:9092,broker2:9092/topic?partition_key=subject&mode=binary.
+type KafkaSink struct {
+	Brokers      []string
+	Topic        string
+	PartitionKey string
+	Mode         string
+}
+
+func ParseKafkaSink(sink string) (*KafkaSink, error) {
+	u, err := url.Parse(sink)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != string(KafkaProtocol) {
+		return nil, fmt.Errorf("the scheme of kafka sink must be kafka")
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("the brokers of kafka sink is empty")
+	}
+	topic := strings.Trim(u.Path, "/")
+	if topic == "" {
+		return nil, fmt.Errorf("the topic of kafka sink is empty")
+	}
+	s := &KafkaSink{
+		Brokers:      strings.Split(u.Host, ","),
+		Topic:        topic,
+		PartitionKey: u.Query().Get(kafkaQueryPartitionKey),
+		Mode:         u.Query().Get(kafkaQueryMode),
+	}
+	switch s.Mode {
+	case "":
+		s.Mode = KafkaModeBinary
+	case KafkaModeBinary, KafkaModeStructured:
+	default:
+		return nil, fmt.Errorf("the mode of kafka sink must be binary or structured")
+	}
+	return s, nil
+}
+
+// GRPCSink is parsed from sink like grpc://host:port or grpcs://host:port, the latter enables TLS.
+type GRPCSink struct {
+	Target string
+	TLS    bool
+}
+
+func ParseGRPCSink(sink string) (*GRPCSink, error) {
+	u, err := url.Parse(sink)
+	if err != nil {
+		return nil, err
+	}
+	s := &GRPCSink{Target: u.Host}
+	switch u.Scheme {
+	case GRPCScheme:
+	case GRPCTLSScheme:
+		s.TLS = true
+	default:
+		return nil, fmt.Errorf("the scheme of grpc sink must be grpc or grpcs")
+	}
+	if s.Target == "" {
+		return nil, fmt.Errorf("the target of grpc sink is empty")
+	}
+	return s, nil
+}
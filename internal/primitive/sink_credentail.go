@@ -20,6 +20,8 @@ const (
 	Plain  CredentialType = "plain"
 	AWS    CredentialType = "aws"
 	GCloud CredentialType = "gcloud"
+	Kafka  CredentialType = "kafka"
+	TLS    CredentialType = "tls"
 
 	SecretsMask = "******"
 )
@@ -60,6 +62,21 @@ func FillSinkCredential(dst, src SinkCredential) {
 		if _dst.CredentialJSON == SecretsMask {
 			_dst.CredentialJSON = _src.CredentialJSON
 		}
+	case Kafka:
+		_dst, _ := dst.(*KafkaSinkCredential)
+		_src, _ := src.(*KafkaSinkCredential)
+		if _dst.Password == SecretsMask {
+			_dst.Password = _src.Password
+		}
+		if _dst.ClientKey == SecretsMask {
+			_dst.ClientKey = _src.ClientKey
+		}
+	case TLS:
+		_dst, _ := dst.(*TLSSinkCredential)
+		_src, _ := src.(*TLSSinkCredential)
+		if _dst.ClientKey == SecretsMask {
+			_dst.ClientKey = _src.ClientKey
+		}
 	}
 }
 
@@ -108,3 +125,38 @@ func NewGCloudSinkCredential(credentialJSON string) SinkCredential {
 func (c *GCloudSinkCredential) GetType() CredentialType {
 	return GCloud
 }
+
+// The SASL mechanisms supported by kafka sink, they are the same as the mechanism names of sarama.
+const (
+	KafkaSASLPlain       = "PLAIN"
+	KafkaSASLSCRAMSHA256 = "SCRAM-SHA-256"
+	KafkaSASLSCRAMSHA512 = "SCRAM-SHA-512"
+)
+
+type KafkaSinkCredential struct {
+	SASLMechanism      string `json:"sasl_mechanism,omitempty"`
+	Username           string `json:"username,omitempty"`
+	Password           string `json:"password,omitempty"`
+	EnableTLS          bool   `json:"enable_tls,omitempty"`
+	CACert             string `json:"ca_cert,omitempty"`
+	ClientCert         string `json:"client_cert,omitempty"`
+	ClientKey          string `json:"client_key,omitempty"`
+	InsecureSkipVerify bool   `json:"insecure_skip_verify,omitempty"`
+}
+
+func (c *KafkaSinkCredential) GetType() CredentialType {
+	return Kafka
+}
+
+// TLSSinkCredential is used by the sinks which are connected with TLS, e.g. gRPC, the system root
+// CAs are trusted if CACert is empty.
+type TLSSinkCredential struct {
+	CACert             string `json:"ca_cert,omitempty"`
+	ClientCert         string `json:"client_cert,omitempty"`
+	ClientKey          string `json:"client_key,omitempty"`
+	InsecureSkipVerify bool   `json:"insecure_skip_verify,omitempty"`
+}
+
+func (c *TLSSinkCredential) GetType() CredentialType {
+	return TLS
+}
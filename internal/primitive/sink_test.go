@@ -0,0 +1,61 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package primitive
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParseKafkaSink(t *testing.T) {
+	Convey("test parse kafka sink", t, func() {
+		s, err := ParseKafkaSink("kafka://127.0.0.1:9092,127.0.0.2:9092/topic?partition_key=subject")
+		So(err, ShouldBeNil)
+		So(s.Brokers, ShouldResemble, []string{"127.0.0.1:9092", "127.0.0.2:9092"})
+		So(s.Topic, ShouldEqual, "topic")
+		So(s.PartitionKey, ShouldEqual, "subject")
+		So(s.Mode, ShouldEqual, KafkaModeBinary)
+
+		s, err = ParseKafkaSink("kafka://127.0.0.1:9092/topic?mode=structured")
+		So(err, ShouldBeNil)
+		So(s.Mode, ShouldEqual, KafkaModeStructured)
+
+		_, err = ParseKafkaSink("http://127.0.0.1:9092/topic")
+		So(err, ShouldNotBeNil)
+		_, err = ParseKafkaSink("kafka://127.0.0.1:9092")
+		So(err, ShouldNotBeNil)
+		_, err = ParseKafkaSink("kafka://127.0.0.1:9092/topic?mode=unknown")
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestParseGRPCSink(t *testing.T) {
+	Convey("test parse grpc sink", t, func() {
+		s, err := ParseGRPCSink("grpc://127.0.0.1:8080")
+		So(err, ShouldBeNil)
+		So(s.Target, ShouldEqual, "127.0.0.1:8080")
+		So(s.TLS, ShouldBeFalse)
+
+		s, err = ParseGRPCSink("grpcs://127.0.0.1:8080")
+		So(err, ShouldBeNil)
+		So(s.TLS, ShouldBeTrue)
+
+		_, err = ParseGRPCSink("http://127.0.0.1:8080")
+		So(err, ShouldNotBeNil)
+		_, err = ParseGRPCSink("grpc://")
+		So(err, ShouldNotBeNil)
+	})
+}
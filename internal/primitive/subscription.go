@@ -35,6 +35,9 @@ type Subscription struct {
 	Protocol        Protocol               `json:"protocol,omitempty"`
 	ProtocolSetting *ProtocolSetting       `json:"protocolSetting,omitempty"`
 	SinkCredential  SinkCredential         `json:"sink_credential,omitempty"`
+	Aggregation     *Aggregation           `json:"aggregation,omitempty"`
+	// Checkpoint is the window state of aggregation subscription with the offsets it covers.
+	Checkpoint []byte `json:"checkpoint,omitempty"`
 }
 
 func (sub *Subscription) String() string {
@@ -49,6 +52,8 @@ const (
 	HTTPProtocol      Protocol = "http"
 	AwsLambdaProtocol Protocol = "aws-lambda"
 	GCloudFunctions   Protocol = "gcloud-functions"
+	KafkaProtocol     Protocol = "kafka"
+	GRPCProtocol      Protocol = "grpc"
 )
 
 type ProtocolSetting struct {
@@ -72,7 +77,47 @@ type SubscriptionConfig struct {
 	MaxRetryAttempts   *uint32    `json:"max_retry_attempts,omitempty"`
 	DeadLetterEventbus string     `json:"dead_letter_eventbus,omitempty"`
 	// send event with ordered
-	OrderedEvent bool `json:"ordered_event"`
+	OrderedEvent bool         `json:"ordered_event"`
+	RetryPolicy  *RetryPolicy `json:"retry_policy,omitempty"`
+	// deliver events to sink in batch
+	MaxBatchSize uint32 `json:"max_batch_size,omitempty"`
+	BatchTimeout uint32 `json:"batch_timeout,omitempty"`
+	// redeliver the event pulled but not acked after visibility timeout
+	VisibilityTimeout uint32 `json:"visibility_timeout,omitempty"`
+	// stop delivering to the sink which keeps failing
+	CircuitBreaker *CircuitBreaker `json:"circuit_breaker,omitempty"`
+	// the upper bound of the adaptive in-flight window of unordered delivery
+	MaxConcurrency uint32 `json:"max_concurrency,omitempty"`
+	// deliver the events with the same value of the attribute in order
+	OrderKey string `json:"order_key,omitempty"`
+}
+
+// CircuitBreaker decides when to stop delivering to the sink, durations are in milliseconds and
+// the zero values mean the defaults.
+type CircuitBreaker struct {
+	FailureRatio   float64 `json:"failure_ratio,omitempty"`
+	MinRequests    uint32  `json:"min_requests,omitempty"`
+	Window         uint32  `json:"window,omitempty"`
+	OpenDuration   uint32  `json:"open_duration,omitempty"`
+	HalfOpenTrials uint32  `json:"half_open_trials,omitempty"`
+}
+
+type RetryStrategy string
+
+const (
+	RetryStrategyDefault     RetryStrategy = ""
+	RetryStrategyFixed       RetryStrategy = "fixed"
+	RetryStrategyLinear      RetryStrategy = "linear"
+	RetryStrategyExponential RetryStrategy = "exponential"
+)
+
+// RetryPolicy decides the delay before redelivering a failed event, delays are in milliseconds.
+type RetryPolicy struct {
+	Strategy     RetryStrategy `json:"strategy,omitempty"`
+	InitialDelay uint32        `json:"initial_delay,omitempty"`
+	MaxDelay     uint32        `json:"max_delay,omitempty"`
+	Multiplier   float64       `json:"multiplier,omitempty"`
+	Jitter       float64       `json:"jitter,omitempty"`
 }
 
 // GetMaxRetryAttempts return MaxRetryAttempts if nil return -1.
@@ -140,6 +185,44 @@ type Action struct {
 	Command []interface{} `json:"command"`
 }
 
+type WindowType string
+
+const (
+	TumblingWindow WindowType = "tumbling"
+	SlidingWindow  WindowType = "sliding"
+)
+
+type AggregateFunction string
+
+const (
+	AggregateCount AggregateFunction = "count"
+	AggregateSum   AggregateFunction = "sum"
+	AggregateMin   AggregateFunction = "min"
+	AggregateMax   AggregateFunction = "max"
+	AggregateAvg   AggregateFunction = "avg"
+)
+
+// Aggregation accumulates the events into windows by key, durations are in milliseconds.
+type Aggregation struct {
+	WindowType      WindowType          `json:"window_type,omitempty"`
+	WindowSize      uint32              `json:"window_size,omitempty"`
+	Slide           uint32              `json:"slide,omitempty"`
+	Key             string              `json:"key,omitempty"`
+	Field           string              `json:"field,omitempty"`
+	Functions       []AggregateFunction `json:"functions,omitempty"`
+	AllowedLateness uint32              `json:"allowed_lateness,omitempty"`
+	Type            string              `json:"type,omitempty"`
+	TargetEventbus  string              `json:"target_eventbus,omitempty"`
+}
+
+func (a *Aggregation) String() string {
+	if a == nil {
+		return ""
+	}
+	b, _ := json.Marshal(a)
+	return string(b)
+}
+
 /* annotation no use code .
 type SinkSpec struct {
 	Type   string
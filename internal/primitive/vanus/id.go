@@ -25,10 +25,10 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/pkg/cluster"
+	"github.com/linkall-labs/vanus/pkg/util/tlsconfig"
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	"github.com/sony/sonyflake"
 	"go.uber.org/atomic"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -141,7 +141,7 @@ func InitSnowflake(ctx context.Context, ctrlAddr []string, n *node) error {
 
 	var err error
 	once.Do(func() {
-		ctrl := cluster.NewClusterController(ctrlAddr, insecure.NewCredentials())
+		ctrl := cluster.NewClusterController(ctrlAddr, tlsconfig.ClientCredentials())
 		snow := &snowflake{
 			client:   ctrl.IDService().RawClient(),
 			ctrlAddr: ctrlAddr,
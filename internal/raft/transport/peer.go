@@ -22,8 +22,8 @@ import (
 	"time"
 
 	// third-party libraries.
+	"github.com/linkall-labs/vanus/pkg/util/tlsconfig"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
 	// first-party libraries.
 	vsraftpb "github.com/linkall-labs/vanus/proto/pkg/raft"
@@ -68,7 +68,7 @@ func newPeer(endpoint string, callback string) *peer {
 func (p *peer) run(callback string) {
 	opts := []grpc.DialOption{
 		grpc.WithBlock(),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(tlsconfig.ClientCredentials()),
 	}
 
 	preface := raftpb.Message{
@@ -0,0 +1,37 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package block
+
+import (
+	// standard libraries.
+	"context"
+	"errors"
+)
+
+// ErrIdempotenceDisabled is returned by idempotent append if the block has no idempotence window.
+var ErrIdempotenceDisabled = errors.New("idempotent append is disabled")
+
+type idempotentAppendKey struct{}
+
+// WithIdempotentAppend marks the append in ctx as idempotent, the entries which have been
+// appended recently are skipped and their original sequence numbers are returned.
+func WithIdempotentAppend(ctx context.Context) context.Context {
+	return context.WithValue(ctx, idempotentAppendKey{}, true)
+}
+
+func IsIdempotentAppend(ctx context.Context) bool {
+	v, _ := ctx.Value(idempotentAppendKey{}).(bool)
+	return v
+}
@@ -0,0 +1,47 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package block
+
+import (
+	// standard libraries.
+	"context"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+)
+
+// Offloader is implemented by the raw blocks which can move their data to object storage after
+// they are archived, the replicas of a segment share one object.
+type Offloader interface {
+	// SetSegment sets the segment which the block is a replica of.
+	SetSegment(ctx context.Context, id vanus.ID) error
+	// Offload moves the data to object storage, the object is uploaded only if upload is true,
+	// otherwise it waits for the object uploaded by another replica. It returns true once the
+	// block is offloaded.
+	Offload(ctx context.Context, upload bool) (bool, error)
+}
+
+type objectKeptKey struct{}
+
+// WithObjectKept keeps the offloaded object when deleting a block in ctx, because the object is
+// still read by the other replicas of segment.
+func WithObjectKept(ctx context.Context) context.Context {
+	return context.WithValue(ctx, objectKeptKey{}, true)
+}
+
+func IsObjectKept(ctx context.Context) bool {
+	v, _ := ctx.Value(objectKeptKey{}).(bool)
+	return v
+}
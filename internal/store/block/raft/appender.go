@@ -79,6 +79,11 @@ type Appender interface {
 	Bootstrap(ctx context.Context, blocks []Peer) error
 	Delete(ctx context.Context)
 	Status() ClusterStatus
+
+	AddLearner(ctx context.Context, learner Peer) error
+	PromoteLearner(ctx context.Context, id vanus.ID) error
+	RemovePeer(ctx context.Context, id vanus.ID) error
+	TransferLeader(ctx context.Context, to vanus.ID) error
 }
 
 type appender struct {
@@ -401,8 +406,11 @@ func (a *appender) applyConfChange(ctx context.Context, pbEntry *raftpb.Entry) *
 		if err := cc.Unmarshal(pbEntry.Data); err != nil {
 			panic(err)
 		}
-		// TODO(james.yin): non-add
-		a.hintPeer(ctx, cc.NodeID, string(cc.Context))
+		if cc.Type == raftpb.ConfChangeRemoveNode {
+			a.removeHint(ctx, cc.NodeID)
+		} else {
+			a.hintPeer(ctx, cc.NodeID, string(cc.Context))
+		}
 		cci = cc
 	} else {
 		var cc raftpb.ConfChangeV2
@@ -504,6 +512,11 @@ func (a *appender) append(ctx context.Context, entries []block.Entry) ([]int64,
 	}
 	off := a.actx.WriteOffset()
 
+	// All entries are duplicated, wait until the previous appends are committed.
+	if frag == nil {
+		return seqs, off, nil
+	}
+
 	data, _ := block.MarshalFragment(ctx, frag)
 	if err = a.node.Propose(ctx, data); err != nil {
 		return nil, 0, err
@@ -0,0 +1,185 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raft
+
+import (
+	// standard libraries.
+	"context"
+	"time"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/raft"
+	"github.com/linkall-labs/vanus/raft/raftpb"
+	"github.com/linkall-labs/vanus/raft/tracker"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/pkg/errors"
+)
+
+// defaultMaxLearnerLag is the max number of raft entries which a learner can fall behind the
+// commit index when it is promoted.
+const defaultMaxLearnerLag = 64
+
+// AddLearner adds a non-voting member to the replica group, the learner catches up with the
+// leader by receiving snapshot and entries. It's a no-op if the peer is already a member.
+//
+// Like PromoteLearner and RemovePeer, it returns after the change is applied, because raft drops
+// the proposal silently if there is a pending conf change, the caller should retry if ctx is done.
+func (a *appender) AddLearner(ctx context.Context, learner Peer) error {
+	ctx, span := a.tracer.Start(ctx, "AddLearner")
+	defer span.End()
+
+	if !a.isLeader() {
+		return errors.ErrNotLeader
+	}
+	if _, ok := a.node.Status().Progress[learner.ID.Uint64()]; ok {
+		return nil
+	}
+
+	a.hintPeer(ctx, learner.ID.Uint64(), learner.Endpoint)
+	log.Info(ctx, "Add learner to replica group.", map[string]interface{}{
+		"node_id":    a.ID(),
+		"learner_id": learner.ID,
+		"endpoint":   learner.Endpoint,
+	})
+	cc := raftpb.ConfChange{
+		Type:    raftpb.ConfChangeAddLearnerNode,
+		NodeID:  learner.ID.Uint64(),
+		Context: []byte(learner.Endpoint),
+	}
+	return a.changeConf(ctx, cc, func(progress map[uint64]tracker.Progress) bool {
+		_, ok := progress[learner.ID.Uint64()]
+		return ok
+	})
+}
+
+// PromoteLearner makes the learner a voter, errors.ErrLearnerNotCaughtUp is returned if the
+// learner falls behind the leader too much. It's a no-op if the peer is already a voter.
+func (a *appender) PromoteLearner(ctx context.Context, id vanus.ID) error {
+	ctx, span := a.tracer.Start(ctx, "PromoteLearner")
+	defer span.End()
+
+	if !a.isLeader() {
+		return errors.ErrNotLeader
+	}
+	st := a.node.Status()
+	pr, ok := st.Progress[id.Uint64()]
+	if !ok {
+		return errors.ErrResourceNotFound.WithMessage("the learner isn't a member of replica group")
+	}
+	if !pr.IsLearner {
+		return nil
+	}
+	if pr.State != tracker.StateReplicate || pr.Match+defaultMaxLearnerLag < st.Commit {
+		return errors.ErrLearnerNotCaughtUp
+	}
+
+	log.Info(ctx, "Promote learner to voter.", map[string]interface{}{
+		"node_id":    a.ID(),
+		"learner_id": id,
+		"match":      pr.Match,
+		"commit":     st.Commit,
+	})
+	cc := raftpb.ConfChange{
+		Type:   raftpb.ConfChangeAddNode,
+		NodeID: id.Uint64(),
+	}
+	return a.changeConf(ctx, cc, func(progress map[uint64]tracker.Progress) bool {
+		pr, ok := progress[id.Uint64()]
+		return ok && !pr.IsLearner
+	})
+}
+
+// RemovePeer removes a member from the replica group. It's a no-op if the peer isn't a member.
+func (a *appender) RemovePeer(ctx context.Context, id vanus.ID) error {
+	ctx, span := a.tracer.Start(ctx, "RemovePeer")
+	defer span.End()
+
+	if !a.isLeader() {
+		return errors.ErrNotLeader
+	}
+	if id == a.ID() {
+		return errors.ErrInvalidRequest.WithMessage("the leader can not remove itself")
+	}
+	if _, ok := a.node.Status().Progress[id.Uint64()]; !ok {
+		return nil
+	}
+
+	log.Info(ctx, "Remove peer from replica group.", map[string]interface{}{
+		"node_id": a.ID(),
+		"peer_id": id,
+	})
+	cc := raftpb.ConfChange{
+		Type:   raftpb.ConfChangeRemoveNode,
+		NodeID: id.Uint64(),
+	}
+	return a.changeConf(ctx, cc, func(progress map[uint64]tracker.Progress) bool {
+		_, ok := progress[id.Uint64()]
+		return !ok
+	})
+}
+
+// TransferLeader hands over the leadership to the voter, it returns once the transfer is started,
+// and the new leader is reported by LeaderChangedListener. It's a no-op if the voter is this node.
+func (a *appender) TransferLeader(ctx context.Context, to vanus.ID) error {
+	ctx, span := a.tracer.Start(ctx, "TransferLeader")
+	defer span.End()
+
+	if !a.isLeader() {
+		return errors.ErrNotLeader
+	}
+	if to == a.ID() {
+		return nil
+	}
+	if pr, ok := a.node.Status().Progress[to.Uint64()]; !ok || pr.IsLearner {
+		return errors.ErrInvalidRequest.WithMessage("the transferee isn't a voter of replica group")
+	}
+
+	log.Info(ctx, "Transfer leadership.", map[string]interface{}{
+		"node_id":    a.ID(),
+		"transferee": to,
+	})
+	a.node.TransferLeadership(ctx, a.ID().Uint64(), to.Uint64())
+	return nil
+}
+
+// changeConf proposes the conf change, and waits until applied is satisfied by the progress of
+// replica group.
+func (a *appender) changeConf(
+	ctx context.Context, cc raftpb.ConfChange, applied func(map[uint64]tracker.Progress) bool,
+) error {
+	if err := a.node.ProposeConfChange(ctx, cc); err != nil {
+		return err
+	}
+
+	t := time.NewTicker(defaultTickInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			st := a.node.Status()
+			if st.RaftState != raft.StateLeader {
+				return errors.ErrNotLeader
+			}
+			if applied(st.Progress) {
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -145,3 +145,17 @@ func (a *appender) hintPeer(ctx context.Context, from uint64, endpoint string) {
 		p.endpoint = endpoint
 	}
 }
+
+func (a *appender) removeHint(ctx context.Context, id uint64) {
+	_, span := a.tracer.Start(ctx, "removeHint")
+	defer span.End()
+
+	a.hintMu.Lock()
+	defer a.hintMu.Unlock()
+	for i := range a.hint {
+		if a.hint[i].id == id {
+			a.hint = append(a.hint[:i], a.hint[i+1:]...)
+			return
+		}
+	}
+}
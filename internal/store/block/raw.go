@@ -19,6 +19,9 @@ import (
 	"context"
 	"errors"
 
+	// first-party libraries.
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+
 	// this project.
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 )
@@ -42,6 +45,12 @@ type Snapshoter interface {
 	ApplySnapshot(ctx context.Context, snap Fragment) error
 }
 
+// Compressor is implemented by the raw blocks which can compress the data of entries.
+type Compressor interface {
+	// SetCompression sets the algorithm which compresses the data of entries appended later.
+	SetCompression(ctx context.Context, algorithm metapb.CompressAlgorithm) error
+}
+
 type Raw interface {
 	Seeker
 	Reader
@@ -56,9 +65,11 @@ type Raw interface {
 }
 
 type Statistics struct {
-	ID        vanus.ID
-	Capacity  uint64
-	Archived  bool
+	ID       vanus.ID
+	Capacity uint64
+	Archived bool
+	// Offloaded indicates the data of Block is moved to object storage.
+	Offloaded bool
 	EntryNum  uint32
 	EntrySize uint64
 	// FirstEntryStime is the millisecond timestamp when the first Entry will be written to Block.
@@ -23,9 +23,11 @@ import (
 	"github.com/linkall-labs/vanus/internal/primitive"
 	"github.com/linkall-labs/vanus/observability"
 	"github.com/linkall-labs/vanus/pkg/util"
+	"github.com/linkall-labs/vanus/pkg/util/tlsconfig"
 
 	// this project.
 	"github.com/linkall-labs/vanus/internal/store/io"
+	"github.com/linkall-labs/vanus/internal/store/objstore"
 	walog "github.com/linkall-labs/vanus/internal/store/wal"
 )
 
@@ -33,6 +35,10 @@ const (
 	baseKB                         = 1024
 	baseMB                         = 1024 * baseKB
 	ioEnginePsync                  = "psync"
+	tieredBackendFile              = "file"
+	tieredBackendS3                = "s3"
+	defaultTieredCacheSize         = 64 * baseMB
+	defaultIdempotenceWindowSize   = 1024
 	baseWALBlockSize        uint64 = 4 * baseKB
 	minMetaStoreWALFileSize uint64 = 4 * baseMB
 	minRaftLogWALFileSize   uint64 = 32 * baseMB
@@ -47,7 +53,10 @@ type Config struct {
 	MetaStore           SyncStoreConfig      `yaml:"meta_store"`
 	OffsetStore         AsyncStoreConfig     `yaml:"offset_store"`
 	Raft                RaftConfig           `yaml:"raft"`
+	Idempotence         IdempotenceConfig    `yaml:"idempotence"`
+	TieredStorage       TieredStorageConfig  `yaml:"tiered_storage"`
 	Observability       observability.Config `yaml:"observability"`
+	TLS                 tlsconfig.Config     `yaml:"tls"`
 }
 
 func (c *Config) Validate() error {
@@ -60,6 +69,9 @@ func (c *Config) Validate() error {
 	if err := c.Raft.validate(); err != nil {
 		return err
 	}
+	if err := c.TieredStorage.validate(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -67,6 +79,9 @@ type VolumeInfo struct {
 	ID       uint16 `json:"id"`
 	Dir      string `json:"dir"`
 	Capacity uint64 `json:"capacity"`
+	// Labels describe where the volume is, the controller spreads replicas of a segment
+	// across failure domains by them, e.g. zone, rack and node.
+	Labels map[string]string `json:"labels"`
 }
 
 type SyncStoreConfig struct {
@@ -93,6 +108,74 @@ func (c *RaftConfig) validate() error {
 	return c.WAL.validate(minRaftLogWALFileSize)
 }
 
+// IdempotenceConfig configures the window of idempotent append. The window is kept in memory and
+// rebuilt by reading the latest WindowSize entries of each block when it's opened, so a larger
+// window makes restart slower. Duplicates are only detected within one block, an event appended
+// again after the block is full may be stored twice.
+type IdempotenceConfig struct {
+	// WindowSize is the number of latest entries remembered by each block to skip duplicated
+	// appends, 0 means the default size.
+	WindowSize uint32 `yaml:"window_size"`
+}
+
+func (c *IdempotenceConfig) GetWindowSize() int {
+	if c.WindowSize == 0 {
+		return defaultIdempotenceWindowSize
+	}
+	return int(c.WindowSize)
+}
+
+// TieredStorageConfig configures the object storage which archived blocks are offloaded to.
+type TieredStorageConfig struct {
+	// Backend is the type of object storage, "s3" or "file", empty means disabled.
+	Backend string `yaml:"backend"`
+	// Dir is the directory of objects for "file" backend, e.g. a mounted shared filesystem.
+	Dir string            `yaml:"dir"`
+	S3  objstore.S3Config `yaml:"s3"`
+	// CacheSize is the size in bytes of the cache of data read from object storage.
+	CacheSize uint64 `yaml:"cache_size"`
+}
+
+func (c *TieredStorageConfig) validate() error {
+	switch c.Backend {
+	case "":
+	case tieredBackendFile:
+		if c.Dir == "" {
+			return fmt.Errorf("tiered storage dir must be set for %s backend", tieredBackendFile)
+		}
+	case tieredBackendS3:
+		if c.S3.Bucket == "" {
+			return fmt.Errorf("tiered storage bucket must be set for %s backend", tieredBackendS3)
+		}
+	default:
+		return fmt.Errorf("unknown tiered storage backend: %s", c.Backend)
+	}
+	return nil
+}
+
+// ObjectStore returns the object storage of archived blocks, nil if tiered storage is disabled.
+func (c *TieredStorageConfig) ObjectStore() (objstore.Store, error) {
+	var s objstore.Store
+	var err error
+	switch c.Backend {
+	case tieredBackendFile:
+		s, err = objstore.NewFileStore(c.Dir)
+	case tieredBackendS3:
+		s, err = objstore.NewS3Store(c.S3)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	size := c.CacheSize
+	if size == 0 {
+		size = defaultTieredCacheSize
+	}
+	return objstore.NewCachedStore(s, int64(size)), nil
+}
+
 type WALConfig struct {
 	BlockSize    uint64   `yaml:"block_size"`
 	FileSize     uint64   `yaml:"file_size"`
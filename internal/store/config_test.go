@@ -115,5 +115,33 @@ raft:
 		}
 		err = cfg.Validate()
 		So(err, ShouldNotBeNil)
+
+		cfg = Config{
+			TieredStorage: TieredStorageConfig{
+				Backend: "hdfs",
+			},
+		}
+		err = cfg.Validate()
+		So(err, ShouldNotBeNil)
+
+		cfg = Config{
+			TieredStorage: TieredStorageConfig{
+				Backend: tieredBackendS3,
+			},
+		}
+		err = cfg.Validate()
+		So(err, ShouldNotBeNil)
+
+		cfg = Config{
+			TieredStorage: TieredStorageConfig{
+				Backend: tieredBackendFile,
+				Dir:     t.TempDir(),
+			},
+		}
+		err = cfg.Validate()
+		So(err, ShouldBeNil)
+		s, err := cfg.TieredStorage.ObjectStore()
+		So(err, ShouldBeNil)
+		So(s, ShouldNotBeNil)
 	})
 }
@@ -0,0 +1,163 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objstore
+
+import (
+	// standard libraries.
+	"container/list"
+	"context"
+	"errors"
+	"io"
+	"sync"
+)
+
+// DefaultChunkSize is the size of the ranges which are fetched from Store and cached.
+const DefaultChunkSize = 256 * 1024
+
+type chunkKey struct {
+	key string
+	idx int64
+}
+
+type chunk struct {
+	chunkKey
+	data []byte
+}
+
+// cachedStore caches the recently read chunks of objects in memory, and evicts the least
+// recently used chunks once their total size exceeds capacity.
+type cachedStore struct {
+	Store
+	chunkSize int64
+	capacity  int64
+
+	mu     sync.Mutex
+	size   int64
+	lru    *list.List
+	chunks map[chunkKey]*list.Element
+}
+
+// Make sure cachedStore implements Store.
+var _ Store = (*cachedStore)(nil)
+
+// NewCachedStore wraps s with a LRU cache of capacity bytes, s is returned if capacity is not
+// positive.
+func NewCachedStore(s Store, capacity int64) Store {
+	if capacity <= 0 {
+		return s
+	}
+	return &cachedStore{
+		Store:     s,
+		chunkSize: DefaultChunkSize,
+		capacity:  capacity,
+		lru:       list.New(),
+		chunks:    make(map[chunkKey]*list.Element),
+	}
+}
+
+func (s *cachedStore) Put(ctx context.Context, key string, r io.Reader, size int64) error {
+	s.invalidate(key)
+	return s.Store.Put(ctx, key, r, size)
+}
+
+func (s *cachedStore) ReadAt(ctx context.Context, key string, p []byte, off int64) (int, error) {
+	var n int
+	for n < len(p) {
+		pos := off + int64(n)
+		idx := pos / s.chunkSize
+		data, err := s.chunk(ctx, key, idx)
+		if err != nil {
+			return n, err
+		}
+		co := pos - idx*s.chunkSize
+		if co >= int64(len(data)) {
+			return n, io.EOF
+		}
+		n += copy(p[n:], data[co:])
+		// A short chunk is the tail of object.
+		if int64(len(data)) < s.chunkSize && n < len(p) {
+			return n, io.EOF
+		}
+	}
+	return n, nil
+}
+
+func (s *cachedStore) Delete(ctx context.Context, key string) error {
+	s.invalidate(key)
+	return s.Store.Delete(ctx, key)
+}
+
+func (s *cachedStore) chunk(ctx context.Context, key string, idx int64) ([]byte, error) {
+	ck := chunkKey{key: key, idx: idx}
+
+	s.mu.Lock()
+	if e, ok := s.chunks[ck]; ok {
+		s.lru.MoveToFront(e)
+		s.mu.Unlock()
+		c, _ := e.Value.(*chunk)
+		return c.data, nil
+	}
+	s.mu.Unlock()
+
+	buf := make([]byte, s.chunkSize)
+	n, err := s.Store.ReadAt(ctx, key, buf, idx*s.chunkSize)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	data := buf[:n]
+
+	s.put(&chunk{chunkKey: ck, data: data})
+	return data, nil
+}
+
+func (s *cachedStore) put(c *chunk) {
+	sz := int64(len(c.data))
+	if sz > s.capacity {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Filled by another reader concurrently.
+	if _, ok := s.chunks[c.chunkKey]; ok {
+		return
+	}
+
+	s.chunks[c.chunkKey] = s.lru.PushFront(c)
+	s.size += sz
+	for s.size > s.capacity {
+		s.remove(s.lru.Back())
+	}
+}
+
+func (s *cachedStore) invalidate(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for e := s.lru.Front(); e != nil; {
+		next := e.Next()
+		if c, _ := e.Value.(*chunk); c.key == key {
+			s.remove(e)
+		}
+		e = next
+	}
+}
+
+func (s *cachedStore) remove(e *list.Element) {
+	c, _ := s.lru.Remove(e).(*chunk)
+	delete(s.chunks, c.chunkKey)
+	s.size -= int64(len(c.data))
+}
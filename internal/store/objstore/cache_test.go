@@ -0,0 +1,109 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objstore
+
+import (
+	// standard libraries.
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type countingStore struct {
+	Store
+	reads int
+}
+
+func (s *countingStore) ReadAt(ctx context.Context, key string, p []byte, off int64) (int, error) {
+	s.reads++
+	return s.Store.ReadAt(ctx, key, p, off)
+}
+
+func TestCachedStore(t *testing.T) {
+	Convey("cached store", t, func() {
+		ctx := context.Background()
+		dir, err := os.MkdirTemp("", "objstore-*")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		fs, err := NewFileStore(dir)
+		So(err, ShouldBeNil)
+		backend := &countingStore{Store: fs}
+		s, _ := NewCachedStore(backend, 2*DefaultChunkSize).(*cachedStore)
+		So(s, ShouldNotBeNil)
+
+		data := make([]byte, 2*DefaultChunkSize+100)
+		for i := range data {
+			data[i] = byte(i)
+		}
+		err = s.Put(ctx, "x", bytes.NewReader(data), int64(len(data)))
+		So(err, ShouldBeNil)
+
+		Convey("read across chunks", func() {
+			off := int64(DefaultChunkSize - 10)
+			buf := make([]byte, 20)
+			n, err := s.ReadAt(ctx, "x", buf, off)
+			So(err, ShouldBeNil)
+			So(n, ShouldEqual, 20)
+			So(buf, ShouldResemble, data[off:off+20])
+			So(backend.reads, ShouldEqual, 2)
+
+			// Served from cache.
+			_, err = s.ReadAt(ctx, "x", buf, off)
+			So(err, ShouldBeNil)
+			So(backend.reads, ShouldEqual, 2)
+		})
+
+		Convey("read the tail", func() {
+			buf := make([]byte, 200)
+			n, err := s.ReadAt(ctx, "x", buf, int64(len(data)-50))
+			So(err, ShouldEqual, io.EOF)
+			So(n, ShouldEqual, 50)
+			So(buf[:n], ShouldResemble, data[len(data)-50:])
+
+			n, err = s.ReadAt(ctx, "x", buf, int64(len(data)))
+			So(err, ShouldEqual, io.EOF)
+			So(n, ShouldEqual, 0)
+		})
+
+		Convey("evict least recently used chunks", func() {
+			buf := make([]byte, 1)
+			for _, off := range []int64{0, DefaultChunkSize, 2 * DefaultChunkSize} {
+				_, err := s.ReadAt(ctx, "x", buf, off)
+				So(err, ShouldBeNil)
+			}
+			So(backend.reads, ShouldEqual, 3)
+			So(s.size, ShouldBeLessThanOrEqualTo, s.capacity)
+
+			_, err := s.ReadAt(ctx, "x", buf, 0)
+			So(err, ShouldBeNil)
+			So(backend.reads, ShouldEqual, 4)
+		})
+
+		Convey("invalidate chunks after delete", func() {
+			_, err := s.ReadAt(ctx, "x", make([]byte, 1), 0)
+			So(err, ShouldBeNil)
+			So(s.Delete(ctx, "x"), ShouldBeNil)
+			So(s.lru.Len(), ShouldEqual, 0)
+			_, err = s.ReadAt(ctx, "x", make([]byte, 1), 0)
+			So(err, ShouldEqual, ErrNotFound)
+		})
+	})
+}
@@ -0,0 +1,105 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objstore
+
+import (
+	// standard libraries.
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+const (
+	defaultDirPerm  = 0o755
+	defaultFilePerm = 0o644
+	tmpExt          = ".tmp"
+)
+
+// fileStore stores objects as files in a directory, it is used for tests and the deployments
+// which mount a shared filesystem.
+type fileStore struct {
+	dir string
+}
+
+// Make sure fileStore implements Store.
+var _ Store = (*fileStore)(nil)
+
+// NewFileStore returns a Store which keeps objects as files in dir.
+func NewFileStore(dir string) (Store, error) {
+	if err := os.MkdirAll(dir, defaultDirPerm); err != nil {
+		return nil, err
+	}
+	return &fileStore{dir: dir}, nil
+}
+
+func (s *fileStore) Put(ctx context.Context, key string, r io.Reader, size int64) error {
+	path := s.resolvePath(key)
+	if err := os.MkdirAll(filepath.Dir(path), defaultDirPerm); err != nil {
+		return err
+	}
+
+	// Write to a temporary file and rename it, so readers never see a partial object.
+	tmp := path + tmpExt
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, defaultFilePerm)
+	if err != nil {
+		return err
+	}
+	if _, err = io.CopyN(f, r, size); err == nil {
+		err = f.Sync()
+	}
+	if err2 := f.Close(); err == nil {
+		err = err2
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func (s *fileStore) ReadAt(ctx context.Context, key string, p []byte, off int64) (int, error) {
+	f, err := os.Open(s.resolvePath(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, ErrNotFound
+		}
+		return 0, err
+	}
+	defer f.Close()
+	return f.ReadAt(p, off)
+}
+
+func (s *fileStore) Size(ctx context.Context, key string) (int64, error) {
+	fi, err := os.Stat(s.resolvePath(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, ErrNotFound
+		}
+		return 0, err
+	}
+	return fi.Size(), nil
+}
+
+func (s *fileStore) Delete(ctx context.Context, key string) error {
+	if err := os.Remove(s.resolvePath(key)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *fileStore) resolvePath(key string) string {
+	return filepath.Join(s.dir, filepath.FromSlash(key))
+}
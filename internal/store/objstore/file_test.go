@@ -0,0 +1,76 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objstore
+
+import (
+	// standard libraries.
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFileStore(t *testing.T) {
+	Convey("file store", t, func() {
+		ctx := context.Background()
+		dir, err := os.MkdirTemp("", "objstore-*")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		s, err := NewFileStore(dir)
+		So(err, ShouldBeNil)
+
+		data := []byte("0123456789")
+		err = s.Put(ctx, "a/b.vsb", bytes.NewReader(data), int64(len(data)))
+		So(err, ShouldBeNil)
+
+		Convey("read range", func() {
+			buf := make([]byte, 4)
+			n, err := s.ReadAt(ctx, "a/b.vsb", buf, 3)
+			So(err, ShouldBeNil)
+			So(n, ShouldEqual, 4)
+			So(buf, ShouldResemble, []byte("3456"))
+		})
+
+		Convey("read beyond the end", func() {
+			buf := make([]byte, 4)
+			n, err := s.ReadAt(ctx, "a/b.vsb", buf, 8)
+			So(err, ShouldEqual, io.EOF)
+			So(n, ShouldEqual, 2)
+			So(buf[:n], ShouldResemble, []byte("89"))
+		})
+
+		Convey("size of object", func() {
+			n, err := s.Size(ctx, "a/b.vsb")
+			So(err, ShouldBeNil)
+			So(n, ShouldEqual, len(data))
+			_, err = s.Size(ctx, "a/c.vsb")
+			So(err, ShouldEqual, ErrNotFound)
+		})
+
+		Convey("delete object", func() {
+			So(s.Delete(ctx, "a/b.vsb"), ShouldBeNil)
+			_, err := s.ReadAt(ctx, "a/b.vsb", make([]byte, 1), 0)
+			So(err, ShouldEqual, ErrNotFound)
+			_, err = s.Size(ctx, "a/b.vsb")
+			So(err, ShouldEqual, ErrNotFound)
+			So(s.Delete(ctx, "a/b.vsb"), ShouldBeNil)
+		})
+	})
+}
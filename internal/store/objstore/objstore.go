@@ -0,0 +1,60 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package objstore provides the object storage which archived blocks are offloaded to.
+package objstore
+
+import (
+	// standard libraries.
+	"context"
+	"errors"
+	"io"
+)
+
+var ErrNotFound = errors.New("object not found")
+
+// Store is an object storage, objects are immutable after put.
+type Store interface {
+	// Put uploads size bytes read from r as the object key.
+	Put(ctx context.Context, key string, r io.Reader, size int64) error
+	// ReadAt reads len(p) bytes of the object key from off, like io.ReaderAt, it returns io.EOF
+	// if the object ends before p is filled, and ErrNotFound if the object does not exist.
+	ReadAt(ctx context.Context, key string, p []byte, off int64) (int, error)
+	// Size returns the size of object key, or ErrNotFound if the object does not exist.
+	Size(ctx context.Context, key string) (int64, error)
+	// Delete removes the object key, it succeeds if the object does not exist.
+	Delete(ctx context.Context, key string) error
+}
+
+// NewReaderAt returns an io.ReaderAt which reads the object key from s.
+func NewReaderAt(ctx context.Context, s Store, key string) io.ReaderAt {
+	return &objectReader{
+		ctx: ctx,
+		s:   s,
+		key: key,
+	}
+}
+
+type objectReader struct {
+	ctx context.Context
+	s   Store
+	key string
+}
+
+// Make sure objectReader implements io.ReaderAt.
+var _ io.ReaderAt = (*objectReader)(nil)
+
+func (r *objectReader) ReadAt(p []byte, off int64) (int, error) {
+	return r.s.ReadAt(r.ctx, r.key, p, off)
+}
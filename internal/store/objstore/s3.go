@@ -0,0 +1,149 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objstore
+
+import (
+	// standard libraries.
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+
+	// third-party libraries.
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+type S3Config struct {
+	// Endpoint is the URL of S3-compatible service, the AWS endpoint of Region is used if empty.
+	Endpoint        string `yaml:"endpoint"`
+	Region          string `yaml:"region"`
+	Bucket          string `yaml:"bucket"`
+	AccessKeyID     string `yaml:"access_key_id"`
+	SecretAccessKey string `yaml:"secret_access_key"`
+	// UsePathStyle addresses the bucket in the path instead of the host, it is required by
+	// most of S3-compatible services, e.g. MinIO.
+	UsePathStyle bool `yaml:"use_path_style"`
+	// Prefix is prepended to the keys of objects.
+	Prefix string `yaml:"prefix"`
+}
+
+type s3Store struct {
+	client *s3.Client
+	bucket string
+	prefix string
+}
+
+// Make sure s3Store implements Store.
+var _ Store = (*s3Store)(nil)
+
+// NewS3Store returns a Store which keeps objects in a bucket of S3-compatible service.
+func NewS3Store(cfg S3Config) (Store, error) {
+	if cfg.Bucket == "" {
+		return nil, errors.New("objstore: bucket of s3 is empty")
+	}
+
+	opts := s3.Options{
+		Region:       cfg.Region,
+		UsePathStyle: cfg.UsePathStyle,
+	}
+	if cfg.AccessKeyID != "" {
+		opts.Credentials = aws.NewCredentialsCache(
+			credentials.NewStaticCredentialsProvider(cfg.AccessKeyID, cfg.SecretAccessKey, ""))
+	}
+	if cfg.Endpoint != "" {
+		opts.EndpointResolver = s3.EndpointResolverFromURL(cfg.Endpoint)
+	}
+
+	return &s3Store{
+		client: s3.New(opts),
+		bucket: cfg.Bucket,
+		prefix: cfg.Prefix,
+	}, nil
+}
+
+func (s *s3Store) Put(ctx context.Context, key string, r io.Reader, size int64) error {
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(s.bucket),
+		Key:           aws.String(s.resolveKey(key)),
+		Body:          r,
+		ContentLength: size,
+	})
+	return err
+}
+
+func (s *s3Store) ReadAt(ctx context.Context, key string, p []byte, off int64) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	out, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.resolveKey(key)),
+		Range:  aws.String(fmt.Sprintf("bytes=%d-%d", off, off+int64(len(p))-1)),
+	})
+	if err != nil {
+		var nsk *types.NoSuchKey
+		if errors.As(err, &nsk) {
+			return 0, ErrNotFound
+		}
+		var re interface{ HTTPStatusCode() int }
+		if errors.As(err, &re) && re.HTTPStatusCode() == http.StatusRequestedRangeNotSatisfiable {
+			return 0, io.EOF
+		}
+		return 0, err
+	}
+	defer out.Body.Close()
+
+	n, err := io.ReadFull(out.Body, p)
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		err = io.EOF
+	}
+	return n, err
+}
+
+func (s *s3Store) Size(ctx context.Context, key string) (int64, error) {
+	out, err := s.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.resolveKey(key)),
+	})
+	if err != nil {
+		var nf *types.NotFound
+		if errors.As(err, &nf) {
+			return 0, ErrNotFound
+		}
+		return 0, err
+	}
+	return out.ContentLength, nil
+}
+
+func (s *s3Store) Delete(ctx context.Context, key string) error {
+	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.resolveKey(key)),
+	})
+	return err
+}
+
+func (s *s3Store) resolveKey(key string) string {
+	if s.prefix == "" {
+		return key
+	}
+	return path.Join(s.prefix, key)
+}
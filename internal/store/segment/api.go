@@ -27,6 +27,9 @@ import (
 
 	// this project.
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/block"
+	"github.com/linkall-labs/vanus/internal/store/block/raft"
+	"github.com/linkall-labs/vanus/pkg/errors"
 )
 
 type segmentServer struct {
@@ -71,6 +74,9 @@ func (s *segmentServer) CreateBlock(ctx context.Context, req *segpb.CreateBlockR
 
 func (s *segmentServer) RemoveBlock(ctx context.Context, req *segpb.RemoveBlockRequest) (*emptypb.Empty, error) {
 	blockID := vanus.NewIDFromUint64(req.Id)
+	if req.KeepObject {
+		ctx = block.WithObjectKept(ctx)
+	}
 	if err := s.srv.RemoveBlock(ctx, blockID); err != nil {
 		return nil, err
 	}
@@ -89,6 +95,29 @@ func (s *segmentServer) GetBlockInfo(
 	return &segpb.GetBlockInfoResponse{}, nil
 }
 
+func (s *segmentServer) SetBlockCompression(
+	ctx context.Context, req *segpb.SetBlockCompressionRequest,
+) (*emptypb.Empty, error) {
+	blockID := vanus.NewIDFromUint64(req.BlockId)
+	if err := s.srv.SetBlockCompression(ctx, blockID, req.Compression); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *segmentServer) SetBlockSegment(
+	ctx context.Context, req *segpb.SetBlockSegmentRequest,
+) (*emptypb.Empty, error) {
+	blockID := vanus.NewIDFromUint64(req.BlockId)
+	segID := vanus.NewIDFromUint64(req.SegmentId)
+	if err := s.srv.SetBlockSegment(ctx, blockID, segID); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (s *segmentServer) ActivateSegment(
 	ctx context.Context, req *segpb.ActivateSegmentRequest,
 ) (*segpb.ActivateSegmentResponse, error) {
@@ -100,7 +129,7 @@ func (s *segmentServer) ActivateSegment(
 		replicas[blockID] = endpoint
 	}
 
-	if err := s.srv.ActivateSegment(ctx, logID, segID, replicas); err != nil {
+	if err := s.srv.ActivateSegment(ctx, logID, segID, replicas, req.Compression); err != nil {
 		return nil, err
 	}
 
@@ -113,11 +142,39 @@ func (s *segmentServer) InactivateSegment(
 	return &emptypb.Empty{}, nil
 }
 
+func (s *segmentServer) ChangeMembership(
+	ctx context.Context, req *segpb.ChangeMembershipRequest,
+) (*emptypb.Empty, error) {
+	blockID := vanus.NewIDFromUint64(req.BlockId)
+	peerID := vanus.NewIDFromUint64(req.PeerId)
+	var err error
+	switch req.Type {
+	case segpb.MembershipChangeType_ADD_LEARNER:
+		err = s.srv.AddLearner(ctx, blockID, raft.Peer{ID: peerID, Endpoint: req.Endpoint})
+	case segpb.MembershipChangeType_PROMOTE_LEARNER:
+		err = s.srv.PromoteLearner(ctx, blockID, peerID)
+	case segpb.MembershipChangeType_REMOVE_PEER:
+		err = s.srv.RemovePeer(ctx, blockID, peerID)
+	case segpb.MembershipChangeType_TRANSFER_LEADER:
+		err = s.srv.TransferLeader(ctx, blockID, peerID)
+	default:
+		err = errors.ErrInvalidRequest.WithMessage("unknown membership change type")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (s *segmentServer) AppendToBlock(
 	ctx context.Context, req *segpb.AppendToBlockRequest,
 ) (*segpb.AppendToBlockResponse, error) {
 	blockID := vanus.NewIDFromUint64(req.BlockId)
 	events := req.Events.GetEvents()
+	if req.Idempotent {
+		ctx = block.WithIdempotentAppend(ctx)
+	}
 	offs, err := s.srv.AppendToBlock(ctx, blockID, events)
 	if err != nil {
 		return nil, err
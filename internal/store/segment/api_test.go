@@ -26,11 +26,14 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	// first-party libraries.
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
 	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
 
 	// this project.
 	"github.com/linkall-labs/vanus/internal/primitive"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/block"
+	"github.com/linkall-labs/vanus/internal/store/block/raft"
 	"github.com/linkall-labs/vanus/pkg/errors"
 )
 
@@ -117,6 +120,17 @@ func TestSegmentServer(t *testing.T) {
 			}
 			_, err = ss.RemoveBlock(context.Background(), req)
 			So(err, ShouldEqual, errors.ErrInvalidRequest)
+
+			blockID := vanus.NewTestID()
+			srv.EXPECT().RemoveBlock(Any(), Eq(blockID)).DoAndReturn(func(ctx context.Context, _ vanus.ID) error {
+				So(block.IsObjectKept(ctx), ShouldBeTrue)
+				return nil
+			})
+			_, err = ss.RemoveBlock(context.Background(), &segpb.RemoveBlockRequest{
+				Id:         blockID.Uint64(),
+				KeepObject: true,
+			})
+			So(err, ShouldBeNil)
 		})
 
 		Convey("GetBlockInfo()", func() {
@@ -127,9 +141,36 @@ func TestSegmentServer(t *testing.T) {
 			So(resp, ShouldNotBeNil)
 		})
 
+		Convey("SetBlockCompression()", func() {
+			blockID := vanus.NewTestID()
+			srv.EXPECT().SetBlockCompression(Any(), Eq(blockID), Eq(metapb.CompressAlgorithm_ZSTD)).Return(nil)
+
+			req := &segpb.SetBlockCompressionRequest{
+				BlockId:     blockID.Uint64(),
+				Compression: metapb.CompressAlgorithm_ZSTD,
+			}
+			resp, err := ss.SetBlockCompression(context.Background(), req)
+			So(err, ShouldBeNil)
+			So(resp, ShouldNotBeNil)
+		})
+
+		Convey("SetBlockSegment()", func() {
+			blockID := vanus.NewTestID()
+			segID := vanus.NewTestID()
+			srv.EXPECT().SetBlockSegment(Any(), Eq(blockID), Eq(segID)).Return(nil)
+
+			req := &segpb.SetBlockSegmentRequest{
+				BlockId:   blockID.Uint64(),
+				SegmentId: segID.Uint64(),
+			}
+			resp, err := ss.SetBlockSegment(context.Background(), req)
+			So(err, ShouldBeNil)
+			So(resp, ShouldNotBeNil)
+		})
+
 		Convey("ActivateSegment()", func() {
 			// TODO(james.yin):
-			srv.EXPECT().ActivateSegment(Any(), Any(), Any(), Any()).Return(nil)
+			srv.EXPECT().ActivateSegment(Any(), Any(), Any(), Any(), Eq(metapb.CompressAlgorithm_LZ4)).Return(nil)
 
 			req := &segpb.ActivateSegmentRequest{
 				EventLogId:     vanus.NewTestID().Uint64(),
@@ -137,6 +178,7 @@ func TestSegmentServer(t *testing.T) {
 				Replicas: map[uint64]string{
 					1: "127.0.0.1:11811",
 				},
+				Compression: metapb.CompressAlgorithm_LZ4,
 			}
 			resp, err := ss.ActivateSegment(context.Background(), req)
 			So(err, ShouldBeNil)
@@ -152,6 +194,43 @@ func TestSegmentServer(t *testing.T) {
 			So(err, ShouldBeNil)
 		})
 
+		Convey("ChangeMembership()", func() {
+			leaderID := vanus.NewTestID()
+			learnerID := vanus.NewTestID()
+			srv.EXPECT().AddLearner(Any(), Eq(leaderID), Eq(raft.Peer{
+				ID:       learnerID,
+				Endpoint: "127.0.0.1:11811",
+			})).Return(nil)
+			srv.EXPECT().PromoteLearner(Any(), Eq(leaderID), Eq(learnerID)).Return(errors.ErrLearnerNotCaughtUp)
+			srv.EXPECT().RemovePeer(Any(), Eq(leaderID), Eq(learnerID)).Return(nil)
+			srv.EXPECT().TransferLeader(Any(), Eq(leaderID), Eq(learnerID)).Return(nil)
+
+			req := &segpb.ChangeMembershipRequest{
+				BlockId:  leaderID.Uint64(),
+				Type:     segpb.MembershipChangeType_ADD_LEARNER,
+				PeerId:   learnerID.Uint64(),
+				Endpoint: "127.0.0.1:11811",
+			}
+			_, err := ss.ChangeMembership(context.Background(), req)
+			So(err, ShouldBeNil)
+
+			req.Type = segpb.MembershipChangeType_PROMOTE_LEARNER
+			_, err = ss.ChangeMembership(context.Background(), req)
+			So(err, ShouldEqual, errors.ErrLearnerNotCaughtUp)
+
+			req.Type = segpb.MembershipChangeType_REMOVE_PEER
+			_, err = ss.ChangeMembership(context.Background(), req)
+			So(err, ShouldBeNil)
+
+			req.Type = segpb.MembershipChangeType_TRANSFER_LEADER
+			_, err = ss.ChangeMembership(context.Background(), req)
+			So(err, ShouldBeNil)
+
+			req.Type = segpb.MembershipChangeType(100)
+			_, err = ss.ChangeMembership(context.Background(), req)
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+		})
+
 		Convey("AppendToBlock()", func() {
 			srv.EXPECT().AppendToBlock(Any(), Not(vanus.EmptyID()), Not(Len(0))).Return([]int64{1}, nil)
 			srv.EXPECT().AppendToBlock(Any(), Eq(vanus.EmptyID()), Any()).Return(nil, errors.ErrInvalidRequest)
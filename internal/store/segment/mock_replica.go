@@ -38,6 +38,20 @@ func (m *MockReplica) EXPECT() *MockReplicaMockRecorder {
 	return m.recorder
 }
 
+// AddLearner mocks base method.
+func (m *MockReplica) AddLearner(ctx context.Context, learner raft.Peer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddLearner", ctx, learner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddLearner indicates an expected call of AddLearner.
+func (mr *MockReplicaMockRecorder) AddLearner(ctx, learner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLearner", reflect.TypeOf((*MockReplica)(nil).AddLearner), ctx, learner)
+}
+
 // Append mocks base method.
 func (m *MockReplica) Append(ctx context.Context, entries ...block.Entry) ([]int64, error) {
 	m.ctrl.T.Helper()
@@ -128,6 +142,35 @@ func (mr *MockReplicaMockRecorder) IDStr() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IDStr", reflect.TypeOf((*MockReplica)(nil).IDStr))
 }
 
+// Offload mocks base method.
+func (m *MockReplica) Offload(ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Offload", ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Offload indicates an expected call of Offload.
+func (mr *MockReplicaMockRecorder) Offload(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Offload", reflect.TypeOf((*MockReplica)(nil).Offload), ctx)
+}
+
+// PromoteLearner mocks base method.
+func (m *MockReplica) PromoteLearner(ctx context.Context, id vanus.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromoteLearner", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PromoteLearner indicates an expected call of PromoteLearner.
+func (mr *MockReplicaMockRecorder) PromoteLearner(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoteLearner", reflect.TypeOf((*MockReplica)(nil).PromoteLearner), ctx, id)
+}
+
 // Read mocks base method.
 func (m *MockReplica) Read(ctx context.Context, seq int64, num int) ([]block.Entry, error) {
 	m.ctrl.T.Helper()
@@ -143,6 +186,20 @@ func (mr *MockReplicaMockRecorder) Read(ctx, seq, num interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockReplica)(nil).Read), ctx, seq, num)
 }
 
+// RemovePeer mocks base method.
+func (m *MockReplica) RemovePeer(ctx context.Context, id vanus.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePeer", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemovePeer indicates an expected call of RemovePeer.
+func (mr *MockReplicaMockRecorder) RemovePeer(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePeer", reflect.TypeOf((*MockReplica)(nil).RemovePeer), ctx, id)
+}
+
 // Seek mocks base method.
 func (m *MockReplica) Seek(ctx context.Context, index int64, key block.Entry, flag block.SeekKeyFlag) (int64, error) {
 	m.ctrl.T.Helper()
@@ -158,6 +215,34 @@ func (mr *MockReplicaMockRecorder) Seek(ctx, index, key, flag interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seek", reflect.TypeOf((*MockReplica)(nil).Seek), ctx, index, key, flag)
 }
 
+// SetCompression mocks base method.
+func (m *MockReplica) SetCompression(ctx context.Context, algorithm meta.CompressAlgorithm) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCompression", ctx, algorithm)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCompression indicates an expected call of SetCompression.
+func (mr *MockReplicaMockRecorder) SetCompression(ctx, algorithm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCompression", reflect.TypeOf((*MockReplica)(nil).SetCompression), ctx, algorithm)
+}
+
+// SetSegment mocks base method.
+func (m *MockReplica) SetSegment(ctx context.Context, id vanus.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSegment", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSegment indicates an expected call of SetSegment.
+func (mr *MockReplicaMockRecorder) SetSegment(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSegment", reflect.TypeOf((*MockReplica)(nil).SetSegment), ctx, id)
+}
+
 // Status mocks base method.
 func (m *MockReplica) Status() *meta.SegmentHealthInfo {
 	m.ctrl.T.Helper()
@@ -171,3 +256,17 @@ func (mr *MockReplicaMockRecorder) Status() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockReplica)(nil).Status))
 }
+
+// TransferLeader mocks base method.
+func (m *MockReplica) TransferLeader(ctx context.Context, to vanus.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferLeader", ctx, to)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferLeader indicates an expected call of TransferLeader.
+func (mr *MockReplicaMockRecorder) TransferLeader(ctx, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferLeader", reflect.TypeOf((*MockReplica)(nil).TransferLeader), ctx, to)
+}
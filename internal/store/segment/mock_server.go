@@ -13,6 +13,8 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	primitive "github.com/linkall-labs/vanus/internal/primitive"
 	vanus "github.com/linkall-labs/vanus/internal/primitive/vanus"
+	raft "github.com/linkall-labs/vanus/internal/store/block/raft"
+	meta "github.com/linkall-labs/vanus/proto/pkg/meta"
 )
 
 // MockServer is a mock of Server interface.
@@ -39,17 +41,31 @@ func (m *MockServer) EXPECT() *MockServerMockRecorder {
 }
 
 // ActivateSegment mocks base method.
-func (m *MockServer) ActivateSegment(ctx context.Context, logID, segID vanus.ID, replicas map[vanus.ID]string) error {
+func (m *MockServer) ActivateSegment(ctx context.Context, logID, segID vanus.ID, replicas map[vanus.ID]string, compression meta.CompressAlgorithm) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ActivateSegment", ctx, logID, segID, replicas)
+	ret := m.ctrl.Call(m, "ActivateSegment", ctx, logID, segID, replicas, compression)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ActivateSegment indicates an expected call of ActivateSegment.
-func (mr *MockServerMockRecorder) ActivateSegment(ctx, logID, segID, replicas interface{}) *gomock.Call {
+func (mr *MockServerMockRecorder) ActivateSegment(ctx, logID, segID, replicas, compression interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateSegment", reflect.TypeOf((*MockServer)(nil).ActivateSegment), ctx, logID, segID, replicas)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateSegment", reflect.TypeOf((*MockServer)(nil).ActivateSegment), ctx, logID, segID, replicas, compression)
+}
+
+// AddLearner mocks base method.
+func (m *MockServer) AddLearner(ctx context.Context, id vanus.ID, learner raft.Peer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddLearner", ctx, id, learner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddLearner indicates an expected call of AddLearner.
+func (mr *MockServerMockRecorder) AddLearner(ctx, id, learner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLearner", reflect.TypeOf((*MockServer)(nil).AddLearner), ctx, id, learner)
 }
 
 // AppendToBlock mocks base method.
@@ -124,6 +140,20 @@ func (mr *MockServerMockRecorder) LookupOffsetInBlock(ctx, id, stime interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupOffsetInBlock", reflect.TypeOf((*MockServer)(nil).LookupOffsetInBlock), ctx, id, stime)
 }
 
+// PromoteLearner mocks base method.
+func (m *MockServer) PromoteLearner(ctx context.Context, id, learner vanus.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromoteLearner", ctx, id, learner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PromoteLearner indicates an expected call of PromoteLearner.
+func (mr *MockServerMockRecorder) PromoteLearner(ctx, id, learner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoteLearner", reflect.TypeOf((*MockServer)(nil).PromoteLearner), ctx, id, learner)
+}
+
 // ReadFromBlock mocks base method.
 func (m *MockServer) ReadFromBlock(ctx context.Context, id vanus.ID, seq int64, num int, pollingTimeout uint32) ([]*v1.CloudEvent, error) {
 	m.ctrl.T.Helper()
@@ -153,6 +183,20 @@ func (mr *MockServerMockRecorder) RemoveBlock(ctx, id interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBlock", reflect.TypeOf((*MockServer)(nil).RemoveBlock), ctx, id)
 }
 
+// RemovePeer mocks base method.
+func (m *MockServer) RemovePeer(ctx context.Context, id, peer vanus.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePeer", ctx, id, peer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemovePeer indicates an expected call of RemovePeer.
+func (mr *MockServerMockRecorder) RemovePeer(ctx, id, peer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePeer", reflect.TypeOf((*MockServer)(nil).RemovePeer), ctx, id, peer)
+}
+
 // Serve mocks base method.
 func (m *MockServer) Serve(lis net.Listener) error {
 	m.ctrl.T.Helper()
@@ -167,6 +211,34 @@ func (mr *MockServerMockRecorder) Serve(lis interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Serve", reflect.TypeOf((*MockServer)(nil).Serve), lis)
 }
 
+// SetBlockCompression mocks base method.
+func (m *MockServer) SetBlockCompression(ctx context.Context, id vanus.ID, algorithm meta.CompressAlgorithm) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBlockCompression", ctx, id, algorithm)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetBlockCompression indicates an expected call of SetBlockCompression.
+func (mr *MockServerMockRecorder) SetBlockCompression(ctx, id, algorithm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBlockCompression", reflect.TypeOf((*MockServer)(nil).SetBlockCompression), ctx, id, algorithm)
+}
+
+// SetBlockSegment mocks base method.
+func (m *MockServer) SetBlockSegment(ctx context.Context, id, segID vanus.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBlockSegment", ctx, id, segID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetBlockSegment indicates an expected call of SetBlockSegment.
+func (mr *MockServerMockRecorder) SetBlockSegment(ctx, id, segID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBlockSegment", reflect.TypeOf((*MockServer)(nil).SetBlockSegment), ctx, id, segID)
+}
+
 // Start mocks base method.
 func (m *MockServer) Start(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -208,3 +280,17 @@ func (mr *MockServerMockRecorder) Stop(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockServer)(nil).Stop), ctx)
 }
+
+// TransferLeader mocks base method.
+func (m *MockServer) TransferLeader(ctx context.Context, id, to vanus.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferLeader", ctx, id, to)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferLeader indicates an expected call of TransferLeader.
+func (mr *MockServerMockRecorder) TransferLeader(ctx, id, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferLeader", reflect.TypeOf((*MockServer)(nil).TransferLeader), ctx, id, to)
+}
@@ -0,0 +1,77 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"context"
+	"time"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/log"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+)
+
+const defaultOffloadInterval = 10 * time.Second
+
+// offloadBlock moves the data of archived block to object storage. The leader uploads the object
+// and the followers evict their data after the object exists, so it retries until the block is
+// offloaded or removed, or the server is stopped.
+func (s *server) offloadBlock(id vanus.ID) {
+	if _, loaded := s.offloading.LoadOrStore(id, struct{}{}); loaded {
+		return
+	}
+	defer s.offloading.Delete(id)
+
+	ticker := time.NewTicker(defaultOffloadInterval)
+	defer ticker.Stop()
+	for {
+		v, ok := s.replicas.Load(id)
+		if !ok {
+			return
+		}
+		b, _ := v.(Replica)
+		offloaded, err := b.Offload(context.Background())
+		if err != nil {
+			log.Warning(context.Background(), "Offload block to object storage failed.", map[string]interface{}{
+				"block_id":   id,
+				log.KeyError: err,
+			})
+		}
+		if offloaded {
+			return
+		}
+
+		select {
+		case <-s.closeC:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// resumeOffloading offloads the archived blocks which were not offloaded before restart.
+func (s *server) resumeOffloading() {
+	s.replicas.Range(func(key, value interface{}) bool {
+		b, _ := value.(Replica)
+		if info := b.Status(); info.IsFull && info.Tier != metapb.StorageTier_S3 {
+			go s.offloadBlock(b.ID())
+		}
+		return true
+	})
+}
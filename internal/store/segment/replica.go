@@ -20,6 +20,7 @@ import (
 	"context"
 
 	// first-party libraries.
+	"github.com/linkall-labs/vanus/pkg/errors"
 	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
 
 	// this project.
@@ -35,6 +36,15 @@ type Replica interface {
 
 	IDStr() string
 	Bootstrap(ctx context.Context, blocks []raft.Peer) error
+	AddLearner(ctx context.Context, learner raft.Peer) error
+	PromoteLearner(ctx context.Context, id vanus.ID) error
+	RemovePeer(ctx context.Context, id vanus.ID) error
+	TransferLeader(ctx context.Context, to vanus.ID) error
+	SetCompression(ctx context.Context, algorithm metapb.CompressAlgorithm) error
+	SetSegment(ctx context.Context, id vanus.ID) error
+	// Offload moves the data of archived replica to object storage, the object is uploaded by the
+	// leader, it returns true once the replica is offloaded.
+	Offload(ctx context.Context) (bool, error)
 	Close(ctx context.Context) error
 	Delete(ctx context.Context) error
 	Status() *metapb.SegmentHealthInfo
@@ -62,6 +72,47 @@ func (r *replica) Bootstrap(ctx context.Context, blocks []raft.Peer) error {
 	return r.appender.Bootstrap(ctx, blocks)
 }
 
+func (r *replica) AddLearner(ctx context.Context, learner raft.Peer) error {
+	return r.appender.AddLearner(ctx, learner)
+}
+
+func (r *replica) PromoteLearner(ctx context.Context, id vanus.ID) error {
+	return r.appender.PromoteLearner(ctx, id)
+}
+
+func (r *replica) RemovePeer(ctx context.Context, id vanus.ID) error {
+	return r.appender.RemovePeer(ctx, id)
+}
+
+func (r *replica) TransferLeader(ctx context.Context, to vanus.ID) error {
+	return r.appender.TransferLeader(ctx, to)
+}
+
+func (r *replica) SetCompression(ctx context.Context, algorithm metapb.CompressAlgorithm) error {
+	if c, ok := r.raw.(block.Compressor); ok {
+		return c.SetCompression(ctx, algorithm)
+	}
+	if algorithm != metapb.CompressAlgorithm_NONE {
+		return errors.ErrInvalidRequest.WithMessage("the block doesn't support compression")
+	}
+	return nil
+}
+
+func (r *replica) SetSegment(ctx context.Context, id vanus.ID) error {
+	if o, ok := r.raw.(block.Offloader); ok {
+		return o.SetSegment(ctx, id)
+	}
+	return nil
+}
+
+func (r *replica) Offload(ctx context.Context) (bool, error) {
+	o, ok := r.raw.(block.Offloader)
+	if !ok {
+		return true, nil
+	}
+	return o.Offload(ctx, r.appender.Status().Leader == r.id)
+}
+
 func (r *replica) Close(ctx context.Context) error {
 	r.appender.Stop(ctx)
 	return r.raw.Close(ctx)
@@ -98,6 +149,7 @@ func (r *replica) Status() *metapb.SegmentHealthInfo {
 		Leader:             cs.Leader.Uint64(),
 		Term:               cs.Term,
 		FirstEventBornTime: stat.FirstEntryStime,
+		Tier:               storageTier(stat),
 	}
 	if stat.Archived {
 		info.LastEventBornTime = stat.LastEntryStime
@@ -105,6 +157,13 @@ func (r *replica) Status() *metapb.SegmentHealthInfo {
 	return info
 }
 
+func storageTier(stat block.Statistics) metapb.StorageTier {
+	if stat.Offloaded {
+		return metapb.StorageTier_S3
+	}
+	return metapb.StorageTier_SSD
+}
+
 func (s *server) createBlock(ctx context.Context, id vanus.ID, size int64) (Replica, error) {
 	e, _ := raw.ResolveEngine(raw.VSB)
 
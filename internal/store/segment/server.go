@@ -31,12 +31,12 @@ import (
 	// third-party libraries.
 	cepb "cloudevents.io/genproto/v1"
 	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	"github.com/linkall-labs/vanus/pkg/util/tlsconfig"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/tap"
 	"google.golang.org/protobuf/proto"
 
@@ -85,9 +85,16 @@ type Server interface {
 	CreateBlock(ctx context.Context, id vanus.ID, size int64) error
 	RemoveBlock(ctx context.Context, id vanus.ID) error
 	// GetBlockInfo(ctx context.Context, id vanus.ID) error
+	SetBlockCompression(ctx context.Context, id vanus.ID, algorithm metapb.CompressAlgorithm) error
+	SetBlockSegment(ctx context.Context, id vanus.ID, segID vanus.ID) error
 
-	ActivateSegment(ctx context.Context, logID vanus.ID, segID vanus.ID, replicas map[vanus.ID]string) error
+	ActivateSegment(ctx context.Context, logID vanus.ID, segID vanus.ID, replicas map[vanus.ID]string,
+		compression metapb.CompressAlgorithm) error
 	InactivateSegment(ctx context.Context) error
+	AddLearner(ctx context.Context, id vanus.ID, learner raft.Peer) error
+	PromoteLearner(ctx context.Context, id vanus.ID, learner vanus.ID) error
+	RemovePeer(ctx context.Context, id vanus.ID, peer vanus.ID) error
+	TransferLeader(ctx context.Context, id vanus.ID, to vanus.ID) error
 
 	AppendToBlock(ctx context.Context, id vanus.ID, events []*cepb.CloudEvent) ([]int64, error)
 	ReadFromBlock(ctx context.Context, id vanus.ID, seq int64, num int, pollingTimeout uint32) ([]*cepb.CloudEvent, error)
@@ -117,7 +124,7 @@ func NewServer(cfg store.Config) Server {
 		resolver:     resolver,
 		host:         host,
 		ctrlAddress:  cfg.ControllerAddresses,
-		credentials:  insecure.NewCredentials(),
+		credentials:  tlsconfig.ClientCredentials(),
 		leaderC:      make(chan leaderInfo, defaultLeaderInfoBufferSize),
 		closeC:       make(chan struct{}),
 		pm:           &pollingMgr{},
@@ -163,6 +170,10 @@ type server struct {
 	grpcSrv *grpc.Server
 	closeC  chan struct{}
 
+	// tiered is true if archived blocks are offloaded to object storage.
+	tiered     bool
+	offloading sync.Map // vanus.ID, struct{}
+
 	pm     pollingManager
 	tracer *tracing.Tracer
 }
@@ -177,6 +188,7 @@ func (s *server) Serve(lis net.Listener) error {
 
 	raftSrv := transport.NewServer(s.host)
 	srv := grpc.NewServer(
+		grpc.Creds(tlsconfig.ServerCredentials()),
 		grpc.InTapHandle(s.preGrpcStream),
 		grpc.ChainStreamInterceptor(
 			recovery.StreamServerInterceptor(),
@@ -249,8 +261,17 @@ func (s *server) Initialize(ctx context.Context) error {
 
 func (s *server) loadEngine(ctx context.Context) error {
 	// TODO(james.yin): how to organize engine?
+	opts := []vsb.Option{vsb.WithIdempotenceWindow(s.cfg.Idempotence.GetWindowSize())}
+	store, err := s.cfg.TieredStorage.ObjectStore()
+	if err != nil {
+		return err
+	}
+	if store != nil {
+		opts = append(opts, vsb.WithObjectStore(store))
+		s.tiered = true
+	}
 	return vsb.Initialize(filepath.Join(s.cfg.Volume.Dir, "block"),
-		block.ArchivedCallback(s.onBlockArchived))
+		block.ArchivedCallback(s.onBlockArchived), opts...)
 }
 
 func (s *server) reconcileBlocks(ctx context.Context) error {
@@ -269,6 +290,7 @@ func (s *server) registerSelf(ctx context.Context) error {
 		Address:  s.localAddress,
 		VolumeId: s.volumeID,
 		Capacity: s.cfg.Volume.Capacity,
+		Labels:   s.cfg.Volume.Labels,
 	})
 	if err != nil {
 		return err
@@ -320,6 +342,43 @@ func (s *server) reconcileSegments(ctx context.Context, segments map[uint64]*met
 			continue
 		}
 		s.registerReplicas(ctx, segment)
+		s.reconcileCompression(ctx, myID, segment.Compressed)
+		s.reconcileSegment(ctx, myID, vanus.NewIDFromUint64(segment.Id))
+	}
+}
+
+// reconcileCompression catches up the algorithm of segments which were created before the
+// replica was told.
+func (s *server) reconcileCompression(ctx context.Context, id vanus.ID, algorithm metapb.CompressAlgorithm) {
+	if algorithm == metapb.CompressAlgorithm_NONE {
+		return
+	}
+	v, ok := s.replicas.Load(id)
+	if !ok {
+		return
+	}
+	b, _ := v.(Replica)
+	if err := b.SetCompression(ctx, algorithm); err != nil {
+		log.Warning(ctx, "Set compression of block failed.", map[string]interface{}{
+			log.KeyError: err,
+			"block_id":   id,
+		})
+	}
+}
+
+// reconcileSegment binds the blocks which were created before the replica was told its segment.
+func (s *server) reconcileSegment(ctx context.Context, id vanus.ID, segID vanus.ID) {
+	v, ok := s.replicas.Load(id)
+	if !ok {
+		return
+	}
+	b, _ := v.(Replica)
+	if err := b.SetSegment(ctx, segID); err != nil {
+		log.Warning(ctx, "Set segment of block failed.", map[string]interface{}{
+			log.KeyError: err,
+			"block_id":   id,
+			"segment_id": segID,
+		})
 	}
 }
 
@@ -355,6 +414,9 @@ func (s *server) Start(ctx context.Context) error {
 	if err := s.startHeartbeatTask(ctx); err != nil {
 		return errors.ErrInternal.WithMessage("start heartbeat task failed")
 	}
+	if s.tiered {
+		s.resumeOffloading()
+	}
 
 	s.state = primitive.ServerStateRunning
 	return nil
@@ -398,17 +460,23 @@ func (s *server) runHeartbeat(_ context.Context) error {
 
 	f := func() interface{} {
 		infos := make([]*metapb.SegmentHealthInfo, 0)
+		var used uint64
 		s.replicas.Range(func(key, value interface{}) bool {
 			b, _ := value.(Replica)
-			infos = append(infos, b.Status())
+			info := b.Status()
+			infos = append(infos, info)
+			used += uint64(info.Capacity)
 			return true
 		})
 		return &ctrlpb.SegmentHeartbeatRequest{
-			ServerId:   s.id.Uint64(),
-			VolumeId:   s.volumeID,
-			HealthInfo: infos,
-			ReportTime: util.FormatTime(time.Now()),
-			ServerAddr: s.localAddress,
+			ServerId:       s.id.Uint64(),
+			VolumeId:       s.volumeID,
+			HealthInfo:     infos,
+			ReportTime:     util.FormatTime(time.Now()),
+			ServerAddr:     s.localAddress,
+			VolumeCapacity: s.cfg.Volume.Capacity,
+			VolumeUsed:     used,
+			VolumeLabels:   s.cfg.Volume.Labels,
 		}
 	}
 
@@ -563,9 +631,36 @@ func (s *server) RemoveBlock(ctx context.Context, blockID vanus.ID) error {
 // 	return nil
 // }
 
+// SetBlockCompression sets the algorithm which the block compresses with after it becomes leader,
+// so that every replica keeps compressing after the leadership changed.
+func (s *server) SetBlockCompression(ctx context.Context, id vanus.ID, algorithm metapb.CompressAlgorithm) error {
+	ctx, span := s.tracer.Start(ctx, "SetBlockCompression")
+	defer span.End()
+
+	b, err := s.loadReplica(id)
+	if err != nil {
+		return err
+	}
+	return b.SetCompression(ctx, algorithm)
+}
+
+// SetBlockSegment binds the block to segment, the replicas of segment share one object after they
+// are offloaded to object storage.
+func (s *server) SetBlockSegment(ctx context.Context, id vanus.ID, segID vanus.ID) error {
+	ctx, span := s.tracer.Start(ctx, "SetBlockSegment")
+	defer span.End()
+
+	b, err := s.loadReplica(id)
+	if err != nil {
+		return err
+	}
+	return b.SetSegment(ctx, segID)
+}
+
 // ActivateSegment mark a block ready to using and preparing to initializing a replica group.
 func (s *server) ActivateSegment(
 	ctx context.Context, logID vanus.ID, segID vanus.ID, replicas map[vanus.ID]string,
+	compression metapb.CompressAlgorithm,
 ) error {
 	ctx, span := s.tracer.Start(ctx, "ActivateSegment")
 	defer span.End()
@@ -621,8 +716,13 @@ func (s *server) ActivateSegment(
 		"peers":    peers,
 	})
 
-	// Bootstrap raft.
+	// Only the leader compresses entries, so the algorithm is set before bootstrapping.
 	b, _ := v.(Replica)
+	if err := b.SetCompression(ctx, compression); err != nil {
+		return err
+	}
+
+	// Bootstrap raft.
 	if err := b.Bootstrap(ctx, peers); err != nil {
 		return err
 	}
@@ -638,6 +738,68 @@ func (s *server) InactivateSegment(ctx context.Context) error {
 	return nil
 }
 
+// AddLearner adds the learner to the replica group which is led by block id.
+func (s *server) AddLearner(ctx context.Context, id vanus.ID, learner raft.Peer) error {
+	ctx, span := s.tracer.Start(ctx, "AddLearner")
+	defer span.End()
+
+	b, err := s.loadReplica(id)
+	if err != nil {
+		return err
+	}
+
+	s.resolver.Register(learner.ID.Uint64(), learner.Endpoint) //nolint:contextcheck // wrong advice
+	return b.AddLearner(ctx, learner)
+}
+
+// PromoteLearner makes the learner a voter of the replica group which is led by block id.
+func (s *server) PromoteLearner(ctx context.Context, id vanus.ID, learner vanus.ID) error {
+	ctx, span := s.tracer.Start(ctx, "PromoteLearner")
+	defer span.End()
+
+	b, err := s.loadReplica(id)
+	if err != nil {
+		return err
+	}
+	return b.PromoteLearner(ctx, learner)
+}
+
+// RemovePeer removes the peer from the replica group which is led by block id.
+func (s *server) RemovePeer(ctx context.Context, id vanus.ID, peer vanus.ID) error {
+	ctx, span := s.tracer.Start(ctx, "RemovePeer")
+	defer span.End()
+
+	b, err := s.loadReplica(id)
+	if err != nil {
+		return err
+	}
+	return b.RemovePeer(ctx, peer)
+}
+
+// TransferLeader hands over the leadership of the replica group which is led by block id.
+func (s *server) TransferLeader(ctx context.Context, id vanus.ID, to vanus.ID) error {
+	ctx, span := s.tracer.Start(ctx, "TransferLeader")
+	defer span.End()
+
+	b, err := s.loadReplica(id)
+	if err != nil {
+		return err
+	}
+	return b.TransferLeader(ctx, to)
+}
+
+func (s *server) loadReplica(id vanus.ID) (Replica, error) {
+	if err := s.checkState(); err != nil {
+		return nil, err
+	}
+	v, ok := s.replicas.Load(id)
+	if !ok {
+		return nil, errors.ErrResourceNotFound.WithMessage("the block doesn't exist")
+	}
+	b, _ := v.(Replica)
+	return b, nil
+}
+
 func (s *server) AppendToBlock(ctx context.Context, id vanus.ID, events []*cepb.CloudEvent) ([]int64, error) {
 	ctx, span := s.tracer.Start(ctx, "AppendToBlock")
 	defer span.End()
@@ -690,6 +852,10 @@ func (s *server) processAppendError(ctx context.Context, b Replica, err error) e
 		return errors.ErrSegmentFull
 	}
 
+	if stderr.Is(err, block.ErrIdempotenceDisabled) {
+		return errors.ErrInvalidRequest.WithMessage("the idempotence window of block is disabled").Wrap(err)
+	}
+
 	log.Warning(ctx, "Append failed.", map[string]interface{}{
 		"block_id":   b.ID(),
 		log.KeyError: err,
@@ -712,11 +878,16 @@ func (s *server) onBlockArchived(stat block.Statistics) {
 		EventNumber:        int32(stat.EntryNum),
 		IsFull:             stat.Archived,
 		FirstEventBornTime: stat.FirstEntryStime,
+		Tier:               storageTier(stat),
 	}
 	if stat.Archived {
 		info.LastEventBornTime = stat.LastEntryStime
 	}
 
+	if s.tiered {
+		go s.offloadBlock(id)
+	}
+
 	// report to controller
 	go func() {
 		_, _ = s.cc.ReportSegmentBlockIsFull(context.Background(), &ctrlpb.SegmentHeartbeatRequest{
@@ -188,3 +188,35 @@ func TestServer_ReadFromBlock(t *testing.T) {
 		})
 	})
 }
+
+func TestServer_OffloadBlock(t *testing.T) {
+	Convey("offload block", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+
+		srv := &server{
+			closeC: make(chan struct{}),
+		}
+		id := vanus.NewTestID()
+		b := NewMockReplica(ctrl)
+		srv.replicas.Store(id, b)
+
+		Convey("offloaded at once", func() {
+			b.EXPECT().Offload(Any()).Times(1).Return(true, nil)
+			srv.offloadBlock(id)
+			_, ok := srv.offloading.Load(id)
+			So(ok, ShouldBeFalse)
+		})
+
+		Convey("stop waiting for the object after the server is closed", func() {
+			b.EXPECT().Offload(Any()).Times(1).Return(false, nil)
+			close(srv.closeC)
+			srv.offloadBlock(id)
+		})
+
+		Convey("the block is removed", func() {
+			srv.replicas.Delete(id)
+			srv.offloadBlock(id)
+		})
+	})
+}
@@ -17,6 +17,7 @@ package vsb
 import (
 	// standard libraries.
 	"context"
+	"io"
 	"os"
 	"sync"
 	"sync/atomic"
@@ -27,6 +28,7 @@ import (
 	// this project.
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/internal/store/block"
+	"github.com/linkall-labs/vanus/internal/store/objstore"
 	"github.com/linkall-labs/vanus/internal/store/vsb/codec"
 	"github.com/linkall-labs/vanus/internal/store/vsb/index"
 )
@@ -41,6 +43,8 @@ type meta struct {
 	entryNum int64
 	// archived is the flag indicating Block is archived.
 	archived bool
+	// offloaded is the flag indicating the data of Block is offloaded to object storage.
+	offloaded bool
 }
 
 // vsBlock is Vanus block file.
@@ -58,13 +62,24 @@ type vsBlock struct {
 	fm      meta // flushed meta
 	actx    appendContext
 	indexes []index.Index
+	// dedup is the window of idempotence keys of committed entries, nil if disabled.
+	dedup *dedupWindow
+	// compression is the codec.Compression of the data of new entries, accessed atomically.
+	compression uint32
+	// segment is the ID of segment which the block is a replica of, accessed atomically.
+	segment uint64
 	mu      sync.RWMutex
 
 	enc codec.EntryEncoder
 	dec codec.EntryDecoder
 	lis block.ArchivedListener
 
-	f      *os.File
+	f *os.File
+	// r is the source of entries, it is f unless the block is offloaded to store.
+	r     io.ReaderAt
+	rmu   sync.RWMutex
+	store objstore.Store
+
 	wg     sync.WaitGroup
 	tracer *tracing.Tracer
 }
@@ -100,8 +115,14 @@ func (b *vsBlock) Close(ctx context.Context) error {
 	return b.f.Close()
 }
 
-func (b *vsBlock) Delete(context.Context) error {
+func (b *vsBlock) Delete(ctx context.Context) error {
 	// FIXME(james.yin): make sure block is closed.
+	// The object is shared by the other replicas, which may be still alive.
+	if b.store != nil && b.loadSegment() != 0 && !block.IsObjectKept(ctx) {
+		if err := b.store.Delete(ctx, b.objectKey()); err != nil {
+			return err
+		}
+	}
 	return os.Remove(b.path)
 }
 
@@ -114,6 +135,7 @@ func (b *vsBlock) stat(m meta, indexes []index.Index) block.Statistics {
 		ID:              b.id,
 		Capacity:        uint64(b.capacity),
 		Archived:        m.archived,
+		Offloaded:       m.offloaded,
 		EntryNum:        uint32(m.entryNum),
 		EntrySize:       uint64(m.entryLength),
 		FirstEntryStime: -1,
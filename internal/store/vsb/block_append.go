@@ -38,6 +38,8 @@ type appendContext struct {
 	seq      int64
 	offset   int64
 	archived uint32
+	// pending records the idempotence keys of entries which are prepared but may not be committed.
+	pending *dedupWindow
 }
 
 // Make sure appendContext implements block.AppendContext.
@@ -69,14 +71,23 @@ func (b *vsBlock) NewAppendContext(last block.Fragment) block.AppendContext {
 		if ceschema.EntryType(entry) == ceschema.End {
 			actx.archived = 1
 		}
+		b.adoptCompression(entry)
+		b.initPending(actx)
 		return actx
 	}
 
 	// Copy append context.
 	actx := b.actx
+	b.initPending(&actx)
 	return &actx
 }
 
+func (b *vsBlock) initPending(actx *appendContext) {
+	if b.dedup != nil {
+		actx.pending = newDedupWindow(b.dedup.size)
+	}
+}
+
 func (b *vsBlock) PrepareAppend(
 	ctx context.Context, appendCtx block.AppendContext, entries ...block.Entry,
 ) ([]int64, block.Fragment, bool, error) {
@@ -85,24 +96,49 @@ func (b *vsBlock) PrepareAppend(
 
 	actx, _ := appendCtx.(*appendContext)
 
+	if block.IsIdempotentAppend(ctx) {
+		if actx.pending == nil {
+			return nil, nil, false, block.ErrIdempotenceDisabled
+		}
+		fresh, seqs := b.dedupEntries(actx, entries)
+		if len(fresh) == 0 {
+			return seqs, nil, false, nil
+		}
+		frag, enough := b.prepareEntries(actx, fresh)
+		return seqs, frag, enough, nil
+	}
+
+	if actx.pending != nil {
+		for i, entry := range entries {
+			actx.pending.put(idempotenceKey(entry), actx.seq+int64(i))
+		}
+	}
+
+	seqs := make([]int64, len(entries))
+	for i := range seqs {
+		seqs[i] = actx.seq + int64(i)
+	}
+	frag, enough := b.prepareEntries(actx, entries)
+	return seqs, frag, enough, nil
+}
+
+func (b *vsBlock) prepareEntries(actx *appendContext, entries []block.Entry) (block.Fragment, bool) {
 	num := int64(len(entries))
 	ents := make([]block.Entry, num)
-	seqs := make([]int64, num)
 
 	// TODO(james.yin): fill auto fields in a general way.
 	now := time.Now().UnixMilli()
 	for i := int64(0); i < num; i++ {
-		seq := actx.seq + i
-		ents[i] = wrapEntry(entries[i], ceschema.CloudEvent, seq, now)
-		seqs[i] = seq
+		ents[i] = wrapEntry(entries[i], ceschema.CloudEvent, actx.seq+i, now)
 	}
+	compressEntries(ents, b.loadCompression())
 
 	frag := newFragment(actx.offset, ents, b.enc)
 
 	actx.offset += int64(frag.Size())
 	actx.seq += num
 
-	return seqs, frag, actx.size(b.dataOffset) >= b.capacity, nil
+	return frag, actx.size(b.dataOffset) >= b.capacity
 }
 
 func (b *vsBlock) PrepareArchive(ctx context.Context, appendCtx block.AppendContext) (block.Fragment, error) {
@@ -148,7 +184,7 @@ func (b *vsBlock) CommitAppend(ctx context.Context, frags ...block.Fragment) (bo
 		copy(data[frag.StartOffset()-base:], frag.Payload())
 	}
 
-	indexes, entryCount, archived, err := b.buildIndexes(ctx, base, data)
+	indexes, keys, entryCount, archived, err := b.buildIndexes(ctx, base, data)
 	if err != nil {
 		return false, err
 	}
@@ -174,6 +210,7 @@ func (b *vsBlock) CommitAppend(ctx context.Context, frags ...block.Fragment) (bo
 	)
 
 	b.indexes = append(b.indexes, indexes...)
+	b.recordKeys(keys)
 	b.actx.seq += entryCount
 	b.actx.offset += int64(entrySize)
 	if archived {
@@ -209,11 +246,14 @@ func (b *vsBlock) CommitAppend(ctx context.Context, frags ...block.Fragment) (bo
 	return archived, nil
 }
 
-func (b *vsBlock) buildIndexes(ctx context.Context, base int64, data []byte) ([]index.Index, int64, bool, error) {
+func (b *vsBlock) buildIndexes(
+	ctx context.Context, base int64, data []byte,
+) ([]index.Index, []string, int64, bool, error) {
 	_, span := b.tracer.Start(ctx, "buildIndexes")
 	defer span.End()
 
 	var archived bool
+	var keys []string
 	indexes := make([]index.Index, 0, 1)
 	expected := b.actx.seq
 	for off, sz := 0, len(data); off < sz; {
@@ -224,13 +264,13 @@ func (b *vsBlock) buildIndexes(ctx context.Context, base int64, data []byte) ([]
 		case seq < expected && len(indexes) == 0:
 			continue
 		default:
-			return nil, 0, false, errCorruptedFragment
+			return nil, nil, 0, false, errCorruptedFragment
 		}
 
 		if ceschema.EntryType(entry) == ceschema.End {
 			// End entry must be the last.
 			if off+n != sz {
-				return nil, 0, false, errCorruptedFragment
+				return nil, nil, 0, false, errCorruptedFragment
 			}
 			archived = true
 			break
@@ -238,11 +278,14 @@ func (b *vsBlock) buildIndexes(ctx context.Context, base int64, data []byte) ([]
 
 		idx := index.NewIndex(base+int64(off), int32(n), index.WithEntry(entry))
 		indexes = append(indexes, idx)
+		if b.dedup != nil {
+			keys = append(keys, idempotenceKey(entry))
+		}
 
 		off += n
 	}
 
-	return indexes, expected - b.actx.seq, archived, nil
+	return indexes, keys, expected - b.actx.seq, archived, nil
 }
 
 func (b *vsBlock) appendIndexEntry(ctx context.Context, indexes []index.Index, off int64) (int, error) {
@@ -0,0 +1,82 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"sync/atomic"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/metrics"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store/block"
+	ceschema "github.com/linkall-labs/vanus/internal/store/schema/ce"
+	"github.com/linkall-labs/vanus/internal/store/vsb/codec"
+)
+
+// Make sure vsBlock implements block.Compressor.
+var _ block.Compressor = (*vsBlock)(nil)
+
+func (b *vsBlock) SetCompression(ctx context.Context, algorithm metapb.CompressAlgorithm) error {
+	c := codec.Compression(algorithm)
+	if algorithm < 0 || !c.Valid() {
+		return errors.ErrInvalidRequest.WithMessage("unknown compress algorithm")
+	}
+	if b.loadCompression() == c {
+		return nil
+	}
+	atomic.StoreUint32(&b.compression, uint32(c))
+
+	b.mu.RLock()
+	m := b.fm
+	b.mu.RUnlock()
+	return b.persistHeader(ctx, m)
+}
+
+func (b *vsBlock) loadCompression() codec.Compression {
+	return codec.Compression(atomic.LoadUint32(&b.compression))
+}
+
+// adoptCompression keeps compressing with the algorithm of the last entry after the leader
+// of block changed, the new leader may have not been told the algorithm.
+func (b *vsBlock) adoptCompression(last block.Entry) {
+	if c := codec.CompressionOf(last); c != codec.CompressionNone {
+		atomic.StoreUint32(&b.compression, uint32(c))
+	}
+}
+
+func compressEntries(entries []block.Entry, c codec.Compression) {
+	if c == codec.CompressionNone {
+		return
+	}
+
+	var in, out int
+	for i, entry := range entries {
+		in += len(entry.GetBytes(ceschema.DataOrdinal))
+		entries[i] = codec.Compress(entry, c)
+		out += len(entries[i].GetBytes(ceschema.DataOrdinal))
+	}
+
+	if out == 0 {
+		return
+	}
+	metrics.CompressionInputByteCounterVec.WithLabelValues(c.String()).Add(float64(in))
+	metrics.CompressionOutputByteCounterVec.WithLabelValues(c.String()).Add(float64(out))
+	metrics.CompressionRatioHistogramVec.WithLabelValues(c.String()).Observe(float64(in) / float64(out))
+}
@@ -0,0 +1,103 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"bytes"
+	"context"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+
+	// first-party libraries.
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/block"
+	blktest "github.com/linkall-labs/vanus/internal/store/block/testing"
+	ceschema "github.com/linkall-labs/vanus/internal/store/schema/ce"
+	"github.com/linkall-labs/vanus/internal/store/vsb/codec"
+)
+
+func TestVSBlock_Compression(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	data := bytes.Repeat([]byte(`{"key":"value","number":12345}`), 64)
+	ent := blktest.NewMockEntryExt(ctrl)
+	ent.EXPECT().OptionalAttributeCount().AnyTimes().Return(5)
+	ent.EXPECT().GetBytes(ceschema.DataOrdinal).AnyTimes().Return(data)
+	ent.EXPECT().RangeOptionalAttributes(Any()).AnyTimes().DoAndReturn(func(cb block.OptionalAttributeCallback) {
+		cb.OnString(ceschema.IDOrdinal, "id")
+		cb.OnString(ceschema.SourceOrdinal, "source")
+		cb.OnString(ceschema.SpecVersionOrdinal, "1.0")
+		cb.OnString(ceschema.TypeOrdinal, "type")
+		cb.OnBytes(ceschema.DataOrdinal, data)
+	})
+	ent.EXPECT().ExtensionAttributeCount().AnyTimes().Return(0)
+	ent.EXPECT().RangeExtensionAttributes(Any()).AnyTimes().Return()
+
+	Convey("append compressed entries to vsb", t, func() {
+		ctx := context.Background()
+		e := &engine{dir: t.TempDir()}
+		r, err := e.Create(ctx, vanus.NewTestID(), 64*1024)
+		So(err, ShouldBeNil)
+		b, _ := r.(*vsBlock)
+
+		err = b.SetCompression(ctx, metapb.CompressAlgorithm(10))
+		So(err, ShouldNotBeNil)
+		err = b.SetCompression(ctx, metapb.CompressAlgorithm_LZ4)
+		So(err, ShouldBeNil)
+
+		actx := b.NewAppendContext(nil)
+		_, frag, _, err := b.PrepareAppend(ctx, actx, ent)
+		So(err, ShouldBeNil)
+		So(frag.Size(), ShouldBeLessThan, len(data))
+		_, err = b.CommitAppend(ctx, frag)
+		So(err, ShouldBeNil)
+
+		entries, err := b.Read(ctx, 0, 1)
+		So(err, ShouldBeNil)
+		So(entries, ShouldHaveLength, 1)
+		So(codec.CompressionOf(entries[0]), ShouldEqual, codec.CompressionLZ4)
+		So(entries[0].GetBytes(ceschema.DataOrdinal), ShouldResemble, data)
+
+		Convey("load compression from header", func() {
+			err = b.Close(ctx)
+			So(err, ShouldBeNil)
+
+			b2 := &vsBlock{path: b.path}
+			err = b2.Open(ctx)
+			So(err, ShouldBeNil)
+			So(b2.loadCompression(), ShouldEqual, codec.CompressionLZ4)
+			So(b2.Close(ctx), ShouldBeNil)
+		})
+
+		Convey("adopt compression of the last entry", func() {
+			b2 := &vsBlock{
+				actx: appendContext{offset: headerBlockSize},
+				enc:  b.enc,
+				dec:  b.dec,
+			}
+			b2.NewAppendContext(frag)
+			So(b2.loadCompression(), ShouldEqual, codec.CompressionLZ4)
+			So(b.Close(ctx), ShouldBeNil)
+		})
+	})
+}
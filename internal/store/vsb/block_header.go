@@ -26,7 +26,7 @@ import (
 
 const (
 	headerBlockSize = 4 * 1024
-	headerSize      = 4 + 4 + 4 + 4 + 4 + 1 + 1 + 2 + 8 + 8 + 4 + 2
+	headerSize      = 4 + 4 + 4 + 4 + 4 + 1 + 1 + 2 + 8 + 8 + 4 + 2 + 2 + 8
 
 	magicOffset       = 0
 	crcOffset         = 4
@@ -34,11 +34,13 @@ const (
 	breakFlagsOffset  = 12
 	dataOffsetOffset  = 16
 	stateOffset       = 20
+	compressionOffset = 21
 	indexSizeOffset   = 22
 	capacityOffset    = 24
 	entryLengthOffset = 32
 	entryNumOffset    = 40
 	indexOffsetOffset = 44
+	segmentOffset     = 48
 )
 
 var (
@@ -49,12 +51,13 @@ var (
 func (b *vsBlock) persistHeader(ctx context.Context, m meta) error {
 	var buf [headerSize]byte
 	binary.LittleEndian.PutUint32(buf[magicOffset:], FormatMagic)               // magic
-	binary.LittleEndian.PutUint32(buf[flagsOffset:], 0)                         // flags
+	binary.LittleEndian.PutUint32(buf[flagsOffset:], m.flags())                 // flags
 	binary.LittleEndian.PutUint32(buf[breakFlagsOffset:], 0)                    // break flags
 	binary.LittleEndian.PutUint32(buf[dataOffsetOffset:], uint32(b.dataOffset)) // data offset
 	if m.archived {                                                             // state
 		buf[stateOffset] = 1
 	}
+	buf[compressionOffset] = byte(b.loadCompression())                            // compression
 	binary.LittleEndian.PutUint16(buf[indexSizeOffset:], b.indexSize)             // index size
 	binary.LittleEndian.PutUint64(buf[capacityOffset:], uint64(b.capacity))       // capacity
 	binary.LittleEndian.PutUint64(buf[entryLengthOffset:], uint64(m.entryLength)) // entry length
@@ -63,6 +66,7 @@ func (b *vsBlock) persistHeader(ctx context.Context, m meta) error {
 		off := b.indexOffset - eo
 		binary.LittleEndian.PutUint16(buf[indexOffsetOffset:], uint16(off))
 	}
+	binary.LittleEndian.PutUint64(buf[segmentOffset:], b.loadSegment()) // segment id
 	crc := crc32.Checksum(buf[flagsOffset:], crc32q)
 	crc = crc32.Update(crc, crc32q, emptyHeader[headerSize:])
 	binary.LittleEndian.PutUint32(buf[crcOffset:], crc) // crc
@@ -94,12 +98,17 @@ func (b *vsBlock) loadHeader(ctx context.Context) error {
 		return errIncomplete
 	}
 
+	flags := binary.LittleEndian.Uint32(buf[flagsOffset:])
+	b.fm.offloaded = flags&flagOffloaded != 0
+
 	b.dataOffset = int64(binary.LittleEndian.Uint32(buf[dataOffsetOffset:]))      // data offset
 	b.fm.archived = buf[stateOffset] != 0                                         // state
+	b.compression = uint32(buf[compressionOffset])                                // compression
 	b.indexSize = binary.LittleEndian.Uint16(buf[indexSizeOffset:])               // index size
 	b.capacity = int64(binary.LittleEndian.Uint64(buf[capacityOffset:]))          // capacity
 	b.fm.entryLength = int64(binary.LittleEndian.Uint64(buf[entryLengthOffset:])) // entry length
 	b.fm.entryNum = int64(binary.LittleEndian.Uint32(buf[entryNumOffset:]))       // entry number
+	b.segment = binary.LittleEndian.Uint64(buf[segmentOffset:])                   // segment id
 
 	origin := binary.LittleEndian.Uint32(buf[crcOffset:])
 	crc := crc32.Checksum(buf[flagsOffset:], crc32q)
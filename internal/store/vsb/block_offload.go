@@ -0,0 +1,147 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	stderr "errors"
+	"io"
+	"sync/atomic"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/pkg/errors"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/block"
+	"github.com/linkall-labs/vanus/internal/store/objstore"
+)
+
+const flagOffloaded = uint32(1)
+
+// Make sure vsBlock implements block.Offloader.
+var _ block.Offloader = (*vsBlock)(nil)
+
+var errNoObjectStore = stderr.New("vsb: block is offloaded, but object storage is not configured")
+
+func (m meta) flags() uint32 {
+	var flags uint32
+	if m.offloaded {
+		flags |= flagOffloaded
+	}
+	return flags
+}
+
+// objectKey is scoped to the segment, so that the replicas of segment share one object.
+func (b *vsBlock) objectKey() string {
+	return vanus.NewIDFromUint64(b.loadSegment()).String() + vsbExt
+}
+
+func (b *vsBlock) loadSegment() uint64 {
+	return atomic.LoadUint64(&b.segment)
+}
+
+func (b *vsBlock) SetSegment(ctx context.Context, id vanus.ID) error {
+	if b.loadSegment() == id.Uint64() {
+		return nil
+	}
+
+	b.mu.RLock()
+	m := b.fm
+	b.mu.RUnlock()
+	if m.offloaded {
+		return errors.ErrInvalidRequest.WithMessage("the block has been offloaded")
+	}
+
+	atomic.StoreUint64(&b.segment, id.Uint64())
+	return b.persistHeader(ctx, m)
+}
+
+func (b *vsBlock) readAt(p []byte, off int64) (int, error) {
+	b.rmu.RLock()
+	defer b.rmu.RUnlock()
+	return b.r.ReadAt(p, off)
+}
+
+// openOffloaded switches the source of entries to object storage when opening an offloaded block.
+func (b *vsBlock) openOffloaded(ctx context.Context) error {
+	if b.store == nil {
+		return errNoObjectStore
+	}
+
+	b.r = objstore.NewReaderAt(context.Background(), b.store, b.objectKey())
+
+	// Finish the eviction interrupted by crash.
+	fi, err := b.f.Stat()
+	if err != nil {
+		return err
+	}
+	if fi.Size() > headerBlockSize {
+		return b.f.Truncate(headerBlockSize)
+	}
+	return nil
+}
+
+// Offload moves the data of archived block to object storage, and evicts it from local disk. The
+// header is kept locally with the offloaded flag, the entries are read from object storage later.
+// Only the replica which is told to upload puts the object, the others wait for the object, it
+// returns false if the block can't be offloaded for now.
+func (b *vsBlock) Offload(ctx context.Context, upload bool) (bool, error) {
+	if b.store == nil {
+		return false, errNoObjectStore
+	}
+
+	b.mu.RLock()
+	m := b.fm
+	b.mu.RUnlock()
+
+	if m.offloaded {
+		return true, nil
+	}
+	if !m.archived || b.loadSegment() == 0 {
+		return false, nil
+	}
+	// The index entry has not been written.
+	if b.indexLength == 0 || b.indexOffset != m.writeOffset {
+		return false, nil
+	}
+
+	key := b.objectKey()
+	size := b.indexOffset + int64(b.indexLength)
+	n, err := b.store.Size(ctx, key)
+	if err != nil && !stderr.Is(err, objstore.ErrNotFound) {
+		return false, err
+	}
+	// The object isn't uploaded, or is uploaded partially.
+	if err != nil || n != size {
+		if !upload {
+			return false, nil
+		}
+		if err = b.store.Put(ctx, key, io.NewSectionReader(b.f, 0, size), size); err != nil {
+			return false, err
+		}
+	}
+
+	m.offloaded = true
+	if err = b.persistHeader(ctx, m); err != nil {
+		return false, err
+	}
+
+	b.rmu.Lock()
+	defer b.rmu.Unlock()
+	b.r = objstore.NewReaderAt(context.Background(), b.store, key)
+	return true, b.f.Truncate(headerBlockSize)
+}
@@ -0,0 +1,177 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/block"
+	blktest "github.com/linkall-labs/vanus/internal/store/block/testing"
+	"github.com/linkall-labs/vanus/internal/store/objstore"
+	ceschema "github.com/linkall-labs/vanus/internal/store/schema/ce"
+)
+
+func TestVSBlock_Offload(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	data := []byte("offloaded data")
+	ent := blktest.NewMockEntryExt(ctrl)
+	ent.EXPECT().OptionalAttributeCount().AnyTimes().Return(5)
+	ent.EXPECT().GetBytes(ceschema.DataOrdinal).AnyTimes().Return(data)
+	ent.EXPECT().RangeOptionalAttributes(Any()).AnyTimes().DoAndReturn(func(cb block.OptionalAttributeCallback) {
+		cb.OnString(ceschema.IDOrdinal, "id")
+		cb.OnString(ceschema.SourceOrdinal, "source")
+		cb.OnString(ceschema.SpecVersionOrdinal, "1.0")
+		cb.OnString(ceschema.TypeOrdinal, "type")
+		cb.OnBytes(ceschema.DataOrdinal, data)
+	})
+	ent.EXPECT().ExtensionAttributeCount().AnyTimes().Return(0)
+	ent.EXPECT().RangeExtensionAttributes(Any()).AnyTimes().Return()
+
+	archive := func(ctx context.Context, b *vsBlock) {
+		actx := b.NewAppendContext(nil)
+		_, frag0, _, err := b.PrepareAppend(ctx, actx, ent, ent)
+		So(err, ShouldBeNil)
+		frag1, err := b.PrepareArchive(ctx, actx)
+		So(err, ShouldBeNil)
+		archived, err := b.CommitAppend(ctx, frag0, frag1)
+		So(err, ShouldBeNil)
+		So(archived, ShouldBeTrue)
+		b.wg.Wait()
+	}
+
+	checkEntries := func(ctx context.Context, b *vsBlock) {
+		entries, err := b.Read(ctx, 0, 3)
+		So(err, ShouldBeNil)
+		So(entries, ShouldHaveLength, 2)
+		for _, entry := range entries {
+			So(entry.GetBytes(ceschema.DataOrdinal), ShouldResemble, data)
+		}
+	}
+
+	Convey("offload archived vsb to object storage", t, func() {
+		ctx := context.Background()
+		dir := t.TempDir()
+		store, err := objstore.NewFileStore(filepath.Join(dir, "objects"))
+		So(err, ShouldBeNil)
+		e := &engine{dir: dir, store: store}
+		id := vanus.NewTestID()
+
+		r, err := e.Create(ctx, id, 64*1024)
+		So(err, ShouldBeNil)
+		b, _ := r.(*vsBlock)
+		archive(ctx, b)
+
+		// The block isn't bound to segment.
+		offloaded, err := b.Offload(ctx, true)
+		So(err, ShouldBeNil)
+		So(offloaded, ShouldBeFalse)
+
+		segID := vanus.NewTestID()
+		So(b.SetSegment(ctx, segID), ShouldBeNil)
+		offloaded, err = b.Offload(ctx, true)
+		So(err, ShouldBeNil)
+		So(offloaded, ShouldBeTrue)
+
+		So(b.status().Offloaded, ShouldBeTrue)
+		fi, err := os.Stat(b.path)
+		So(err, ShouldBeNil)
+		So(fi.Size(), ShouldEqual, headerBlockSize)
+		So(b.objectKey(), ShouldEqual, segID.String()+vsbExt)
+		_, err = os.Stat(filepath.Join(dir, "objects", b.objectKey()))
+		So(err, ShouldBeNil)
+		checkEntries(ctx, b)
+		So(b.SetSegment(ctx, vanus.NewTestID()), ShouldNotBeNil)
+		So(b.Close(ctx), ShouldBeNil)
+
+		Convey("reopen offloaded vsb", func() {
+			r, err := e.Open(ctx, id)
+			So(err, ShouldBeNil)
+			b, _ := r.(*vsBlock)
+			So(b.status().Offloaded, ShouldBeTrue)
+			So(b.status().Archived, ShouldBeTrue)
+			So(b.loadSegment(), ShouldEqual, segID.Uint64())
+			checkEntries(ctx, b)
+			So(b.Close(ctx), ShouldBeNil)
+
+			So(b.Delete(block.WithObjectKept(ctx)), ShouldBeNil)
+			_, err = store.Size(ctx, b.objectKey())
+			So(err, ShouldBeNil)
+			So(b.Delete(ctx), ShouldNotBeNil) // the file has been removed.
+			_, err = store.Size(ctx, b.objectKey())
+			So(err, ShouldEqual, objstore.ErrNotFound)
+		})
+
+		Convey("open offloaded vsb without object storage", func() {
+			e2 := &engine{dir: dir}
+			_, err := e2.Open(ctx, id)
+			So(err, ShouldEqual, errNoObjectStore)
+		})
+	})
+
+	Convey("replicas of segment share one object", t, func() {
+		ctx := context.Background()
+		dir := t.TempDir()
+		store, err := objstore.NewFileStore(filepath.Join(dir, "objects"))
+		So(err, ShouldBeNil)
+		segID := vanus.NewTestID()
+
+		newReplica := func(name string) *vsBlock {
+			e := &engine{dir: filepath.Join(dir, name), store: store}
+			So(os.MkdirAll(e.dir, 0o755), ShouldBeNil)
+			r, err := e.Create(ctx, vanus.NewTestID(), 64*1024)
+			So(err, ShouldBeNil)
+			b, _ := r.(*vsBlock)
+			So(b.SetSegment(ctx, segID), ShouldBeNil)
+			archive(ctx, b)
+			return b
+		}
+		leader := newReplica("leader")
+		follower := newReplica("follower")
+
+		// The follower waits for the leader to upload.
+		offloaded, err := follower.Offload(ctx, false)
+		So(err, ShouldBeNil)
+		So(offloaded, ShouldBeFalse)
+		So(follower.status().Offloaded, ShouldBeFalse)
+
+		offloaded, err = leader.Offload(ctx, true)
+		So(err, ShouldBeNil)
+		So(offloaded, ShouldBeTrue)
+
+		offloaded, err = follower.Offload(ctx, false)
+		So(err, ShouldBeNil)
+		So(offloaded, ShouldBeTrue)
+		fi, err := os.Stat(follower.path)
+		So(err, ShouldBeNil)
+		So(fi.Size(), ShouldEqual, headerBlockSize)
+		So(follower.objectKey(), ShouldEqual, leader.objectKey())
+		checkEntries(ctx, follower)
+
+		So(leader.Close(ctx), ShouldBeNil)
+		So(follower.Close(ctx), ShouldBeNil)
+	})
+}
@@ -48,12 +48,14 @@ func (b *vsBlock) Open(ctx context.Context) error {
 		return err
 	}
 	b.f = f
+	b.r = f
 
 	if err = b.init(ctx); err != nil {
 		if err2 := f.Close(); err2 != nil {
 			return errors.Chain(err, err2)
 		}
 		b.f = nil
+		b.r = nil
 		return err
 	}
 
@@ -65,6 +67,12 @@ func (b *vsBlock) init(ctx context.Context) error {
 		return err
 	}
 
+	if b.fm.offloaded {
+		if err := b.openOffloaded(ctx); err != nil {
+			return err
+		}
+	}
+
 	b.enc = codec.NewEncoder()
 	if dec, err := codec.NewDecoder(false, int(b.indexSize)); err == nil {
 		b.dec = dec
@@ -80,7 +88,7 @@ func (b *vsBlock) init(ctx context.Context) error {
 		return err
 	}
 
-	return nil
+	return b.rebuildDedupWindow()
 }
 
 func (b *vsBlock) repairMeta() error {
@@ -95,7 +103,7 @@ func (b *vsBlock) repairMeta() error {
 	// Scan entries.
 	indexes := make([]index.Index, 0)
 	// Note: use math.MaxInt64-off to avoid overflow.
-	r := io.NewSectionReader(b.f, off, math.MaxInt64-off)
+	r := io.NewSectionReader(b.r, off, math.MaxInt64-off)
 	if full {
 		n, entry, err = b.dec.UnmarshalReader(r)
 		if err != nil || ceschema.EntryType(entry) != ceschema.End {
@@ -172,7 +180,7 @@ func (b *vsBlock) rebuildIndexes(num int, tail []index.Index) error {
 
 	// Scan entries.
 	off := b.dataOffset
-	r := io.NewSectionReader(b.f, off, b.fm.entryLength)
+	r := io.NewSectionReader(b.r, off, b.fm.entryLength)
 	for {
 		n, entry, err := b.dec.UnmarshalReader(r)
 		if err != nil {
@@ -38,7 +38,7 @@ func (b *vsBlock) Read(ctx context.Context, seq int64, num int) ([]block.Entry,
 
 	length := int(to - from)
 	data := make([]byte, length)
-	if _, err = b.f.ReadAt(data, from); err != nil {
+	if _, err = b.readAt(data, from); err != nil {
 		return nil, err
 	}
 
@@ -67,6 +67,7 @@ func TestVSBlock_Read(t *testing.T) {
 			indexes: []index.Index{idx0, idx1},
 			dec:     dec,
 			f:       f,
+			r:       f,
 		}
 
 		entries, err := b.Read(context.Background(), 0, 1)
@@ -32,7 +32,9 @@ var _ block.Snapshoter = (*vsBlock)(nil)
 func (b *vsBlock) makeSnapshot() (meta, []index.Index) {
 	b.mu.RLock()
 	defer b.mu.RUnlock()
-	return makeSnapshot(b.actx, b.indexes)
+	m, indexes := makeSnapshot(b.actx, b.indexes)
+	m.offloaded = b.fm.offloaded
+	return m, indexes
 }
 
 func makeSnapshot(actx appendContext, indexes []index.Index) (meta, []index.Index) {
@@ -59,7 +61,7 @@ func (b *vsBlock) Snapshot(ctx context.Context) (block.Fragment, error) {
 	data := make([]byte, m.writeOffset-b.dataOffset+8)
 	binary.LittleEndian.PutUint64(data, uint64(b.dataOffset))
 
-	if _, err := b.f.ReadAt(data[8:], b.dataOffset); err != nil {
+	if _, err := b.readAt(data[8:], b.dataOffset); err != nil {
 		return nil, err
 	}
 
@@ -97,6 +99,9 @@ func (b *vsBlock) ApplySnapshot(ctx context.Context, snap block.Fragment) error
 
 		idx := index.NewIndex(off, int32(n), index.WithEntry(entry))
 		b.indexes = append(b.indexes, idx)
+		if b.dedup != nil {
+			b.dedup.put(idempotenceKey(entry), int64(len(b.indexes)-1))
+		}
 
 		off += int64(n)
 	}
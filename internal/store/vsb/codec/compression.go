@@ -0,0 +1,259 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	// standard libraries.
+	"encoding/binary"
+	"errors"
+	"sync"
+
+	// third-party libraries.
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store/block"
+	ceschema "github.com/linkall-labs/vanus/internal/store/schema/ce"
+)
+
+// Compression is the algorithm which compresses the data of CloudEvent entries, the values
+// are the same as meta.CompressAlgorithm.
+type Compression uint8
+
+const (
+	CompressionNone Compression = iota
+	CompressionLZ4
+	CompressionZSTD
+)
+
+const (
+	// Record types of compressed CloudEvent entries, records written by old versions are
+	// always uncompressed, so they can be read as before.
+	cloudEventLZ4  uint16 = 0x3463 // ASCII of "c4" in little endian
+	cloudEventZSTD uint16 = 0x7a63 // ASCII of "cz" in little endian
+
+	lz4SizePrefix = 4
+	// maxDecompressedSize bounds the size of decompressed data, it's far larger than the data of
+	// an entry, which is limited by the message size of gRPC. The data larger than it isn't
+	// compressed.
+	maxDecompressedSize = 64 * 1024 * 1024
+)
+
+var ErrUnknownCompression = errors.New("vsb.codec: unknown compression")
+
+var (
+	zstdOnce sync.Once
+	zstdEnc  *zstd.Encoder
+	zstdDec  *zstd.Decoder
+)
+
+func (c Compression) String() string {
+	switch c {
+	case CompressionNone:
+		return "none"
+	case CompressionLZ4:
+		return "lz4"
+	case CompressionZSTD:
+		return "zstd"
+	}
+	return "unknown"
+}
+
+func (c Compression) Valid() bool {
+	return c <= CompressionZSTD
+}
+
+type compressedEntry struct {
+	block.EntryExtWrapper
+	compression Compression
+	data        []byte
+}
+
+// Make sure compressedEntry implements block.EntryExt.
+var _ block.EntryExt = (*compressedEntry)(nil)
+
+func (e *compressedEntry) GetBytes(ordinal int) []byte {
+	if ordinal == ceschema.DataOrdinal {
+		return e.data
+	}
+	return e.EntryExtWrapper.GetBytes(ordinal)
+}
+
+func (e *compressedEntry) RangeOptionalAttributes(cb block.OptionalAttributeCallback) {
+	e.EntryExtWrapper.RangeOptionalAttributes(&compressedDataCallback{
+		OptionalAttributeCallback: cb,
+		data:                      e.data,
+	})
+}
+
+// compressedDataCallback replaces the data attribute with the compressed one.
+type compressedDataCallback struct {
+	block.OptionalAttributeCallback
+	data []byte
+}
+
+func (cb *compressedDataCallback) OnBytes(ordinal int, val []byte) {
+	if ordinal == ceschema.DataOrdinal {
+		val = cb.data
+	}
+	cb.OptionalAttributeCallback.OnBytes(ordinal, val)
+}
+
+func (cb *compressedDataCallback) OnString(ordinal int, val string) {
+	if ordinal == ceschema.DataOrdinal {
+		cb.OptionalAttributeCallback.OnBytes(ordinal, cb.data)
+		return
+	}
+	cb.OptionalAttributeCallback.OnString(ordinal, val)
+}
+
+// Compress replaces the data of the CloudEvent entry with the compressed one. The entry is
+// returned as is if it can't be compressed or the compressed data isn't smaller.
+func Compress(entry block.Entry, c Compression) block.Entry {
+	if c == CompressionNone || ceschema.EntryType(entry) != ceschema.CloudEvent {
+		return entry
+	}
+	ext, ok := entry.(block.EntryExt)
+	if !ok {
+		return entry
+	}
+	data := ext.GetBytes(ceschema.DataOrdinal)
+	if len(data) == 0 {
+		return entry
+	}
+	compressed, ok := compress(c, data)
+	if !ok {
+		return entry
+	}
+	return &compressedEntry{
+		EntryExtWrapper: block.EntryExtWrapper{E: ext},
+		compression:     c,
+		data:            compressed,
+	}
+}
+
+// CompressionOf returns the algorithm which the data of entry is compressed with.
+func CompressionOf(entry block.Entry) Compression {
+	switch e := entry.(type) {
+	case *compressedEntry:
+		return e.compression
+	case *decompressingEntry:
+		return e.compression
+	}
+	return CompressionNone
+}
+
+// decompressingEntry decompresses the data of a decoded CloudEvent entry on the first access.
+type decompressingEntry struct {
+	block.Entry
+	compression Compression
+	once        sync.Once
+	data        []byte
+}
+
+// Make sure decompressingEntry implements block.Entry.
+var _ block.Entry = (*decompressingEntry)(nil)
+
+// GetBytes returns nil as data if the compressed data is corrupted.
+func (e *decompressingEntry) GetBytes(ordinal int) []byte {
+	if ordinal != ceschema.DataOrdinal {
+		return e.Entry.GetBytes(ordinal)
+	}
+	e.once.Do(func() {
+		e.data, _ = decompress(e.compression, e.Entry.GetBytes(ordinal))
+	})
+	return e.data
+}
+
+func recordType(t uint16, c Compression) uint16 {
+	if t != ceschema.CloudEvent {
+		return t
+	}
+	switch c {
+	case CompressionLZ4:
+		return cloudEventLZ4
+	case CompressionZSTD:
+		return cloudEventZSTD
+	}
+	return t
+}
+
+func entryType(t uint16) (uint16, Compression) {
+	switch t {
+	case cloudEventLZ4:
+		return ceschema.CloudEvent, CompressionLZ4
+	case cloudEventZSTD:
+		return ceschema.CloudEvent, CompressionZSTD
+	}
+	return t, CompressionNone
+}
+
+func compress(c Compression, src []byte) ([]byte, bool) {
+	if len(src) > maxDecompressedSize {
+		return nil, false
+	}
+	switch c {
+	case CompressionLZ4:
+		// The buffer is smaller than the bound, so lz4 gives up if the data is incompressible.
+		buf := make([]byte, lz4SizePrefix+len(src)-1)
+		n, err := lz4.CompressBlock(src, buf[lz4SizePrefix:], nil)
+		if err != nil || n == 0 || lz4SizePrefix+n >= len(src) {
+			return nil, false
+		}
+		binary.LittleEndian.PutUint32(buf, uint32(len(src)))
+		return buf[:lz4SizePrefix+n], true
+	case CompressionZSTD:
+		initZSTD()
+		buf := zstdEnc.EncodeAll(src, make([]byte, 0, len(src)))
+		if len(buf) >= len(src) {
+			return nil, false
+		}
+		return buf, true
+	}
+	return nil, false
+}
+
+func decompress(c Compression, src []byte) ([]byte, error) {
+	switch c {
+	case CompressionNone:
+		return src, nil
+	case CompressionLZ4:
+		if len(src) < lz4SizePrefix {
+			return nil, ErrCorruptedRecord
+		}
+		size := binary.LittleEndian.Uint32(src)
+		if size > maxDecompressedSize {
+			return nil, ErrCorruptedRecord
+		}
+		buf := make([]byte, size)
+		n, err := lz4.UncompressBlock(src[lz4SizePrefix:], buf)
+		if err != nil {
+			return nil, err
+		}
+		return buf[:n], nil
+	case CompressionZSTD:
+		initZSTD()
+		return zstdDec.DecodeAll(src, nil)
+	}
+	return nil, ErrUnknownCompression
+}
+
+func initZSTD() {
+	zstdOnce.Do(func() {
+		zstdEnc, _ = zstd.NewWriter(nil)
+		zstdDec, _ = zstd.NewReader(nil, zstd.WithDecoderMaxMemory(maxDecompressedSize))
+	})
+}
@@ -0,0 +1,109 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	// standard libraries.
+	"bytes"
+	"context"
+	"encoding/binary"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store/block"
+	blktest "github.com/linkall-labs/vanus/internal/store/block/testing"
+	ceschema "github.com/linkall-labs/vanus/internal/store/schema/ce"
+)
+
+func makeDataEntry(ctrl *Controller, data []byte) block.EntryExt {
+	entry := blktest.NewMockEntryExt(ctrl)
+	entry.EXPECT().OptionalAttributeCount().AnyTimes().Return(7)
+	entry.EXPECT().GetUint16(ceschema.EntryTypeOrdinal).AnyTimes().Return(ceschema.CloudEvent)
+	entry.EXPECT().GetBytes(ceschema.DataOrdinal).AnyTimes().Return(data)
+	entry.EXPECT().RangeOptionalAttributes(Any()).AnyTimes().DoAndReturn(func(cb block.OptionalAttributeCallback) {
+		cb.OnInt64(ceschema.SequenceNumberOrdinal, 1)
+		cb.OnInt64(ceschema.StimeOrdinal, 1)
+		cb.OnString(ceschema.IDOrdinal, "id")
+		cb.OnString(ceschema.SourceOrdinal, "source")
+		cb.OnString(ceschema.SpecVersionOrdinal, "1.0")
+		cb.OnString(ceschema.TypeOrdinal, "type")
+		cb.OnBytes(ceschema.DataOrdinal, data)
+	})
+	entry.EXPECT().ExtensionAttributeCount().AnyTimes().Return(0)
+	entry.EXPECT().RangeExtensionAttributes(Any()).AnyTimes().Return()
+	return entry
+}
+
+func TestCompress(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	data := bytes.Repeat([]byte(`{"key":"value","number":12345}`), 64)
+	entry := makeDataEntry(ctrl, data)
+
+	enc := NewEncoder()
+	dec, _ := NewDecoder(true, IndexSize)
+
+	Convey("compress entry", t, func() {
+		for _, c := range []Compression{CompressionLZ4, CompressionZSTD} {
+			compressed := Compress(entry, c)
+			So(CompressionOf(compressed), ShouldEqual, c)
+			So(len(compressed.GetBytes(ceschema.DataOrdinal)), ShouldBeLessThan, len(data))
+
+			sz := enc.Size(compressed)
+			So(sz, ShouldBeLessThan, enc.Size(entry))
+			buf := make([]byte, sz)
+			n, err := enc.MarshalTo(context.Background(), compressed, buf)
+			So(err, ShouldBeNil)
+			So(n, ShouldEqual, sz)
+			So(binary.LittleEndian.Uint16(buf[packetPayloadOffset:]), ShouldEqual, recordType(ceschema.CloudEvent, c))
+
+			n, decoded, err := dec.Unmarshal(buf)
+			So(err, ShouldBeNil)
+			So(n, ShouldEqual, sz)
+			So(CompressionOf(decoded), ShouldEqual, c)
+			So(ceschema.EntryType(decoded), ShouldEqual, ceschema.CloudEvent)
+			So(decoded.GetString(ceschema.IDOrdinal), ShouldEqual, "id")
+			So(decoded.GetBytes(ceschema.DataOrdinal), ShouldResemble, data)
+		}
+	})
+
+	Convey("skip incompressible entry", t, func() {
+		small := makeDataEntry(ctrl, []byte("{}"))
+		So(Compress(small, CompressionLZ4), ShouldEqual, small)
+		So(Compress(small, CompressionZSTD), ShouldEqual, small)
+		So(Compress(entry, CompressionNone), ShouldEqual, entry)
+	})
+
+	Convey("decompress corrupted data", t, func() {
+		_, err := decompress(CompressionLZ4, []byte{0xff})
+		So(err, ShouldNotBeNil)
+		_, err = decompress(CompressionZSTD, []byte("not zstd"))
+		So(err, ShouldNotBeNil)
+		_, err = decompress(Compression(9), data)
+		So(err, ShouldEqual, ErrUnknownCompression)
+	})
+
+	Convey("decompress data with invalid size prefix", t, func() {
+		_, err := decompress(CompressionLZ4, []byte{0x01, 0x02, 0x03})
+		So(err, ShouldEqual, ErrCorruptedRecord)
+		_, err = decompress(CompressionLZ4, []byte{0xff, 0xff, 0xff, 0xff, 0x00})
+		So(err, ShouldEqual, ErrCorruptedRecord)
+	})
+}
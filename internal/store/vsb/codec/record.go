@@ -55,7 +55,8 @@ func (e *recordEncoder) MarshalTo(entry block.Entry, buf []byte) (int, error) {
 	if err != nil {
 		return -1, err
 	}
-	binary.LittleEndian.PutUint16(buf[recordTypeOffset:], ceschema.EntryType(entry))
+	t := recordType(ceschema.EntryType(entry), CompressionOf(entry))
+	binary.LittleEndian.PutUint16(buf[recordTypeOffset:], t)
 	binary.LittleEndian.PutUint16(buf[recordOffsetOffset:], recordPayloadOffset+uint16(headerSize))
 	return recordPayloadOffset + n, nil
 }
@@ -72,10 +73,14 @@ type recordDecoder struct {
 var _ PacketDataDecoder = (*recordDecoder)(nil)
 
 func (d *recordDecoder) Unmarshal(data []byte) (block.Entry, error) {
-	t := binary.LittleEndian.Uint16(data)
+	t, c := entryType(binary.LittleEndian.Uint16(data))
 	offset := binary.LittleEndian.Uint16(data[recordOffsetOffset:])
 	if offset < recordPayloadOffset {
 		return nil, ErrCorruptedRecord
 	}
-	return d.rdd.Unmarshal(t, int(offset)-recordPayloadOffset, data[recordPayloadOffset:])
+	entry, err := d.rdd.Unmarshal(t, int(offset)-recordPayloadOffset, data[recordPayloadOffset:])
+	if err != nil || c == CompressionNone {
+		return entry, err
+	}
+	return &decompressingEntry{Entry: entry, compression: c}, nil
 }
@@ -0,0 +1,166 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/store/block"
+	ceschema "github.com/linkall-labs/vanus/internal/store/schema/ce"
+)
+
+var (
+	producerIDAttr  = []byte(primitive.XVanusProducerID)
+	producerSeqAttr = []byte(primitive.XVanusProducerSeq)
+)
+
+type dedupRecord struct {
+	key string
+	seq int64
+}
+
+// dedupWindow remembers the sequence numbers of the latest entries by their idempotence keys.
+// The oldest record is evicted when the window is full.
+type dedupWindow struct {
+	size    int
+	seqs    map[string]int64
+	records []dedupRecord
+	next    int
+}
+
+func newDedupWindow(size int) *dedupWindow {
+	return &dedupWindow{
+		size:    size,
+		seqs:    make(map[string]int64, size),
+		records: make([]dedupRecord, 0, size),
+	}
+}
+
+func (w *dedupWindow) get(key string) (int64, bool) {
+	seq, ok := w.seqs[key]
+	return seq, ok
+}
+
+func (w *dedupWindow) put(key string, seq int64) {
+	if w.size <= 0 {
+		return
+	}
+
+	r := dedupRecord{key: key, seq: seq}
+	if len(w.records) < w.size {
+		w.records = append(w.records, r)
+	} else {
+		old := w.records[w.next]
+		// The key may be re-put after old record, keep the latest one.
+		if s, ok := w.seqs[old.key]; ok && s == old.seq {
+			delete(w.seqs, old.key)
+		}
+		w.records[w.next] = r
+		w.next = (w.next + 1) % w.size
+	}
+	w.seqs[key] = seq
+}
+
+// idempotenceKey returns the key to detect duplicated entries. The pair of producer id and
+// producer sequence is preferred, otherwise source and id of the CloudEvent are used.
+func idempotenceKey(entry block.Entry) string {
+	if producer := entry.GetExtensionAttribute(producerIDAttr); len(producer) != 0 {
+		return "p" + string(producer) + "\x00" + string(entry.GetExtensionAttribute(producerSeqAttr))
+	}
+	return "e" + entry.GetString(ceschema.SourceOrdinal) + "\x00" + entry.GetString(ceschema.IDOrdinal)
+}
+
+// recordKeys records the idempotence keys of the latest committed entries. Caller must hold b.mu.
+func (b *vsBlock) recordKeys(keys []string) {
+	if b.dedup == nil {
+		return
+	}
+	base := int64(len(b.indexes) - len(keys))
+	for i, key := range keys {
+		b.dedup.put(key, base+int64(i))
+	}
+}
+
+// rebuildDedupWindow loads the idempotence keys of the latest entries after opening.
+func (b *vsBlock) rebuildDedupWindow() error {
+	if b.dedup == nil {
+		return nil
+	}
+
+	sz := len(b.indexes)
+	start := sz - b.dedup.size
+	if start < 0 {
+		start = 0
+	}
+	if start == sz {
+		return nil
+	}
+
+	from, to := b.indexes[start].StartOffset(), b.indexes[sz-1].EndOffset()
+	data := make([]byte, to-from)
+	if _, err := b.readAt(data, from); err != nil {
+		return err
+	}
+
+	seq := int64(start)
+	for so := 0; so < len(data); seq++ {
+		n, entry, _ := b.dec.Unmarshal(data[so:])
+		b.dedup.put(idempotenceKey(entry), seq)
+		so += n
+	}
+	return nil
+}
+
+// dedupEntries finds the entries which have been appended recently, and returns their original
+// sequence numbers. The new entries are recorded into the pending window of appendContext.
+func (b *vsBlock) dedupEntries(actx *appendContext, entries []block.Entry) ([]block.Entry, []int64) {
+	seqs := make([]int64, len(entries))
+	fresh := make([]block.Entry, 0, len(entries))
+	keys := make([]string, len(entries))
+
+	b.mu.RLock()
+	for i, entry := range entries {
+		key := idempotenceKey(entry)
+		keys[i] = key
+		if seq, ok := actx.pending.get(key); ok {
+			seqs[i] = seq
+			continue
+		}
+		if seq, ok := b.dedup.get(key); ok {
+			seqs[i] = seq
+			continue
+		}
+		seqs[i] = -1
+	}
+	b.mu.RUnlock()
+
+	next := actx.seq
+	for i, entry := range entries {
+		if seqs[i] >= 0 {
+			continue
+		}
+		// Duplicated in the same batch.
+		if seq, ok := actx.pending.get(keys[i]); ok {
+			seqs[i] = seq
+			continue
+		}
+		seqs[i] = next
+		actx.pending.put(keys[i], next)
+		fresh = append(fresh, entry)
+		next++
+	}
+
+	return fresh, seqs
+}
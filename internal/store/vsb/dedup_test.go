@@ -0,0 +1,175 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"os"
+	"testing"
+
+	// third-party libraries.
+	cepb "cloudevents.io/genproto/v1"
+	. "github.com/smartystreets/goconvey/convey"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/block"
+	ceconv "github.com/linkall-labs/vanus/internal/store/schema/ce/convert"
+)
+
+func makeDedupEntry(id string, exts ...string) block.Entry {
+	event := &cepb.CloudEvent{
+		Id:          id,
+		Source:      "vanus-test",
+		SpecVersion: "1.0",
+		Type:        "test",
+		Attributes:  map[string]*cepb.CloudEventAttributeValue{},
+	}
+	for i := 0; i+1 < len(exts); i += 2 {
+		event.Attributes[exts[i]] = &cepb.CloudEventAttributeValue{
+			Attr: &cepb.CloudEventAttributeValue_CeString{CeString: exts[i+1]},
+		}
+	}
+	return ceconv.ToEntry(event)
+}
+
+func TestDedupWindow(t *testing.T) {
+	Convey("dedup window", t, func() {
+		w := newDedupWindow(2)
+		w.put("a", 0)
+		w.put("b", 1)
+		seq, ok := w.get("a")
+		So(ok, ShouldBeTrue)
+		So(seq, ShouldEqual, 0)
+
+		w.put("c", 2)
+		_, ok = w.get("a")
+		So(ok, ShouldBeFalse)
+
+		// Re-put key is kept when its older record is evicted.
+		w.put("b", 3)
+		seq, ok = w.get("b")
+		So(ok, ShouldBeTrue)
+		So(seq, ShouldEqual, 3)
+		w.put("d", 4)
+		seq, ok = w.get("b")
+		So(ok, ShouldBeTrue)
+		So(seq, ShouldEqual, 3)
+		_, ok = w.get("c")
+		So(ok, ShouldBeFalse)
+	})
+
+	Convey("idempotence key", t, func() {
+		So(idempotenceKey(makeDedupEntry("1")), ShouldEqual, idempotenceKey(makeDedupEntry("1")))
+		So(idempotenceKey(makeDedupEntry("1")), ShouldNotEqual, idempotenceKey(makeDedupEntry("2")))
+
+		p1 := makeDedupEntry("1", primitive.XVanusProducerID, "p", primitive.XVanusProducerSeq, "1")
+		p2 := makeDedupEntry("2", primitive.XVanusProducerID, "p", primitive.XVanusProducerSeq, "1")
+		p3 := makeDedupEntry("1", primitive.XVanusProducerID, "p", primitive.XVanusProducerSeq, "2")
+		So(idempotenceKey(p1), ShouldEqual, idempotenceKey(p2))
+		So(idempotenceKey(p1), ShouldNotEqual, idempotenceKey(p3))
+	})
+}
+
+func TestVSBlock_IdempotentAppend(t *testing.T) {
+	Convey("idempotent append", t, func() {
+		dir, err := os.MkdirTemp("", "vsb-*")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		e := &engine{dir: dir, dedupSize: 8}
+		id := vanus.NewTestID()
+		r, err := e.Create(context.Background(), id, 1024*1024)
+		So(err, ShouldBeNil)
+		b, _ := r.(*vsBlock)
+
+		ctx := block.WithIdempotentAppend(context.Background())
+		ent0, ent1, ent2 := makeDedupEntry("0"), makeDedupEntry("1"), makeDedupEntry("2")
+
+		actx := b.NewAppendContext(nil)
+		seqs, frag, _, err := b.PrepareAppend(ctx, actx, ent0)
+		So(err, ShouldBeNil)
+		So(seqs, ShouldResemble, []int64{0})
+
+		Convey("skip pending entries", func() {
+			seqs, frag2, _, err := b.PrepareAppend(ctx, actx, ent0, ent1, ent1)
+			So(err, ShouldBeNil)
+			So(seqs, ShouldResemble, []int64{0, 1, 1})
+			So(frag2.StartOffset(), ShouldEqual, frag.EndOffset())
+
+			_, err = b.CommitAppend(ctx, frag, frag2)
+			So(err, ShouldBeNil)
+			So(b.status().EntryNum, ShouldEqual, 2)
+		})
+
+		Convey("skip committed entries", func() {
+			_, err = b.CommitAppend(ctx, frag)
+			So(err, ShouldBeNil)
+
+			actx = b.NewAppendContext(nil)
+			seqs, frag, _, err = b.PrepareAppend(ctx, actx, ent0)
+			So(err, ShouldBeNil)
+			So(seqs, ShouldResemble, []int64{0})
+			So(frag, ShouldBeNil)
+			So(actx.WriteOffset(), ShouldEqual, b.actx.offset)
+
+			// Non-idempotent append doesn't skip entries.
+			seqs, frag, _, err = b.PrepareAppend(context.Background(), actx, ent0)
+			So(err, ShouldBeNil)
+			So(seqs, ShouldResemble, []int64{1})
+			So(frag, ShouldNotBeNil)
+		})
+
+		Convey("rebuild window after reopen", func() {
+			seqs, frag2, _, err := b.PrepareAppend(ctx, actx, ent1, ent2)
+			So(err, ShouldBeNil)
+			So(seqs, ShouldResemble, []int64{1, 2})
+			_, err = b.CommitAppend(ctx, frag, frag2)
+			So(err, ShouldBeNil)
+			So(b.Close(context.Background()), ShouldBeNil)
+
+			r, err = e.Open(context.Background(), id)
+			So(err, ShouldBeNil)
+			b, _ = r.(*vsBlock)
+
+			actx = b.NewAppendContext(nil)
+			seqs, frag, _, err = b.PrepareAppend(ctx, actx, ent2, makeDedupEntry("3"), ent0)
+			So(err, ShouldBeNil)
+			So(seqs, ShouldResemble, []int64{2, 3, 0})
+			So(frag, ShouldNotBeNil)
+		})
+
+		So(b.Close(context.Background()), ShouldBeNil)
+	})
+
+	Convey("idempotent append without window", t, func() {
+		dir, err := os.MkdirTemp("", "vsb-*")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		e := &engine{dir: dir}
+		r, err := e.Create(context.Background(), vanus.NewTestID(), 1024*1024)
+		So(err, ShouldBeNil)
+		b, _ := r.(*vsBlock)
+
+		ctx := block.WithIdempotentAppend(context.Background())
+		_, _, _, err = b.PrepareAppend(ctx, b.NewAppendContext(nil), makeDedupEntry("0"))
+		So(err, ShouldEqual, block.ErrIdempotenceDisabled)
+
+		So(b.Close(context.Background()), ShouldBeNil)
+	})
+}
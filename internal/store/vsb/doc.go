@@ -28,23 +28,27 @@
 //	├─────────────────┼───┬───┬─────────┼─────────────────┴─────────────────┤
 //	│  Data Offset(4) │(1)│(1)│ Size(2) │            Capacity(8)            │
 //	├─────────────────┴───┴───┴─────────┼─────────────────┬─────────┬───────┤
-//	│          Entry Length(8)          │   Entry Num(4)  │Offset(2)│       ;
-//	└───────────────────────────────────┴─────────────────┴─────────┴-------'
+//	│          Entry Length(8)          │   Entry Num(4)  │Offset(2)│       │
+//	├───────────────────────────────────┼─────────────────┴─────────┴───────┘
+//	│           Segment ID(8)           ;
+//	└───────────────────────────────────┘
 //
 // All values little-endian
 //
 //	+00 4B Magic number (0x00627376, "vsb" in ASCII)
 //	+04 4B CRC-32c of header block
-//	+08 4B Flags
+//	+08 4B Flags (bit 0: offloaded, the entries are moved to object storage and only the header
+//	       is kept locally)
 //	+0C 4B Break Flags
 //	+10 4B Data Offset (in bytes, currently 4096)
 //	+14 1B State (0: working, 1: archived)
-//	+15 1B Reserved
+//	+15 1B Compression (0: none, 1: lz4, 2: zstd), which compresses the data of new entries
 //	+16 2B Index Size (in bytes, currently 24)
 //	+18 8B Capacity (in bytes)
 //	+20 8B Entry Length (in bytes)
 //	+28 4B Entry Num (number of entries)
 //	+2C 2B Index Offset (in bytes)
+//	+30 8B Segment ID, the replicas of segment share the object in object storage
 //
 // The layout of `Packet` is:
 //
@@ -75,6 +79,9 @@
 //	+02 2B Offset (offset of payload, 18 for v1)
 //	+04    Payload (CloudEvent, End, etc.)
 //
+// The data attribute of CloudEvent is compressed if the type is "c4" (lz4) or "cz" (zstd),
+// the compressed data of lz4 is prefixed with the 4B length of uncompressed data.
+//
 // The layout of `Entry` is:
 //
 //	┌─────────────────┬─────────────────────────────────────────────────────┐
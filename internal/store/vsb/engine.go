@@ -22,6 +22,7 @@ import (
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/internal/store/block"
 	"github.com/linkall-labs/vanus/internal/store/block/raw"
+	"github.com/linkall-labs/vanus/internal/store/objstore"
 )
 
 const (
@@ -31,6 +32,29 @@ const (
 type engine struct {
 	dir string
 	lis block.ArchivedListener
+	// dedupSize is the size of idempotence window of each block, 0 means disabled.
+	dedupSize int
+	// store is the object storage which archived blocks are offloaded to, nil means disabled.
+	store objstore.Store
+}
+
+type Option func(*engine)
+
+// WithIdempotenceWindow enables idempotent append, the latest size entries of each block are
+// remembered to detect duplicates. The window is rebuilt from the latest size entries when the
+// block is opened, idempotent append fails with block.ErrIdempotenceDisabled if size is 0.
+func WithIdempotenceWindow(size int) Option {
+	return func(e *engine) {
+		e.dedupSize = size
+	}
+}
+
+// WithObjectStore enables tiered storage, archived blocks are uploaded to s and evicted from
+// local disk, then they are read from s.
+func WithObjectStore(s objstore.Store) Option {
+	return func(e *engine) {
+		e.store = s
+	}
 }
 
 // Make sure engine implements raw.Engine.
@@ -45,14 +69,26 @@ func (e *engine) GetBlockStatistics(id vanus.ID, r block.Raw) (block.Statistics,
 	return block.Statistics{}, nil
 }
 
-func Initialize(dir string, lis block.ArchivedListener) error {
+func (e *engine) newDedupWindow() *dedupWindow {
+	if e.dedupSize <= 0 {
+		return nil
+	}
+	return newDedupWindow(e.dedupSize)
+}
+
+func Initialize(dir string, lis block.ArchivedListener, opts ...Option) error {
 	// Make sure the block directory exists.
 	if err := os.MkdirAll(dir, defaultDirPerm); err != nil {
 		return err
 	}
 
-	return raw.RegisterEngine(raw.VSB, &engine{
+	e := &engine{
 		dir: dir,
 		lis: lis,
-	})
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	return raw.RegisterEngine(raw.VSB, e)
 }
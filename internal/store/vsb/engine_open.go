@@ -64,10 +64,13 @@ func (e *engine) Create(ctx context.Context, id vanus.ID, capacity int64) (block
 		actx: appendContext{
 			offset: headerBlockSize,
 		},
+		dedup:  e.newDedupWindow(),
 		enc:    codec.NewEncoder(),
 		dec:    dec,
 		lis:    e.lis,
 		f:      f,
+		r:      f,
+		store:  e.store,
 		tracer: tracing.NewTracer("store.vsb.vsBlock", trace.SpanKindInternal),
 	}
 
@@ -94,7 +97,9 @@ func (e *engine) Open(ctx context.Context, id vanus.ID) (block.Raw, error) {
 	b := &vsBlock{
 		id:     id,
 		path:   path,
+		dedup:  e.newDedupWindow(),
 		lis:    e.lis,
+		store:  e.store,
 		tracer: tracing.NewTracer("store.vsb.vsBlock", trace.SpanKindInternal),
 	}
 
@@ -128,6 +128,8 @@ var (
 		0x90, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // entry length
 		0x02, 0x00, 0x00, 0x00, // entry num
 		0x28, 0x00, // index offset
+		0x00, 0x00, // reversed
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // segment id
 	}
 	EmptyHeaderData = []byte{
 		0x76, 0x73, 0x62, 0x00, // magic
@@ -142,5 +144,7 @@ var (
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // entry length
 		0x00, 0x00, 0x00, 0x00, // entry num
 		0x00, 0x00, // index offset
+		0x00, 0x00, // reversed
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // segment id
 	}
 )
@@ -21,6 +21,7 @@ import (
 	"github.com/linkall-labs/vanus/internal/timer/leaderelection"
 	"github.com/linkall-labs/vanus/internal/timer/timingwheel"
 	"github.com/linkall-labs/vanus/observability"
+	"github.com/linkall-labs/vanus/pkg/util/tlsconfig"
 )
 
 type Config struct {
@@ -34,6 +35,7 @@ type Config struct {
 	LeaderElectionConfig LeaderElectionConfig `yaml:"leaderelection"`
 	TimingWheelConfig    TimingWheelConfig    `yaml:"timingwheel"`
 	Observability        observability.Config `yaml:"observability"`
+	TLS                  tlsconfig.Config     `yaml:"tls"`
 }
 
 const (
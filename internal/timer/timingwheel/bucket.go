@@ -42,6 +42,7 @@ const (
 	timerBuiltInEventbus                    = "__Timer_%d_%d"
 	xVanusEventbus                          = "xvanuseventbus"
 	xVanusDeliveryTime                      = "xvanusdeliverytime"
+	xVanusScheduleID                        = "xvanusscheduleid"
 	sleepDuration                           = 100 * time.Millisecond
 )
 
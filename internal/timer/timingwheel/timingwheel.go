@@ -21,6 +21,7 @@ import (
 	stderr "errors"
 	"fmt"
 	"io"
+	"strconv"
 	"sync"
 	"time"
 
@@ -33,8 +34,8 @@ import (
 	"github.com/linkall-labs/vanus/observability/metrics"
 	"github.com/linkall-labs/vanus/pkg/cluster"
 	"github.com/linkall-labs/vanus/pkg/errors"
+	"github.com/linkall-labs/vanus/pkg/util/tlsconfig"
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
-	"google.golang.org/grpc/credentials/insecure"
 	"k8s.io/apimachinery/pkg/util/wait"
 )
 
@@ -116,7 +117,7 @@ func NewTimingWheel(c *Config) Manager {
 func (tw *timingWheel) Init(ctx context.Context) error {
 	log.Info(ctx, "init timingwheel", nil)
 	// Init Hierarchical Timing Wheels.
-	ctrl := cluster.NewClusterController(tw.config.CtrlEndpoints, insecure.NewCredentials())
+	ctrl := cluster.NewClusterController(tw.config.CtrlEndpoints, tlsconfig.ClientCredentials())
 	if err := ctrl.WaitForControllerReady(true); err != nil {
 		panic("wait for controller ready timeout")
 	}
@@ -570,6 +571,24 @@ func (tw *timingWheel) deliver(ctx context.Context, e *ce.Event) error {
 		})
 		return err
 	}
+	scheduleID, scheduled, err := tw.checkSchedule(ctx, ebName, e)
+	if err != nil {
+		return err
+	}
+	if !scheduled {
+		log.Info(ctx, "the scheduled event has been cancelled, discard this event", map[string]interface{}{
+			"eventbus":    ebName,
+			"event_id":    e.ID(),
+			"schedule_id": scheduleID,
+		})
+		return nil
+	}
+	if _, ok := e.Extensions()[xVanusScheduleID]; ok {
+		// the schedule ID is only used by timer, don't flow it into the target eventbus.
+		event := e.Clone()
+		event.SetExtension(xVanusScheduleID, nil)
+		e = &event
+	}
 	_, err = tw.client.Eventbus(ctx, ebName).Writer().AppendOne(ctx, e)
 	if err != nil {
 		if errors.Is(err, errors.ErrOffsetOnEnd) {
@@ -587,6 +606,9 @@ func (tw *timingWheel) deliver(ctx context.Context, e *ce.Event) error {
 		})
 		return err
 	}
+	if scheduleID != 0 {
+		tw.finishSchedule(ctx, ebName, scheduleID)
+	}
 	log.Debug(ctx, "event delivered", map[string]interface{}{
 		"event_id":      e.ID(),
 		"eventbus":      e.Extensions()[xVanusEventbus],
@@ -595,6 +617,54 @@ func (tw *timingWheel) deliver(ctx context.Context, e *ce.Event) error {
 	return nil
 }
 
+// checkSchedule checks whether the event registered by scheduled event API is still pending,
+// the event which has been cancelled shouldn't be delivered.
+func (tw *timingWheel) checkSchedule(ctx context.Context, ebName string, e *ce.Event) (uint64, bool, error) {
+	value, ok := e.Extensions()[xVanusScheduleID]
+	if !ok {
+		return 0, true, nil
+	}
+	scheduleID, err := strconv.ParseUint(fmt.Sprintf("%v", value), 10, 64)
+	if err != nil {
+		log.Warning(ctx, "invalid schedule id, deliver it directly", map[string]interface{}{
+			log.KeyError:  err,
+			"schedule_id": value,
+		})
+		return 0, true, nil
+	}
+	_, err = tw.ctrlCli.GetScheduledEvent(ctx, &ctrlpb.GetScheduledEventRequest{
+		Eventbus: ebName,
+		Id:       scheduleID,
+	})
+	if err != nil {
+		if errors.Is(err, errors.ErrResourceNotFound) {
+			return scheduleID, false, nil
+		}
+		log.Error(ctx, "get scheduled event failed", map[string]interface{}{
+			log.KeyError:  err,
+			"eventbus":    ebName,
+			"schedule_id": scheduleID,
+		})
+		return scheduleID, false, err
+	}
+	return scheduleID, true, nil
+}
+
+// finishSchedule removes the schedule after the event was delivered.
+func (tw *timingWheel) finishSchedule(ctx context.Context, ebName string, scheduleID uint64) {
+	_, err := tw.ctrlCli.DeleteScheduledEvent(ctx, &ctrlpb.DeleteScheduledEventRequest{
+		Eventbus: ebName,
+		Id:       scheduleID,
+	})
+	if err != nil && !errors.Is(err, errors.ErrResourceNotFound) {
+		log.Warning(ctx, "delete scheduled event failed", map[string]interface{}{
+			log.KeyError:  err,
+			"eventbus":    ebName,
+			"schedule_id": scheduleID,
+		})
+	}
+}
+
 // timingWheelElement timingwheelelement has N number of buckets, every bucket is an eventbus.
 type timingWheelElement struct {
 	config   *Config
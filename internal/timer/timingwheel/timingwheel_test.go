@@ -33,6 +33,7 @@ import (
 	"github.com/linkall-labs/vanus/pkg/cluster"
 	"github.com/linkall-labs/vanus/pkg/errors"
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
 	. "github.com/prashantv/gostub"
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -488,6 +489,46 @@ func TestTimingWheel_deliver(t *testing.T) {
 			err := tw.deliver(ctx, e)
 			So(err, ShouldBeNil)
 		})
+
+		Convey("test timingwheel deliver scheduled event", func() {
+			mockEventbusCtrlCli := ctrlpb.NewMockEventBusControllerClient(mockCtrl)
+			tw.ctrlCli = mockEventbusCtrlCli
+			e.SetExtension(xVanusScheduleID, "100")
+			req := &ctrlpb.GetScheduledEventRequest{Eventbus: "quick-start", Id: 100}
+
+			Convey("test the scheduled event has been cancelled", func() {
+				mockEventbusCtrlCli.EXPECT().GetScheduledEvent(Any(), req).Times(1).
+					Return(nil, errors.ErrResourceNotFound)
+				err := tw.deliver(ctx, e)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("test get scheduled event failed", func() {
+				mockEventbusCtrlCli.EXPECT().GetScheduledEvent(Any(), req).Times(1).
+					Return(nil, errors.ErrInternal)
+				err := tw.deliver(ctx, e)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("test deliver the pending scheduled event", func() {
+				mockEventbusCtrlCli.EXPECT().GetScheduledEvent(Any(), req).Times(1).
+					Return(&metapb.ScheduledEvent{Id: 100, Eventbus: "quick-start"}, nil)
+				var delivered *ce.Event
+				mockBusWriter.EXPECT().AppendOne(Any(), Any()).Times(1).DoAndReturn(
+					func(_ context.Context, event *ce.Event, _ ...api.WriteOption) (string, error) {
+						delivered = event
+						return "", nil
+					})
+				mockEventbusCtrlCli.EXPECT().DeleteScheduledEvent(Any(), &ctrlpb.DeleteScheduledEventRequest{
+					Eventbus: "quick-start",
+					Id:       100,
+				}).Times(1).Return(nil, nil)
+				err := tw.deliver(ctx, e)
+				So(err, ShouldBeNil)
+				So(delivered.Extensions(), ShouldNotContainKey, xVanusScheduleID)
+				So(e.Extensions(), ShouldContainKey, xVanusScheduleID)
+			})
+		})
 	})
 }
 
@@ -0,0 +1,257 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive"
+)
+
+type Config struct {
+	WindowType primitive.WindowType
+	Size       time.Duration
+	// Slide is the interval between the starts of sliding windows, it's ignored by tumbling windows.
+	Slide time.Duration
+	// AllowedLateness is how long the watermark lags behind the latest event time, the events
+	// earlier than watermark are dropped.
+	AllowedLateness time.Duration
+}
+
+// step returns the interval between the starts of windows.
+func (c Config) step() time.Duration {
+	if c.WindowType == primitive.SlidingWindow && c.Slide > 0 {
+		return c.Slide
+	}
+	return c.Size
+}
+
+type windowKey struct {
+	key   string
+	start int64
+}
+
+type accumulator struct {
+	Count        int64   `json:"count"`
+	NumericCount int64   `json:"numeric_count"`
+	Sum          float64 `json:"sum"`
+	Min          float64 `json:"min"`
+	Max          float64 `json:"max"`
+}
+
+func (a *accumulator) add(value *float64) {
+	a.Count++
+	if value == nil {
+		return
+	}
+	v := *value
+	if a.NumericCount == 0 {
+		a.Min, a.Max = v, v
+	} else {
+		a.Min = math.Min(a.Min, v)
+		a.Max = math.Max(a.Max, v)
+	}
+	a.NumericCount++
+	a.Sum += v
+}
+
+// Result is the aggregated values of a closed window, Min, Max and Avg are only meaningful if
+// NumericCount isn't zero.
+type Result struct {
+	Key   string    `json:"key"`
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+	accumulator
+}
+
+// Value returns the value of function, nil means there isn't any numeric value in the window.
+func (r Result) Value(fn primitive.AggregateFunction) interface{} {
+	if fn == primitive.AggregateCount {
+		return r.Count
+	}
+	if r.NumericCount == 0 {
+		return nil
+	}
+	switch fn {
+	case primitive.AggregateSum:
+		return r.Sum
+	case primitive.AggregateMin:
+		return r.Min
+	case primitive.AggregateMax:
+		return r.Max
+	case primitive.AggregateAvg:
+		return r.Sum / float64(r.NumericCount)
+	}
+	return nil
+}
+
+// Aggregator accumulates the events into the windows by key, and closes the windows when the
+// watermark passes their ends. The watermark is driven by the event time, so replaying the
+// history produces the same windows. Aggregator isn't safe for concurrent use.
+type Aggregator struct {
+	config  Config
+	windows map[windowKey]*accumulator
+	// watermark and maxEventTime are in milliseconds.
+	watermark    int64
+	maxEventTime int64
+}
+
+func New(config Config) *Aggregator {
+	return &Aggregator{
+		config:       config,
+		windows:      map[windowKey]*accumulator{},
+		watermark:    math.MinInt64,
+		maxEventTime: math.MinInt64,
+	}
+}
+
+// Add accumulates the event into all windows it belongs to, value is nil if the event hasn't a
+// numeric value. It returns false if the event is late, which means all its windows were closed.
+func (a *Aggregator) Add(key string, eventTime time.Time, value *float64) bool {
+	t := eventTime.UnixMilli()
+	size, step := a.config.Size.Milliseconds(), a.config.step().Milliseconds()
+	added := false
+	for start := floorDiv(t, step) * step; start > t-size; start -= step {
+		if start+size <= a.watermark {
+			// the earlier windows are closed too.
+			break
+		}
+		k := windowKey{key: key, start: start}
+		acc, ok := a.windows[k]
+		if !ok {
+			acc = &accumulator{}
+			a.windows[k] = acc
+		}
+		acc.add(value)
+		added = true
+	}
+	if t > a.maxEventTime {
+		a.maxEventTime = t
+		a.advance(t - a.config.AllowedLateness.Milliseconds())
+	}
+	return added
+}
+
+// Idle advances the watermark as if the event time has progressed d since the latest event, it
+// closes the windows of the keys which don't receive events any more.
+func (a *Aggregator) Idle(d time.Duration) {
+	if a.maxEventTime == math.MinInt64 {
+		return
+	}
+	a.advance(a.maxEventTime + d.Milliseconds() - a.config.AllowedLateness.Milliseconds())
+}
+
+func (a *Aggregator) advance(watermark int64) {
+	if watermark > a.watermark {
+		a.watermark = watermark
+	}
+}
+
+// Expired removes the windows closed by watermark, and returns their results in the order of
+// end and key.
+func (a *Aggregator) Expired() []Result {
+	var results []Result
+	size := a.config.Size.Milliseconds()
+	for k, acc := range a.windows {
+		if k.start+size > a.watermark {
+			continue
+		}
+		results = append(results, Result{
+			Key:         k.key,
+			Start:       time.UnixMilli(k.start),
+			End:         time.UnixMilli(k.start + size),
+			accumulator: *acc,
+		})
+		delete(a.windows, k)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if !results[i].End.Equal(results[j].End) {
+			return results[i].End.Before(results[j].End)
+		}
+		return results[i].Key < results[j].Key
+	})
+	return results
+}
+
+// Len returns the number of open windows.
+func (a *Aggregator) Len() int {
+	return len(a.windows)
+}
+
+// Reset drops all windows and the watermark.
+func (a *Aggregator) Reset() {
+	a.windows = map[windowKey]*accumulator{}
+	a.watermark = math.MinInt64
+	a.maxEventTime = math.MinInt64
+}
+
+type snapshot struct {
+	Watermark    int64            `json:"watermark"`
+	MaxEventTime int64            `json:"max_event_time"`
+	Windows      []windowSnapshot `json:"windows"`
+}
+
+type windowSnapshot struct {
+	Key   string `json:"key"`
+	Start int64  `json:"start"`
+	accumulator
+}
+
+// Snapshot encodes the open windows and the watermark.
+func (a *Aggregator) Snapshot() ([]byte, error) {
+	s := snapshot{
+		Watermark:    a.watermark,
+		MaxEventTime: a.maxEventTime,
+		Windows:      make([]windowSnapshot, 0, len(a.windows)),
+	}
+	for k, acc := range a.windows {
+		s.Windows = append(s.Windows, windowSnapshot{Key: k.key, Start: k.start, accumulator: *acc})
+	}
+	sort.Slice(s.Windows, func(i, j int) bool {
+		if s.Windows[i].Start != s.Windows[j].Start {
+			return s.Windows[i].Start < s.Windows[j].Start
+		}
+		return s.Windows[i].Key < s.Windows[j].Key
+	})
+	return json.Marshal(s)
+}
+
+// Restore replaces the state with the snapshot.
+func (a *Aggregator) Restore(data []byte) error {
+	var s snapshot
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	a.Reset()
+	a.watermark = s.Watermark
+	a.maxEventTime = s.MaxEventTime
+	for i := range s.Windows {
+		w := s.Windows[i]
+		acc := w.accumulator
+		a.windows[windowKey{key: w.Key, start: w.Start}] = &acc
+	}
+	return nil
+}
+
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
@@ -0,0 +1,135 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func value(v float64) *float64 {
+	return &v
+}
+
+func TestAggregator_Tumbling(t *testing.T) {
+	Convey("test tumbling window", t, func() {
+		a := New(Config{WindowType: primitive.TumblingWindow, Size: time.Minute})
+		base := time.UnixMilli(0).Add(time.Hour)
+		So(a.Add("a", base.Add(time.Second), value(1)), ShouldBeTrue)
+		So(a.Add("a", base.Add(2*time.Second), value(3)), ShouldBeTrue)
+		So(a.Add("b", base.Add(3*time.Second), nil), ShouldBeTrue)
+		So(a.Len(), ShouldEqual, 2)
+		So(a.Expired(), ShouldBeEmpty)
+
+		So(a.Add("a", base.Add(time.Minute), value(5)), ShouldBeTrue)
+		results := a.Expired()
+		So(results, ShouldHaveLength, 2)
+		So(results[0].Key, ShouldEqual, "a")
+		So(results[0].Start, ShouldEqual, base)
+		So(results[0].End, ShouldEqual, base.Add(time.Minute))
+		So(results[0].Value(primitive.AggregateCount), ShouldEqual, 2)
+		So(results[0].Value(primitive.AggregateSum), ShouldEqual, 4)
+		So(results[0].Value(primitive.AggregateMin), ShouldEqual, 1)
+		So(results[0].Value(primitive.AggregateMax), ShouldEqual, 3)
+		So(results[0].Value(primitive.AggregateAvg), ShouldEqual, 2)
+		So(results[1].Key, ShouldEqual, "b")
+		So(results[1].Value(primitive.AggregateCount), ShouldEqual, 1)
+		So(results[1].Value(primitive.AggregateAvg), ShouldBeNil)
+
+		Convey("late event is dropped", func() {
+			So(a.Add("a", base.Add(30*time.Second), value(1)), ShouldBeFalse)
+			So(a.Len(), ShouldEqual, 1)
+		})
+
+		Convey("idle closes the window", func() {
+			a.Idle(59 * time.Second)
+			So(a.Expired(), ShouldBeEmpty)
+			a.Idle(time.Minute)
+			results = a.Expired()
+			So(results, ShouldHaveLength, 1)
+			So(results[0].Value(primitive.AggregateSum), ShouldEqual, 5)
+		})
+	})
+
+	Convey("test allowed lateness", t, func() {
+		a := New(Config{WindowType: primitive.TumblingWindow, Size: time.Minute, AllowedLateness: 10 * time.Second})
+		base := time.UnixMilli(0).Add(time.Hour)
+		a.Add("a", base, value(1))
+		a.Add("a", base.Add(65*time.Second), value(1))
+		So(a.Expired(), ShouldBeEmpty)
+		So(a.Add("a", base.Add(59*time.Second), value(1)), ShouldBeTrue)
+		a.Add("a", base.Add(70*time.Second), value(1))
+		results := a.Expired()
+		So(results, ShouldHaveLength, 1)
+		So(results[0].Count, ShouldEqual, 2)
+	})
+}
+
+func TestAggregator_Sliding(t *testing.T) {
+	Convey("test sliding window", t, func() {
+		a := New(Config{WindowType: primitive.SlidingWindow, Size: time.Minute, Slide: 20 * time.Second})
+		base := time.UnixMilli(0).Add(time.Hour)
+		So(a.Add("a", base.Add(25*time.Second), value(2)), ShouldBeTrue)
+		// [-20s, 40s), [0s, 60s), [20s, 80s) contain the event.
+		So(a.Len(), ShouldEqual, 3)
+
+		a.Idle(15 * time.Second)
+		results := a.Expired()
+		So(results, ShouldHaveLength, 1)
+		So(results[0].Start, ShouldEqual, base.Add(-20*time.Second))
+		So(results[0].Value(primitive.AggregateSum), ShouldEqual, 2)
+
+		So(a.Add("a", base.Add(5*time.Second), value(4)), ShouldBeTrue)
+		So(a.Len(), ShouldEqual, 2)
+		a.Idle(time.Minute)
+		results = a.Expired()
+		So(results, ShouldHaveLength, 2)
+		So(results[0].Start, ShouldEqual, base)
+		So(results[0].Value(primitive.AggregateCount), ShouldEqual, 2)
+		So(results[0].Value(primitive.AggregateMax), ShouldEqual, 4)
+		So(results[1].Start, ShouldEqual, base.Add(20*time.Second))
+		So(results[1].Value(primitive.AggregateCount), ShouldEqual, 1)
+	})
+}
+
+func TestAggregator_Snapshot(t *testing.T) {
+	Convey("test snapshot and restore", t, func() {
+		config := Config{WindowType: primitive.TumblingWindow, Size: time.Minute, AllowedLateness: 10 * time.Second}
+		a := New(config)
+		base := time.UnixMilli(0).Add(time.Hour)
+		a.Add("a", base, value(1))
+		a.Add("b", base.Add(time.Second), value(2))
+		data, err := a.Snapshot()
+		So(err, ShouldBeNil)
+
+		b := New(config)
+		So(b.Restore(data), ShouldBeNil)
+		So(b.Len(), ShouldEqual, 2)
+		So(b.Add("a", base.Add(-time.Second), value(1)), ShouldBeTrue)
+		b.Idle(2 * time.Minute)
+		results := b.Expired()
+		So(results, ShouldHaveLength, 3)
+		So(results[0].Start, ShouldEqual, base.Add(-time.Minute))
+		So(results[1].Key, ShouldEqual, "a")
+		So(results[1].Value(primitive.AggregateSum), ShouldEqual, 1)
+		So(results[2].Key, ShouldEqual, "b")
+
+		So(b.Restore([]byte("{")), ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,227 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package breaker
+
+import (
+	"sync"
+	"time"
+)
+
+type State int32
+
+const (
+	StateClosed State = iota
+	StateOpen
+	StateHalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+const (
+	defaultFailureRatio   = 0.5
+	defaultMinRequests    = 20
+	defaultWindow         = time.Minute
+	defaultOpenDuration   = 30 * time.Second
+	defaultHalfOpenTrials = 3
+
+	bucketNumber = 10
+	// the interval to check again when all trials are in flight.
+	trialWaitInterval = 100 * time.Millisecond
+)
+
+type Config struct {
+	// FailureRatio is the ratio of failed requests in Window to open the breaker.
+	FailureRatio float64
+	// MinRequests is the minimum number of requests in Window to check the ratio.
+	MinRequests int
+	Window      time.Duration
+	// OpenDuration is how long the breaker keeps open before half-open.
+	OpenDuration time.Duration
+	// HalfOpenTrials is the number of trial requests when half-open, the breaker closes if all of
+	// them succeed.
+	HalfOpenTrials int
+}
+
+func (c *Config) complete() {
+	if c.FailureRatio <= 0 || c.FailureRatio > 1 {
+		c.FailureRatio = defaultFailureRatio
+	}
+	if c.MinRequests <= 0 {
+		c.MinRequests = defaultMinRequests
+	}
+	if c.Window <= 0 {
+		c.Window = defaultWindow
+	}
+	if c.OpenDuration <= 0 {
+		c.OpenDuration = defaultOpenDuration
+	}
+	if c.HalfOpenTrials <= 0 {
+		c.HalfOpenTrials = defaultHalfOpenTrials
+	}
+}
+
+type bucket struct {
+	start   time.Time
+	total   int
+	failure int
+}
+
+// Breaker counts the results of requests in a sliding window which is split into buckets.
+type Breaker struct {
+	config   Config
+	onChange func(from, to State)
+	now      func() time.Time
+
+	mu       sync.Mutex
+	state    State
+	buckets  [bucketNumber]bucket
+	openedAt time.Time
+	// generation is increased on every state change, so the results of requests which were
+	// allowed in the previous state are ignored.
+	generation   uint64
+	trials       int
+	trialSuccess int
+}
+
+// New creates a closed breaker, onChange is called on every state change while holding the
+// lock of breaker, so it must not call the breaker.
+func New(config Config, onChange func(from, to State)) *Breaker {
+	config.complete()
+	if onChange == nil {
+		onChange = func(from, to State) {}
+	}
+	return &Breaker{
+		config:   config,
+		onChange: onChange,
+		now:      time.Now,
+	}
+}
+
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// Allow checks whether a request can be sent. If so, it returns the function to report the
+// result of request, otherwise it returns how long to wait before checking again.
+func (b *Breaker) Allow() (func(success bool), time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := b.now()
+	switch b.state {
+	case StateOpen:
+		wait := b.openedAt.Add(b.config.OpenDuration).Sub(now)
+		if wait > 0 {
+			return nil, wait
+		}
+		b.setState(StateHalfOpen, now)
+		fallthrough
+	case StateHalfOpen:
+		if b.trials >= b.config.HalfOpenTrials {
+			return nil, trialWaitInterval
+		}
+		b.trials++
+	}
+	generation := b.generation
+	return func(success bool) {
+		b.done(generation, success)
+	}, 0
+}
+
+func (b *Breaker) done(generation uint64, success bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if generation != b.generation {
+		return
+	}
+	now := b.now()
+	switch b.state {
+	case StateClosed:
+		bk := b.currentBucket(now)
+		bk.total++
+		if !success {
+			bk.failure++
+		}
+		total, failure := b.count(now)
+		if total >= b.config.MinRequests && float64(failure) >= float64(total)*b.config.FailureRatio {
+			b.setState(StateOpen, now)
+		}
+	case StateHalfOpen:
+		if !success {
+			b.setState(StateOpen, now)
+			return
+		}
+		b.trialSuccess++
+		if b.trialSuccess >= b.config.HalfOpenTrials {
+			b.setState(StateClosed, now)
+		}
+	}
+}
+
+func (b *Breaker) bucketDuration() time.Duration {
+	return b.config.Window / bucketNumber
+}
+
+func (b *Breaker) currentBucket(now time.Time) *bucket {
+	d := int64(b.bucketDuration())
+	idx := now.UnixNano() / d
+	start := time.Unix(0, idx*d)
+	bk := &b.buckets[idx%bucketNumber]
+	if !bk.start.Equal(start) {
+		*bk = bucket{start: start}
+	}
+	return bk
+}
+
+func (b *Breaker) count(now time.Time) (int, int) {
+	var total, failure int
+	for i := range b.buckets {
+		bk := &b.buckets[i]
+		if now.Sub(bk.start) >= b.config.Window {
+			continue
+		}
+		total += bk.total
+		failure += bk.failure
+	}
+	return total, failure
+}
+
+func (b *Breaker) setState(state State, now time.Time) {
+	if b.state == state {
+		return
+	}
+	from := b.state
+	b.state = state
+	b.generation++
+	b.trials, b.trialSuccess = 0, 0
+	switch state {
+	case StateOpen:
+		b.openedAt = now
+	case StateClosed:
+		b.buckets = [bucketNumber]bucket{}
+	}
+	b.onChange(from, state)
+}
@@ -0,0 +1,116 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package breaker
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBreaker(t *testing.T) {
+	Convey("test circuit breaker", t, func() {
+		now := time.Unix(1000, 0)
+		var changes []State
+		b := New(Config{
+			FailureRatio:   0.5,
+			MinRequests:    4,
+			Window:         10 * time.Second,
+			OpenDuration:   5 * time.Second,
+			HalfOpenTrials: 2,
+		}, func(from, to State) {
+			changes = append(changes, to)
+		})
+		b.now = func() time.Time { return now }
+		request := func(success bool) {
+			done, wait := b.Allow()
+			So(done, ShouldNotBeNil)
+			So(wait, ShouldEqual, 0)
+			done(success)
+		}
+
+		Convey("test keep closed under min requests", func() {
+			request(false)
+			request(false)
+			request(false)
+			So(b.State(), ShouldEqual, StateClosed)
+		})
+
+		Convey("test keep closed under failure ratio", func() {
+			request(true)
+			request(true)
+			request(false)
+			request(true)
+			So(b.State(), ShouldEqual, StateClosed)
+		})
+
+		Convey("test failures out of window are dropped", func() {
+			request(false)
+			request(false)
+			request(false)
+			now = now.Add(11 * time.Second)
+			request(false)
+			So(b.State(), ShouldEqual, StateClosed)
+		})
+
+		Convey("test open, half-open and close", func() {
+			request(true)
+			request(false)
+			request(true)
+			request(false)
+			So(b.State(), ShouldEqual, StateOpen)
+			done, wait := b.Allow()
+			So(done, ShouldBeNil)
+			So(wait, ShouldEqual, 5*time.Second)
+
+			now = now.Add(5 * time.Second)
+			trial1, _ := b.Allow()
+			So(trial1, ShouldNotBeNil)
+			So(b.State(), ShouldEqual, StateHalfOpen)
+			trial2, _ := b.Allow()
+			So(trial2, ShouldNotBeNil)
+			done, wait = b.Allow()
+			So(done, ShouldBeNil)
+			So(wait, ShouldEqual, trialWaitInterval)
+
+			trial1(true)
+			So(b.State(), ShouldEqual, StateHalfOpen)
+			trial2(true)
+			So(b.State(), ShouldEqual, StateClosed)
+			So(changes, ShouldResemble, []State{StateOpen, StateHalfOpen, StateClosed})
+			// the window is reset after closed.
+			request(false)
+			So(b.State(), ShouldEqual, StateClosed)
+		})
+
+		Convey("test trial failure opens again", func() {
+			slow, _ := b.Allow()
+			for i := 0; i < 4; i++ {
+				request(false)
+			}
+			So(b.State(), ShouldEqual, StateOpen)
+			now = now.Add(5 * time.Second)
+			trial, _ := b.Allow()
+			// the request allowed before opened doesn't count as a trial.
+			slow(true)
+			So(b.State(), ShouldEqual, StateHalfOpen)
+			trial(false)
+			So(b.State(), ShouldEqual, StateOpen)
+			_, wait := b.Allow()
+			So(wait, ShouldEqual, 5*time.Second)
+		})
+	})
+}
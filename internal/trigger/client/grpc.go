@@ -0,0 +1,249 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	nethttp "net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	cepb "cloudevents.io/genproto/v1"
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/internal/convert"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	vanusce "github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	grpcConnPoolSize = 4
+)
+
+// grpcPoolKey identifies the connections to a sink, the clients with different credentials
+// don't share connections.
+type grpcPoolKey struct {
+	sink       primitive.GRPCSink
+	credential primitive.TLSSinkCredential
+}
+
+// grpcConnPool keeps a fixed number of connections to a target, connections are
+// shared by all clients which deliver events to the same sink, they are closed when
+// the last client is closed.
+type grpcConnPool struct {
+	key   grpcPoolKey
+	conns []*grpc.ClientConn
+	next  uint64
+	lock  sync.Mutex
+	// refs is the number of clients which use the pool, it's guarded by grpcPoolsLock.
+	refs int
+}
+
+var (
+	grpcPools     = map[grpcPoolKey]*grpcConnPool{}
+	grpcPoolsLock sync.Mutex
+)
+
+func acquireGRPCConnPool(key grpcPoolKey) *grpcConnPool {
+	grpcPoolsLock.Lock()
+	defer grpcPoolsLock.Unlock()
+	pool, exist := grpcPools[key]
+	if !exist {
+		pool = &grpcConnPool{key: key}
+		grpcPools[key] = pool
+	}
+	pool.refs++
+	return pool
+}
+
+func releaseGRPCConnPool(pool *grpcConnPool) error {
+	grpcPoolsLock.Lock()
+	defer grpcPoolsLock.Unlock()
+	pool.refs--
+	if pool.refs > 0 {
+		return nil
+	}
+	delete(grpcPools, pool.key)
+	return pool.close()
+}
+
+func (p *grpcConnPool) get() (*grpc.ClientConn, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if len(p.conns) < grpcConnPoolSize {
+		var creds credentials.TransportCredentials
+		if p.key.sink.TLS {
+			tlsConfig, err := newTLSConfig(&p.key.credential)
+			if err != nil {
+				return nil, err
+			}
+			creds = credentials.NewTLS(tlsConfig)
+		} else {
+			creds = insecure.NewCredentials()
+		}
+		// the connection is established in background, so Dial doesn't block.
+		conn, err := grpc.Dial(p.key.sink.Target, grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return nil, err
+		}
+		p.conns = append(p.conns, conn)
+		return conn, nil
+	}
+	idx := atomic.AddUint64(&p.next, 1)
+	return p.conns[idx%uint64(len(p.conns))], nil
+}
+
+func (p *grpcConnPool) close() error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	var err error
+	for _, conn := range p.conns {
+		if e := conn.Close(); e != nil {
+			err = e
+		}
+	}
+	p.conns = nil
+	return err
+}
+
+type grpcClient struct {
+	key  grpcPoolKey
+	pool *grpcConnPool
+	lock sync.Mutex
+	// newClient is used to replace the stub in testing.
+	newClient func(cc grpc.ClientConnInterface) vanusce.CloudEventServiceClient
+}
+
+// NewGRPCClient creates a client of gRPC sink, the credential is used if TLS is enabled.
+func NewGRPCClient(sink string, credential *primitive.TLSSinkCredential) EventClient {
+	s, err := primitive.ParseGRPCSink(sink)
+	if err != nil {
+		// the sink has been validated by controller
+		s = &primitive.GRPCSink{}
+	}
+	key := grpcPoolKey{sink: *s}
+	if s.TLS && credential != nil {
+		key.credential = *credential
+	}
+	return &grpcClient{
+		key:       key,
+		newClient: vanusce.NewCloudEventServiceClient,
+	}
+}
+
+// getPool returns the connection pool, which is acquired on the first call.
+func (c *grpcClient) getPool() *grpcConnPool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.pool == nil {
+		c.pool = acquireGRPCConnPool(c.key)
+	}
+	return c.pool
+}
+
+// Close releases the connection pool, the client acquires it again if it is used again.
+func (c *grpcClient) Close() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.pool == nil {
+		return nil
+	}
+	err := releaseGRPCConnPool(c.pool)
+	c.pool = nil
+	return err
+}
+
+func (c *grpcClient) client() (vanusce.CloudEventServiceClient, error) {
+	conn, err := c.getPool().get()
+	if err != nil {
+		return nil, err
+	}
+	return c.newClient(conn), nil
+}
+
+func (c *grpcClient) Send(ctx context.Context, event ce.Event) Result {
+	e, err := convert.ToPbCloudEvent(&event)
+	if err != nil {
+		return newResultByError(nethttp.StatusBadRequest, err)
+	}
+	cli, err := c.client()
+	if err != nil {
+		return newUndefinedErr(err)
+	}
+	_, err = cli.Publish(ctx, &vanusce.PublishRequest{Event: e})
+	if err != nil {
+		return convertGRPCError(err)
+	}
+	return Success
+}
+
+// SendBatch sends events in one BatchPublish request.
+func (c *grpcClient) SendBatch(ctx context.Context, events []*ce.Event) Result {
+	batch := &cepb.CloudEventBatch{Events: make([]*cepb.CloudEvent, len(events))}
+	for i := range events {
+		e, err := convert.ToPbCloudEvent(events[i])
+		if err != nil {
+			return newResultByError(nethttp.StatusBadRequest, err)
+		}
+		batch.Events[i] = e
+	}
+	cli, err := c.client()
+	if err != nil {
+		return newUndefinedErr(err)
+	}
+	_, err = cli.BatchPublish(ctx, &vanusce.BatchPublishRequest{Events: batch})
+	if err != nil {
+		return convertGRPCError(err)
+	}
+	return Success
+}
+
+// convertGRPCError maps the status code of gRPC to Result, the codes which can't be
+// recovered by retrying are mapped to non-retryable status codes.
+func convertGRPCError(err error) Result {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return DeliveryTimeout
+	}
+	s, ok := status.FromError(err)
+	if !ok {
+		return newUndefinedErr(err)
+	}
+	switch s.Code() {
+	case codes.OK:
+		return Success
+	case codes.DeadlineExceeded:
+		return DeliveryTimeout
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange, codes.Unimplemented:
+		return newResultByError(nethttp.StatusBadRequest, err)
+	case codes.PermissionDenied, codes.Unauthenticated:
+		return newResultByError(nethttp.StatusForbidden, err)
+	case codes.ResourceExhausted:
+		// the message size exceeds the limit of server, otherwise it's rate limited.
+		if strings.Contains(s.Message(), "larger than max") {
+			return newResultByError(nethttp.StatusRequestEntityTooLarge, err)
+		}
+		return newResultByError(nethttp.StatusTooManyRequests, err)
+	case codes.Unavailable:
+		return newResultByError(nethttp.StatusServiceUnavailable, err)
+	default:
+		return newInternalErr(err)
+	}
+}
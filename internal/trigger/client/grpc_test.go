@@ -0,0 +1,227 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	nethttp "net/http"
+	"testing"
+	"time"
+
+	cepb "cloudevents.io/genproto/v1"
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	vanusce "github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type testCloudEventServer struct {
+	vanusce.UnimplementedCloudEventServiceServer
+	events []*cepb.CloudEvent
+	err    error
+}
+
+func (s *testCloudEventServer) Publish(_ context.Context,
+	req *vanusce.PublishRequest) (*emptypb.Empty, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.events = append(s.events, req.Event)
+	return &emptypb.Empty{}, nil
+}
+
+func (s *testCloudEventServer) BatchPublish(_ context.Context,
+	req *vanusce.BatchPublishRequest) (*emptypb.Empty, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.events = append(s.events, req.Events.Events...)
+	return &emptypb.Empty{}, nil
+}
+
+func TestGRPC_Send(t *testing.T) {
+	Convey("test grpc send", t, func() {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		So(err, ShouldBeNil)
+		srv := &testCloudEventServer{}
+		server := grpc.NewServer()
+		vanusce.RegisterCloudEventServiceServer(server, srv)
+		go func() {
+			_ = server.Serve(listener)
+		}()
+		defer server.Stop()
+
+		c := NewGRPCClient(fmt.Sprintf("grpc://%s", listener.Addr().String()), nil)
+		defer func() {
+			_ = c.(io.Closer).Close()
+		}()
+		e := ce.NewEvent()
+		e.SetID("id")
+		e.SetSource("source")
+		e.SetType("type")
+		e.SetTime(time.Now())
+		e.SetExtension("count", 1)
+		_ = e.SetData(ce.ApplicationJSON, map[string]string{"hello": "world"})
+
+		Convey("test send", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			r := c.Send(ctx, e)
+			So(r, ShouldResemble, Success)
+			So(srv.events, ShouldHaveLength, 1)
+			So(srv.events[0].Id, ShouldEqual, "id")
+			So(srv.events[0].Attributes["count"].GetCeInteger(), ShouldEqual, 1)
+			So(srv.events[0].Attributes["datacontenttype"].GetCeString(), ShouldEqual, ce.ApplicationJSON)
+			So(string(srv.events[0].GetBinaryData()), ShouldEqual, `{"hello":"world"}`)
+		})
+
+		Convey("test send batch", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			r := c.(BatchSender).SendBatch(ctx, []*ce.Event{&e, &e})
+			So(r, ShouldResemble, Success)
+			So(srv.events, ShouldHaveLength, 2)
+		})
+
+		Convey("test send failed", func() {
+			srv.err = status.Error(codes.PermissionDenied, "denied")
+			r := c.Send(context.Background(), e)
+			So(r.StatusCode, ShouldEqual, nethttp.StatusForbidden)
+		})
+	})
+}
+
+func TestGRPC_ConnPool(t *testing.T) {
+	Convey("test the connection pool of grpc sink", t, func() {
+		c1 := NewGRPCClient("grpc://127.0.0.1:8080", nil).(*grpcClient)
+		c2 := NewGRPCClient("grpc://127.0.0.1:8080", nil).(*grpcClient)
+		_, err := c1.client()
+		So(err, ShouldBeNil)
+		_, err = c2.client()
+		So(err, ShouldBeNil)
+		pool := c1.pool
+		So(c2.pool, ShouldEqual, pool)
+		So(pool.refs, ShouldEqual, 2)
+		So(pool.conns, ShouldHaveLength, 2)
+
+		// the clients with different credentials don't share connections.
+		c3 := NewGRPCClient("grpcs://127.0.0.1:8080", &primitive.TLSSinkCredential{InsecureSkipVerify: true})
+		_, err = c3.(*grpcClient).client()
+		So(err, ShouldBeNil)
+		So(c3.(*grpcClient).pool, ShouldNotEqual, pool)
+		So(c3.(io.Closer).Close(), ShouldBeNil)
+
+		So(c1.Close(), ShouldBeNil)
+		So(c1.Close(), ShouldBeNil)
+		So(pool.refs, ShouldEqual, 1)
+		So(pool.conns, ShouldHaveLength, 2)
+		So(c2.Close(), ShouldBeNil)
+		So(pool.conns, ShouldBeEmpty)
+		grpcPoolsLock.Lock()
+		So(grpcPools, ShouldBeEmpty)
+		grpcPoolsLock.Unlock()
+	})
+}
+
+func TestGRPC_SendWithTLS(t *testing.T) {
+	Convey("test grpc send with private ca", t, func() {
+		certPEM, keyPEM := newTestCert()
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		So(err, ShouldBeNil)
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		So(err, ShouldBeNil)
+		srv := &testCloudEventServer{}
+		server := grpc.NewServer(grpc.Creds(credentials.NewServerTLSFromCert(&cert)))
+		vanusce.RegisterCloudEventServiceServer(server, srv)
+		go func() {
+			_ = server.Serve(listener)
+		}()
+		defer server.Stop()
+
+		e := ce.NewEvent()
+		e.SetID("id")
+		e.SetSource("source")
+		e.SetType("type")
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		sink := fmt.Sprintf("grpcs://%s", listener.Addr().String())
+
+		c := NewGRPCClient(sink, &primitive.TLSSinkCredential{CACert: string(certPEM)})
+		r := c.Send(ctx, e)
+		So(r, ShouldResemble, Success)
+		So(srv.events, ShouldHaveLength, 1)
+		So(c.(io.Closer).Close(), ShouldBeNil)
+
+		// the system root CAs don't trust the private ca.
+		c = NewGRPCClient(sink, nil)
+		r = c.Send(ctx, e)
+		So(r.Err, ShouldNotBeNil)
+		So(c.(io.Closer).Close(), ShouldBeNil)
+	})
+}
+
+// newTestCert creates a self-signed certificate of 127.0.0.1.
+func newTestCert() ([]byte, []byte) {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test"},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, _ := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	keyDER, _ := x509.MarshalECPrivateKey(key)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestConvertGRPCError(t *testing.T) {
+	Convey("test convert grpc error", t, func() {
+		So(convertGRPCError(status.Error(codes.InvalidArgument, "")).StatusCode,
+			ShouldEqual, nethttp.StatusBadRequest)
+		So(convertGRPCError(status.Error(codes.Unauthenticated, "")).StatusCode,
+			ShouldEqual, nethttp.StatusForbidden)
+		So(convertGRPCError(status.Error(codes.ResourceExhausted, "received message larger than max")).StatusCode,
+			ShouldEqual, nethttp.StatusRequestEntityTooLarge)
+		So(convertGRPCError(status.Error(codes.ResourceExhausted, "rate limited")).StatusCode,
+			ShouldEqual, nethttp.StatusTooManyRequests)
+		So(convertGRPCError(status.Error(codes.DeadlineExceeded, "")), ShouldResemble, DeliveryTimeout)
+		So(convertGRPCError(status.Error(codes.Unavailable, "")).StatusCode,
+			ShouldEqual, nethttp.StatusServiceUnavailable)
+		So(convertGRPCError(status.Error(codes.Internal, "")).StatusCode,
+			ShouldEqual, nethttp.StatusInternalServerError)
+	})
+}
@@ -15,33 +15,46 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	nethttp "net/http"
+	"strconv"
+	"time"
 
 	ce "github.com/cloudevents/sdk-go/v2"
 	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
 )
 
 type http struct {
-	client ce.Client
+	client     ce.Client
+	httpClient *nethttp.Client
+	url        string
 }
 
 func NewHTTPClient(url string) EventClient {
-	c, _ := ce.NewClientHTTP(ce.WithTarget(url))
+	c, _ := ce.NewClientHTTP(ce.WithTarget(url), cehttp.WithRoundTripperDecorator(
+		func(rt nethttp.RoundTripper) nethttp.RoundTripper {
+			return &retryAfterRoundTripper{next: rt}
+		}))
 	return &http{
-		client: c,
+		client:     c,
+		httpClient: &nethttp.Client{},
+		url:        url,
 	}
 }
 
 func (c *http) Send(ctx context.Context, event ce.Event) Result {
-	res := c.client.Send(ctx, event)
+	var retryAfter time.Duration
+	res := c.client.Send(context.WithValue(ctx, retryAfterKey{}, &retryAfter), event)
 	if ce.IsACK(res) {
 		return Success
 	}
 	if errors.Is(res, context.DeadlineExceeded) {
 		return DeliveryTimeout
 	}
-	r := Result{Err: res}
+	r := Result{Err: res, RetryAfter: retryAfter}
 	var httpResult *cehttp.Result
 	if ce.ResultAs(res, &httpResult) {
 		r.StatusCode = httpResult.StatusCode
@@ -51,3 +64,71 @@ func (c *http) Send(ctx context.Context, event ce.Event) Result {
 
 	return r
 }
+
+// SendBatch sends events in CloudEvents batched content mode.
+func (c *http) SendBatch(ctx context.Context, events []*ce.Event) Result {
+	payload, err := json.Marshal(events)
+	if err != nil {
+		return newInternalErr(err)
+	}
+	req, err := nethttp.NewRequestWithContext(ctx, nethttp.MethodPost, c.url, bytes.NewReader(payload))
+	if err != nil {
+		return newUndefinedErr(err)
+	}
+	req.Header.Set("Content-Type", ce.ApplicationCloudEventsBatchJSON)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return DeliveryTimeout
+		}
+		return newUndefinedErr(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= errStatusCode {
+		buf := new(bytes.Buffer)
+		_, _ = buf.ReadFrom(resp.Body)
+		r := convertHTTPResponse(resp.StatusCode, "http batch send", buf.Bytes())
+		r.RetryAfter = parseRetryAfter(resp.Header.Get(headerRetryAfter), time.Now())
+		return r
+	}
+	return Success
+}
+
+const headerRetryAfter = "Retry-After"
+
+type retryAfterKey struct{}
+
+// retryAfterRoundTripper saves the Retry-After header of the failed response into the request
+// context, the cloudevents client doesn't return the headers of response.
+type retryAfterRoundTripper struct {
+	next nethttp.RoundTripper
+}
+
+func (rt *retryAfterRoundTripper) RoundTrip(req *nethttp.Request) (*nethttp.Response, error) {
+	resp, err := rt.next.RoundTrip(req)
+	if err != nil || resp.StatusCode < errStatusCode {
+		return resp, err
+	}
+	if v, ok := req.Context().Value(retryAfterKey{}).(*time.Duration); ok {
+		*v = parseRetryAfter(resp.Header.Get(headerRetryAfter), time.Now())
+	}
+	return resp, err
+}
+
+// parseRetryAfter parses the Retry-After header which is either delay seconds or a http date.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds <= 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	t, err := nethttp.ParseTime(value)
+	if err != nil || !t.After(now) {
+		return 0
+	}
+	return t.Sub(now)
+}
@@ -0,0 +1,63 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestHTTPRetryAfter(t *testing.T) {
+	Convey("test http sink retry after", t, func() {
+		server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			w.Header().Set(headerRetryAfter, "3")
+			w.WriteHeader(nethttp.StatusTooManyRequests)
+		}))
+		defer server.Close()
+		c := NewHTTPClient(server.URL)
+		event := ce.NewEvent()
+		event.SetID("1")
+		event.SetSource("source")
+		event.SetType("type")
+
+		Convey("test send", func() {
+			r := c.Send(context.Background(), event)
+			So(r.StatusCode, ShouldEqual, nethttp.StatusTooManyRequests)
+			So(r.RetryAfter, ShouldEqual, 3*time.Second)
+		})
+
+		Convey("test send batch", func() {
+			r := c.(BatchSender).SendBatch(context.Background(), []*ce.Event{&event})
+			So(r.StatusCode, ShouldEqual, nethttp.StatusTooManyRequests)
+			So(r.RetryAfter, ShouldEqual, 3*time.Second)
+		})
+	})
+
+	Convey("test parse retry after", t, func() {
+		now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+		So(parseRetryAfter("", now), ShouldEqual, 0)
+		So(parseRetryAfter("10", now), ShouldEqual, 10*time.Second)
+		So(parseRetryAfter("-1", now), ShouldEqual, 0)
+		So(parseRetryAfter("abc", now), ShouldEqual, 0)
+		So(parseRetryAfter("Sat, 01 Jan 2022 00:00:30 GMT", now), ShouldEqual, 30*time.Second)
+		So(parseRetryAfter("Fri, 31 Dec 2021 23:59:30 GMT", now), ShouldEqual, 0)
+	})
+}
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	nethttp "net/http"
+	"time"
 
 	ce "github.com/cloudevents/sdk-go/v2"
 )
@@ -32,9 +33,17 @@ type EventClient interface {
 	Sender
 }
 
+// BatchSender is implemented by the EventClient which supports to deliver
+// events in one request.
+type BatchSender interface {
+	SendBatch(ctx context.Context, events []*ce.Event) Result
+}
+
 type Result struct {
 	StatusCode int
 	Err        error
+	// RetryAfter is how long the sink asks to wait before sending again, zero if not specified.
+	RetryAfter time.Duration
 }
 
 func newResultByHTTPCode(httpCode int) Result {
@@ -44,6 +53,13 @@ func newResultByHTTPCode(httpCode int) Result {
 	}
 }
 
+func newResultByError(statusCode int, err error) Result {
+	return Result{
+		StatusCode: statusCode,
+		Err:        err,
+	}
+}
+
 func newInternalErr(err error) Result {
 	return Result{
 		StatusCode: nethttp.StatusInternalServerError,
@@ -74,7 +90,7 @@ func convertHTTPResponse(statusCode int, desc string, body []byte) Result {
 
 var (
 	Success               = Result{}
-	DeliveryTimeout       = Result{ErrDeliveryTimeout, errors.New("DeliveryTimeout")}
+	DeliveryTimeout       = Result{StatusCode: ErrDeliveryTimeout, Err: errors.New("DeliveryTimeout")}
 	Forbidden             = newResultByHTTPCode(nethttp.StatusForbidden)
 	RequestEntityTooLarge = newResultByHTTPCode(nethttp.StatusRequestEntityTooLarge)
 )
@@ -0,0 +1,327 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	nethttp "net/http"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/xdg-go/scram"
+)
+
+const (
+	kafkaHeaderPrefix      = "ce_"
+	kafkaHeaderContentType = "content-type"
+	// kafkaMaxInflightSends bounds the sends which are waiting for the acks of brokers, including
+	// the sends which have been given up by callers because of timeout.
+	kafkaMaxInflightSends = 256
+)
+
+type kafka struct {
+	sink       *primitive.KafkaSink
+	credential *primitive.KafkaSinkCredential
+	producer   sarama.SyncProducer
+	lock       sync.Mutex
+	inflight   chan struct{}
+}
+
+func NewKafkaClient(sink string, credential *primitive.KafkaSinkCredential) EventClient {
+	s, err := primitive.ParseKafkaSink(sink)
+	if err != nil {
+		// the sink has been validated by controller
+		s = &primitive.KafkaSink{Mode: primitive.KafkaModeBinary}
+	}
+	return &kafka{
+		sink:       s,
+		credential: credential,
+		inflight:   make(chan struct{}, kafkaMaxInflightSends),
+	}
+}
+
+// getProducer returns the producer, which is created on the first call.
+func (c *kafka) getProducer() (sarama.SyncProducer, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.producer != nil {
+		return c.producer, nil
+	}
+	cfg, err := newKafkaConfig(c.credential)
+	if err != nil {
+		return nil, err
+	}
+	producer, err := sarama.NewSyncProducer(c.sink.Brokers, cfg)
+	if err != nil {
+		return nil, err
+	}
+	c.producer = producer
+	return producer, nil
+}
+
+// Close closes the producer, the client creates a new one if it is used again.
+func (c *kafka) Close() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.producer == nil {
+		return nil
+	}
+	err := c.producer.Close()
+	c.producer = nil
+	return err
+}
+
+// Send produces the event to kafka, the delivery is at-least-once: the producer of sarama can't
+// be canceled, so the message may still be written after Send returns DeliveryTimeout, and the
+// event is produced again if it's retried. The sends which are still in flight are bounded by
+// kafkaMaxInflightSends, Send waits for a free slot until ctx is done.
+func (c *kafka) Send(ctx context.Context, event ce.Event) Result {
+	producer, err := c.getProducer()
+	if err != nil {
+		return convertKafkaError(err)
+	}
+	msg, err := c.newMessage(&event)
+	if err != nil {
+		return newResultByError(nethttp.StatusBadRequest, err)
+	}
+	select {
+	case <-ctx.Done():
+		return DeliveryTimeout
+	case c.inflight <- struct{}{}:
+	}
+	resultCh := make(chan error, 1)
+	go func() {
+		_, _, err := producer.SendMessage(msg)
+		<-c.inflight
+		resultCh <- err
+	}()
+	select {
+	case <-ctx.Done():
+		return DeliveryTimeout
+	case err = <-resultCh:
+	}
+	if err != nil {
+		return convertKafkaError(err)
+	}
+	return Success
+}
+
+func (c *kafka) newMessage(event *ce.Event) (*sarama.ProducerMessage, error) {
+	msg := &sarama.ProducerMessage{
+		Topic: c.sink.Topic,
+	}
+	if c.sink.PartitionKey != "" {
+		if key := getAttributeValue(event, c.sink.PartitionKey); key != "" {
+			msg.Key = sarama.StringEncoder(key)
+		}
+	}
+	if c.sink.Mode == primitive.KafkaModeStructured {
+		payload, err := event.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		msg.Value = sarama.ByteEncoder(payload)
+		msg.Headers = []sarama.RecordHeader{{
+			Key:   []byte(kafkaHeaderContentType),
+			Value: []byte(ce.ApplicationCloudEventsJSON),
+		}}
+		return msg, nil
+	}
+	msg.Value = sarama.ByteEncoder(event.Data())
+	msg.Headers = binaryHeaders(event)
+	return msg, nil
+}
+
+// binaryHeaders maps the attributes of event to headers according to CloudEvents Kafka binding.
+func binaryHeaders(event *ce.Event) []sarama.RecordHeader {
+	headers := make([]sarama.RecordHeader, 0)
+	add := func(name, value string) {
+		if value == "" {
+			return
+		}
+		headers = append(headers, sarama.RecordHeader{Key: []byte(name), Value: []byte(value)})
+	}
+	add(kafkaHeaderPrefix+"specversion", event.SpecVersion())
+	add(kafkaHeaderPrefix+"id", event.ID())
+	add(kafkaHeaderPrefix+"source", event.Source())
+	add(kafkaHeaderPrefix+"type", event.Type())
+	add(kafkaHeaderPrefix+"subject", event.Subject())
+	add(kafkaHeaderPrefix+"dataschema", event.DataSchema())
+	if !event.Time().IsZero() {
+		add(kafkaHeaderPrefix+"time", event.Time().UTC().Format(time.RFC3339Nano))
+	}
+	add(kafkaHeaderContentType, event.DataContentType())
+	for name, value := range event.Extensions() {
+		v, err := types.Format(value)
+		if err != nil {
+			continue
+		}
+		add(kafkaHeaderPrefix+name, v)
+	}
+	return headers
+}
+
+// getAttributeValue returns the value of context attribute or extension by name.
+func getAttributeValue(event *ce.Event, name string) string {
+	switch name {
+	case "id":
+		return event.ID()
+	case "source":
+		return event.Source()
+	case "type":
+		return event.Type()
+	case "subject":
+		return event.Subject()
+	case "dataschema":
+		return event.DataSchema()
+	case "datacontenttype":
+		return event.DataContentType()
+	}
+	v, exist := event.Extensions()[name]
+	if !exist {
+		return ""
+	}
+	s, _ := types.Format(v)
+	return s
+}
+
+func newKafkaConfig(credential *primitive.KafkaSinkCredential) (*sarama.Config, error) {
+	cfg := sarama.NewConfig()
+	cfg.Producer.Return.Successes = true
+	cfg.Producer.RequiredAcks = sarama.WaitForAll
+	cfg.Producer.Partitioner = sarama.NewHashPartitioner
+	if credential == nil {
+		return cfg, nil
+	}
+	if credential.SASLMechanism != "" {
+		cfg.Net.SASL.Enable = true
+		cfg.Net.SASL.User = credential.Username
+		cfg.Net.SASL.Password = credential.Password
+		cfg.Net.SASL.Mechanism = sarama.SASLMechanism(credential.SASLMechanism)
+		switch cfg.Net.SASL.Mechanism {
+		case sarama.SASLTypePlaintext:
+		case sarama.SASLTypeSCRAMSHA256:
+			cfg.Net.SASL.SCRAMClientGeneratorFunc = newSCRAMSHA256Client
+		case sarama.SASLTypeSCRAMSHA512:
+			cfg.Net.SASL.SCRAMClientGeneratorFunc = newSCRAMSHA512Client
+		default:
+			return nil, fmt.Errorf("unsupported sasl mechanism %s", credential.SASLMechanism)
+		}
+	}
+	if credential.EnableTLS {
+		tlsConfig, err := newTLSConfig(&primitive.TLSSinkCredential{
+			CACert:             credential.CACert,
+			ClientCert:         credential.ClientCert,
+			ClientKey:          credential.ClientKey,
+			InsecureSkipVerify: credential.InsecureSkipVerify,
+		})
+		if err != nil {
+			return nil, err
+		}
+		cfg.Net.TLS.Enable = true
+		cfg.Net.TLS.Config = tlsConfig
+	}
+	return cfg, nil
+}
+
+// newTLSConfig creates the TLS config of sink, the system root CAs are trusted if CA cert isn't set.
+func newTLSConfig(credential *primitive.TLSSinkCredential) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: credential.InsecureSkipVerify, //nolint:gosec // configured by user
+	}
+	if credential.CACert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(credential.CACert)) {
+			return nil, fmt.Errorf("invalid ca cert")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if credential.ClientCert != "" || credential.ClientKey != "" {
+		cert, err := tls.X509KeyPair([]byte(credential.ClientCert), []byte(credential.ClientKey))
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+// convertKafkaError maps the error of kafka to Result, the errors which can't be
+// recovered by retrying are mapped to non-retryable status codes.
+func convertKafkaError(err error) Result {
+	var kErr sarama.KError
+	if !errors.As(err, &kErr) {
+		var pErr *sarama.ProducerError
+		if errors.As(err, &pErr) && errors.As(pErr.Err, &kErr) {
+			return convertKafkaError(kErr)
+		}
+		return newUndefinedErr(err)
+	}
+	switch kErr {
+	case sarama.ErrMessageSizeTooLarge, sarama.ErrInvalidMessageSize:
+		return newResultByError(nethttp.StatusRequestEntityTooLarge, err)
+	case sarama.ErrTopicAuthorizationFailed, sarama.ErrClusterAuthorizationFailed,
+		sarama.ErrSASLAuthenticationFailed, sarama.ErrUnsupportedSASLMechanism:
+		return newResultByError(nethttp.StatusForbidden, err)
+	case sarama.ErrInvalidMessage, sarama.ErrInvalidTopic, sarama.ErrInvalidRecord,
+		sarama.ErrUnsupportedVersion, sarama.ErrUnsupportedForMessageFormat:
+		return newResultByError(nethttp.StatusBadRequest, err)
+	default:
+		return newInternalErr(err)
+	}
+}
+
+type scramClient struct {
+	*scram.Client
+	*scram.ClientConversation
+	scram.HashGeneratorFcn
+}
+
+func newSCRAMSHA256Client() sarama.SCRAMClient {
+	return &scramClient{HashGeneratorFcn: sha256.New}
+}
+
+func newSCRAMSHA512Client() sarama.SCRAMClient {
+	return &scramClient{HashGeneratorFcn: sha512.New}
+}
+
+func (c *scramClient) Begin(userName, password, authzID string) error {
+	client, err := c.HashGeneratorFcn.NewClient(userName, password, authzID)
+	if err != nil {
+		return err
+	}
+	c.Client = client
+	c.ClientConversation = client.NewConversation()
+	return nil
+}
+
+func (c *scramClient) Step(challenge string) (string, error) {
+	return c.ClientConversation.Step(challenge)
+}
+
+func (c *scramClient) Done() bool {
+	return c.ClientConversation.Done()
+}
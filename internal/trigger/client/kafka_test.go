@@ -0,0 +1,159 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	nethttp "net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/Shopify/sarama/mocks"
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestKafka_Send(t *testing.T) {
+	newEvent := func() ce.Event {
+		e := ce.NewEvent()
+		e.SetID("id")
+		e.SetSource("source")
+		e.SetType("type")
+		e.SetExtension("orderkey", "key1")
+		_ = e.SetData(ce.ApplicationJSON, map[string]string{"hello": "world"})
+		return e
+	}
+	Convey("test kafka send", t, func() {
+		producer := mocks.NewSyncProducer(t, nil)
+		defer producer.Close()
+		c := NewKafkaClient("kafka://127.0.0.1:9092/topic?partition_key=orderkey", nil).(*kafka)
+		c.producer = producer
+
+		Convey("test binary mode", func() {
+			producer.ExpectSendMessageAndSucceed()
+			r := c.Send(context.Background(), newEvent())
+			So(r, ShouldResemble, Success)
+
+			e := newEvent()
+			msg, err := c.newMessage(&e)
+			So(err, ShouldBeNil)
+			key, _ := msg.Key.Encode()
+			So(string(key), ShouldEqual, "key1")
+			value, _ := msg.Value.Encode()
+			So(string(value), ShouldEqual, `{"hello":"world"}`)
+			headers := map[string]string{}
+			for _, h := range msg.Headers {
+				headers[string(h.Key)] = string(h.Value)
+			}
+			So(headers["ce_id"], ShouldEqual, "id")
+			So(headers["ce_source"], ShouldEqual, "source")
+			So(headers["ce_specversion"], ShouldEqual, "1.0")
+			So(headers["ce_orderkey"], ShouldEqual, "key1")
+			So(headers["content-type"], ShouldEqual, ce.ApplicationJSON)
+		})
+
+		Convey("test structured mode", func() {
+			c.sink.Mode = primitive.KafkaModeStructured
+			e := newEvent()
+			msg, err := c.newMessage(&e)
+			So(err, ShouldBeNil)
+			value, _ := msg.Value.Encode()
+			var m map[string]interface{}
+			So(json.Unmarshal(value, &m), ShouldBeNil)
+			So(m["id"], ShouldEqual, "id")
+			So(string(msg.Headers[0].Value), ShouldEqual, ce.ApplicationCloudEventsJSON)
+		})
+
+		Convey("test send failed", func() {
+			producer.ExpectSendMessageAndFail(sarama.ErrMessageSizeTooLarge)
+			r := c.Send(context.Background(), newEvent())
+			So(r.StatusCode, ShouldEqual, nethttp.StatusRequestEntityTooLarge)
+		})
+
+		Convey("test inflight sends are bounded", func() {
+			blocking := &blockingProducer{release: make(chan struct{})}
+			c.producer = blocking
+			c.inflight = make(chan struct{}, 1)
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+			So(c.Send(ctx, newEvent()), ShouldResemble, DeliveryTimeout)
+			// the first send is still in flight, so the second one can't be started.
+			So(c.Send(ctx, newEvent()), ShouldResemble, DeliveryTimeout)
+			So(atomic.LoadInt32(&blocking.sent), ShouldEqual, 1)
+
+			close(blocking.release)
+			So(c.Send(context.Background(), newEvent()), ShouldResemble, Success)
+			So(atomic.LoadInt32(&blocking.sent), ShouldEqual, 2)
+		})
+
+		Convey("test close", func() {
+			closed := mocks.NewSyncProducer(t, nil)
+			c.producer = closed
+			So(c.Close(), ShouldBeNil)
+			So(c.producer, ShouldBeNil)
+			So(c.Close(), ShouldBeNil)
+		})
+	})
+}
+
+// blockingProducer blocks the sends until release is closed.
+type blockingProducer struct {
+	sarama.SyncProducer
+	release chan struct{}
+	sent    int32
+}
+
+func (p *blockingProducer) SendMessage(*sarama.ProducerMessage) (int32, int64, error) {
+	atomic.AddInt32(&p.sent, 1)
+	<-p.release
+	return 0, 0, nil
+}
+
+func TestConvertKafkaError(t *testing.T) {
+	Convey("test convert kafka error", t, func() {
+		So(convertKafkaError(sarama.ErrTopicAuthorizationFailed).StatusCode, ShouldEqual, nethttp.StatusForbidden)
+		So(convertKafkaError(sarama.ErrInvalidTopic).StatusCode, ShouldEqual, nethttp.StatusBadRequest)
+		So(convertKafkaError(sarama.ErrNotLeaderForPartition).StatusCode,
+			ShouldEqual, nethttp.StatusInternalServerError)
+		So(convertKafkaError(&sarama.ProducerError{Err: sarama.ErrMessageSizeTooLarge}).StatusCode,
+			ShouldEqual, nethttp.StatusRequestEntityTooLarge)
+		So(convertKafkaError(fmt.Errorf("unknown")).StatusCode, ShouldEqual, ErrUndefined)
+	})
+}
+
+func TestNewKafkaConfig(t *testing.T) {
+	Convey("test new kafka config", t, func() {
+		cfg, err := newKafkaConfig(&primitive.KafkaSinkCredential{
+			SASLMechanism: sarama.SASLTypeSCRAMSHA512,
+			Username:      "user",
+			Password:      "password",
+			EnableTLS:     true,
+		})
+		So(err, ShouldBeNil)
+		So(cfg.Net.SASL.Enable, ShouldBeTrue)
+		So(cfg.Net.SASL.SCRAMClientGeneratorFunc, ShouldNotBeNil)
+		So(cfg.Net.TLS.Enable, ShouldBeTrue)
+
+		_, err = newKafkaConfig(&primitive.KafkaSinkCredential{SASLMechanism: "unknown"})
+		So(err, ShouldNotBeNil)
+		_, err = newKafkaConfig(&primitive.KafkaSinkCredential{EnableTLS: true, CACert: "invalid"})
+		So(err, ShouldNotBeNil)
+	})
+}
@@ -85,3 +85,40 @@ func (mr *MockEventClientMockRecorder) Send(ctx, event interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockEventClient)(nil).Send), ctx, event)
 }
+
+// MockBatchSender is a mock of BatchSender interface.
+type MockBatchSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchSenderMockRecorder
+}
+
+// MockBatchSenderMockRecorder is the mock recorder for MockBatchSender.
+type MockBatchSenderMockRecorder struct {
+	mock *MockBatchSender
+}
+
+// NewMockBatchSender creates a new mock instance.
+func NewMockBatchSender(ctrl *gomock.Controller) *MockBatchSender {
+	mock := &MockBatchSender{ctrl: ctrl}
+	mock.recorder = &MockBatchSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchSender) EXPECT() *MockBatchSenderMockRecorder {
+	return m.recorder
+}
+
+// SendBatch mocks base method.
+func (m *MockBatchSender) SendBatch(ctx context.Context, events []*v2.Event) Result {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendBatch", ctx, events)
+	ret0, _ := ret[0].(Result)
+	return ret0
+}
+
+// SendBatch indicates an expected call of SendBatch.
+func (mr *MockBatchSenderMockRecorder) SendBatch(ctx, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendBatch", reflect.TypeOf((*MockBatchSender)(nil).SendBatch), ctx, events)
+}
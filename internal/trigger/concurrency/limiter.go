@@ -0,0 +1,254 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package concurrency
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	defaultMinLimit         = 1
+	defaultMaxLimit         = 1000
+	defaultInitialLimit     = 20
+	defaultBackoffRatio     = 0.5
+	defaultLatencyTolerance = 2.0
+	defaultMaxPause         = time.Minute
+
+	// the period to refresh the baseline latency, so the limiter adapts to the sink whose
+	// latency goes up permanently.
+	baselinePeriod = 30 * time.Second
+	// the latency jitter below it isn't regarded as overload.
+	latencyNoise = 10 * time.Millisecond
+)
+
+type Config struct {
+	MinLimit     int
+	MaxLimit     int
+	InitialLimit int
+	// BackoffRatio is multiplied to the limit when the sink is overloaded.
+	BackoffRatio float64
+	// LatencyTolerance is the ratio to the baseline latency above which the sink is overloaded.
+	LatencyTolerance float64
+	// MaxPause is the maximum time to honour the Retry-After of sink.
+	MaxPause time.Duration
+}
+
+func (c *Config) complete() {
+	if c.MinLimit <= 0 {
+		c.MinLimit = defaultMinLimit
+	}
+	if c.MaxLimit <= 0 {
+		c.MaxLimit = defaultMaxLimit
+	}
+	if c.MaxLimit < c.MinLimit {
+		c.MaxLimit = c.MinLimit
+	}
+	if c.InitialLimit <= 0 {
+		c.InitialLimit = defaultInitialLimit
+	}
+	if c.InitialLimit < c.MinLimit {
+		c.InitialLimit = c.MinLimit
+	}
+	if c.InitialLimit > c.MaxLimit {
+		c.InitialLimit = c.MaxLimit
+	}
+	if c.BackoffRatio <= 0 || c.BackoffRatio >= 1 {
+		c.BackoffRatio = defaultBackoffRatio
+	}
+	if c.LatencyTolerance <= 1 {
+		c.LatencyTolerance = defaultLatencyTolerance
+	}
+	if c.MaxPause <= 0 {
+		c.MaxPause = defaultMaxPause
+	}
+}
+
+type Outcome int
+
+const (
+	// OutcomeSuccess means the request succeeded, the latency decides whether the sink is healthy.
+	OutcomeSuccess Outcome = iota
+	// OutcomeDropped means the sink is overloaded, such as timeout, 429 or 503.
+	OutcomeDropped
+	// OutcomeIgnore means the result says nothing about the load of sink, such as the request
+	// is rejected for its content.
+	OutcomeIgnore
+)
+
+// Limiter bounds the number of in-flight requests with AIMD, the limit increases by one after
+// a full window of healthy requests, and is multiplied by the backoff ratio on overload.
+type Limiter struct {
+	config   Config
+	onChange func(limit int)
+	now      func() time.Time
+
+	mu       sync.Mutex
+	limit    float64
+	inflight int
+	// released is closed and replaced when a slot is released.
+	released   chan struct{}
+	pauseUntil time.Time
+	// the requests which were acquired before the last decrease don't decrease the limit again.
+	lastDecrease time.Time
+	baseline     time.Duration
+	periodMin    time.Duration
+	periodStart  time.Time
+}
+
+// New creates a limiter, onChange is called with the new limit while holding the lock of
+// limiter, so it must not call the limiter.
+func New(config Config, onChange func(limit int)) *Limiter {
+	config.complete()
+	if onChange == nil {
+		onChange = func(int) {}
+	}
+	l := &Limiter{
+		config:   config,
+		onChange: onChange,
+		now:      time.Now,
+		limit:    float64(config.InitialLimit),
+		released: make(chan struct{}),
+	}
+	onChange(config.InitialLimit)
+	return l
+}
+
+func (l *Limiter) Limit() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return int(l.limit)
+}
+
+func (l *Limiter) InFlight() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.inflight
+}
+
+// Token is an acquired slot, it MUST be released exactly once.
+type Token struct {
+	l     *Limiter
+	start time.Time
+}
+
+// Acquire waits until there is a free slot and the limiter isn't paused by Retry-After, it
+// returns false if ctx is done.
+func (l *Limiter) Acquire(ctx context.Context) (*Token, bool) {
+	for {
+		l.mu.Lock()
+		now := l.now()
+		pause := l.pauseUntil.Sub(now)
+		if pause <= 0 && l.inflight < int(l.limit) {
+			l.inflight++
+			l.mu.Unlock()
+			return &Token{l: l, start: now}, true
+		}
+		released := l.released
+		l.mu.Unlock()
+		if pause <= 0 {
+			select {
+			case <-ctx.Done():
+				return nil, false
+			case <-released:
+			}
+			continue
+		}
+		timer := time.NewTimer(pause)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, false
+		case <-timer.C:
+		}
+	}
+}
+
+// Release returns the slot with the result of request, the limiter stops acquiring for
+// retryAfter if it's positive.
+func (t *Token) Release(outcome Outcome, latency, retryAfter time.Duration) {
+	t.l.release(t.start, outcome, latency, retryAfter)
+}
+
+func (l *Limiter) release(start time.Time, outcome Outcome, latency, retryAfter time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.inflight--
+	now := l.now()
+	if retryAfter > 0 {
+		if retryAfter > l.config.MaxPause {
+			retryAfter = l.config.MaxPause
+		}
+		if until := now.Add(retryAfter); until.After(l.pauseUntil) {
+			l.pauseUntil = until
+		}
+	}
+	switch outcome {
+	case OutcomeSuccess:
+		if l.isOverloaded(latency, now) {
+			l.decrease(start, now)
+		} else {
+			l.increase()
+		}
+	case OutcomeDropped:
+		l.decrease(start, now)
+	}
+	close(l.released)
+	l.released = make(chan struct{})
+}
+
+// isOverloaded compares the latency with the minimum latency of the current and last period.
+func (l *Limiter) isOverloaded(latency time.Duration, now time.Time) bool {
+	if now.Sub(l.periodStart) >= baselinePeriod {
+		l.baseline = l.periodMin
+		l.periodMin = 0
+		l.periodStart = now
+	}
+	if l.periodMin == 0 || latency < l.periodMin {
+		l.periodMin = latency
+	}
+	if l.baseline == 0 || latency < l.baseline {
+		l.baseline = latency
+	}
+	return latency > latencyNoise+l.baseline &&
+		float64(latency) > float64(l.baseline)*l.config.LatencyTolerance
+}
+
+func (l *Limiter) increase() {
+	// don't grow the limit when the window isn't used up, otherwise it grows without bound
+	// under a low traffic and can't protect the sink when a burst comes.
+	if float64(l.inflight+1)*2 < l.limit {
+		return
+	}
+	l.setLimit(math.Min(l.limit+1/l.limit, float64(l.config.MaxLimit)))
+}
+
+func (l *Limiter) decrease(start, now time.Time) {
+	if start.Before(l.lastDecrease) {
+		return
+	}
+	l.lastDecrease = now
+	l.setLimit(math.Max(l.limit*l.config.BackoffRatio, float64(l.config.MinLimit)))
+}
+
+func (l *Limiter) setLimit(limit float64) {
+	old := int(l.limit)
+	l.limit = limit
+	if int(limit) != old {
+		l.onChange(int(limit))
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package concurrency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestLimiter(t *testing.T) {
+	Convey("test adaptive concurrency limiter", t, func() {
+		now := time.Unix(1000, 0)
+		var limits []int
+		l := New(Config{
+			MinLimit:     1,
+			MaxLimit:     6,
+			InitialLimit: 4,
+			MaxPause:     10 * time.Second,
+		}, func(limit int) {
+			limits = append(limits, limit)
+		})
+		l.now = func() time.Time { return now }
+		ctx := context.Background()
+		acquire := func(n int) []*Token {
+			tokens := make([]*Token, n)
+			for i := range tokens {
+				token, ok := l.Acquire(ctx)
+				So(ok, ShouldBeTrue)
+				tokens[i] = token
+			}
+			return tokens
+		}
+		tryAcquire := func() bool {
+			ctx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+			defer cancel()
+			token, ok := l.Acquire(ctx)
+			if ok {
+				token.Release(OutcomeIgnore, 0, 0)
+			}
+			return ok
+		}
+		So(limits, ShouldResemble, []int{4})
+
+		Convey("test bound in-flight requests", func() {
+			tokens := acquire(4)
+			So(l.InFlight(), ShouldEqual, 4)
+			So(tryAcquire(), ShouldBeFalse)
+			tokens[0].Release(OutcomeIgnore, 0, 0)
+			So(tryAcquire(), ShouldBeTrue)
+		})
+
+		Convey("test increase after a full window", func() {
+			for i := 0; i < 10; i++ {
+				for _, token := range acquire(l.Limit()) {
+					token.Release(OutcomeSuccess, 10*time.Millisecond, 0)
+				}
+			}
+			So(l.Limit(), ShouldEqual, 6)
+			So(limits, ShouldResemble, []int{4, 5, 6})
+		})
+
+		Convey("test don't increase when window isn't used", func() {
+			for i := 0; i < 20; i++ {
+				acquire(1)[0].Release(OutcomeSuccess, 10*time.Millisecond, 0)
+			}
+			So(l.Limit(), ShouldEqual, 4)
+		})
+
+		Convey("test decrease once for concurrent drops", func() {
+			tokens := acquire(4)
+			now = now.Add(time.Second)
+			for _, token := range tokens {
+				token.Release(OutcomeDropped, 0, 0)
+			}
+			So(l.Limit(), ShouldEqual, 2)
+			now = now.Add(time.Second)
+			acquire(1)[0].Release(OutcomeDropped, 0, 0)
+			So(l.Limit(), ShouldEqual, 1)
+			now = now.Add(time.Second)
+			acquire(1)[0].Release(OutcomeDropped, 0, 0)
+			So(l.Limit(), ShouldEqual, 1)
+		})
+
+		Convey("test decrease on high latency", func() {
+			acquire(1)[0].Release(OutcomeSuccess, 20*time.Millisecond, 0)
+			acquire(1)[0].Release(OutcomeSuccess, 25*time.Millisecond, 0)
+			So(l.Limit(), ShouldEqual, 4)
+			acquire(1)[0].Release(OutcomeSuccess, 100*time.Millisecond, 0)
+			So(l.Limit(), ShouldEqual, 2)
+		})
+
+		Convey("test pause by retry after", func() {
+			token := acquire(1)[0]
+			token.Release(OutcomeDropped, 0, time.Minute)
+			So(tryAcquire(), ShouldBeFalse)
+			// retry after is limited by max pause.
+			now = now.Add(10 * time.Second)
+			So(tryAcquire(), ShouldBeTrue)
+		})
+	})
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/linkall-labs/vanus/internal/primitive"
 	"github.com/linkall-labs/vanus/observability"
 	"github.com/linkall-labs/vanus/pkg/util"
+	"github.com/linkall-labs/vanus/pkg/util/tlsconfig"
 )
 
 type Config struct {
@@ -29,6 +30,7 @@ type Config struct {
 	IP             string               `yaml:"ip"`
 	ControllerAddr []string             `yaml:"controllers"`
 	Observability  observability.Config `yaml:"observability"`
+	TLS            tlsconfig.Config     `yaml:"tls"`
 
 	HeartbeatInterval time.Duration
 }
@@ -0,0 +1,104 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package offset
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/option"
+	"github.com/linkall-labs/vanus/client/pkg/policy"
+	"github.com/linkall-labs/vanus/internal/primitive/info"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/observability/log"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+)
+
+// Lag is how far a subscription falls behind an eventlog.
+type Lag struct {
+	EventlogID vanus.ID
+	// Committed is the offset of the next event to consume.
+	Committed uint64
+	// Latest is the offset of the next event to append.
+	Latest uint64
+	Lag    uint64
+	// OldestUnconsumedTime is the time when the oldest unconsumed event was stored, zero if
+	// there is no lag.
+	OldestUnconsumedTime time.Time
+}
+
+// CalculateLag compares the committed offsets with the latest offsets of eventlogs in bus, the
+// eventlogs which have no committed offset are skipped.
+func CalculateLag(ctx context.Context, bus api.Eventbus, commits info.ListOffsetInfo) ([]Lag, error) {
+	logs, err := bus.ListLog(ctx)
+	if err != nil {
+		return nil, err
+	}
+	committed := make(map[vanus.ID]uint64, len(commits))
+	for _, c := range commits {
+		committed[c.EventLogID] = c.Offset
+	}
+	lags := make([]Lag, 0, len(logs))
+	for _, l := range logs {
+		id := vanus.NewIDFromUint64(l.ID())
+		off, ok := committed[id]
+		if !ok {
+			continue
+		}
+		latest, err := l.LatestOffset(ctx)
+		if err != nil {
+			return nil, err
+		}
+		lag := Lag{
+			EventlogID: id,
+			Committed:  off,
+			Latest:     uint64(latest),
+		}
+		if lag.Latest > lag.Committed {
+			lag.Lag = lag.Latest - lag.Committed
+			lag.OldestUnconsumedTime = oldestUnconsumedTime(ctx, bus, l, off)
+		}
+		lags = append(lags, lag)
+	}
+	return lags, nil
+}
+
+// oldestUnconsumedTime reads the event at offset, zero time is returned if it can't be read.
+func oldestUnconsumedTime(ctx context.Context, bus api.Eventbus, l api.Eventlog, offset uint64) time.Time {
+	events, _, _, err := bus.Reader(
+		option.WithDisablePolling(),
+		option.WithReadPolicy(policy.NewManuallyReadPolicy(l, int64(offset))),
+		option.WithBatchSize(1),
+	).Read(ctx)
+	if err != nil || len(events) == 0 {
+		log.Debug(ctx, "read the oldest unconsumed event failed", map[string]interface{}{
+			log.KeyEventlogID:  l.ID(),
+			log.KeyOffsetInLog: offset,
+			log.KeyError:       err,
+		})
+		return time.Time{}
+	}
+	stime, ok := events[0].Extensions()[segpb.XVanusStime]
+	if !ok {
+		return time.Time{}
+	}
+	t, err := types.ToTime(stime)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
@@ -0,0 +1,80 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package offset
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/primitive/info"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCalculateLag(t *testing.T) {
+	Convey("test calculate lag", t, func() {
+		ctx := context.Background()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		bus := api.NewMockEventbus(ctrl)
+		busReader := api.NewMockBusReader(ctrl)
+		bus.EXPECT().Reader(gomock.Any()).AnyTimes().Return(busReader)
+		logs := make([]api.Eventlog, 3)
+		for i := range logs {
+			l := api.NewMockEventlog(ctrl)
+			l.EXPECT().ID().AnyTimes().Return(uint64(i + 1))
+			l.EXPECT().LatestOffset(gomock.Any()).AnyTimes().Return(int64(100), nil)
+			logs[i] = l
+		}
+		bus.EXPECT().ListLog(gomock.Any()).AnyTimes().Return(logs, nil)
+		commits := info.ListOffsetInfo{
+			{EventLogID: vanus.NewIDFromUint64(1), Offset: 100},
+			{EventLogID: vanus.NewIDFromUint64(2), Offset: 40},
+			// the offset of retry eventlog is ignored.
+			{EventLogID: vanus.NewIDFromUint64(10), Offset: 1},
+		}
+
+		Convey("test lag with the oldest unconsumed event", func() {
+			stime := time.UnixMilli(time.Now().Add(-time.Hour).UnixMilli())
+			e := ce.NewEvent()
+			e.SetExtension(segpb.XVanusStime, stime)
+			busReader.EXPECT().Read(gomock.Any()).Times(1).Return([]*ce.Event{&e}, int64(40), uint64(2), nil)
+			lags, err := CalculateLag(ctx, bus, commits)
+			So(err, ShouldBeNil)
+			So(lags, ShouldHaveLength, 2)
+			So(lags[0].Lag, ShouldEqual, 0)
+			So(lags[0].OldestUnconsumedTime.IsZero(), ShouldBeTrue)
+			So(lags[1].EventlogID, ShouldEqual, vanus.NewIDFromUint64(2))
+			So(lags[1].Committed, ShouldEqual, 40)
+			So(lags[1].Latest, ShouldEqual, 100)
+			So(lags[1].Lag, ShouldEqual, 60)
+			So(lags[1].OldestUnconsumedTime.Equal(stime), ShouldBeTrue)
+		})
+
+		Convey("test the oldest unconsumed event can't be read", func() {
+			busReader.EXPECT().Read(gomock.Any()).Times(1).Return(nil, int64(0), uint64(0), fmt.Errorf("test"))
+			lags, err := CalculateLag(ctx, bus, commits)
+			So(err, ShouldBeNil)
+			So(lags[1].Lag, ShouldEqual, 60)
+			So(lags[1].OldestUnconsumedTime.IsZero(), ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,347 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	pInfo "github.com/linkall-labs/vanus/internal/primitive/info"
+	vContext "github.com/linkall-labs/vanus/internal/primitive/transform/context"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/trigger/aggregation"
+	"github.com/linkall-labs/vanus/internal/trigger/info"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/observability/metrics"
+)
+
+const (
+	defaultAggregationEventType = "vanus.aggregation.window"
+	aggregationEventSource      = "/vanus/subscriptions/"
+)
+
+// checkpoint is the state of aggregation subscription, the windows contain exactly the events
+// before the offsets.
+type checkpoint struct {
+	Offsets pInfo.ListOffsetInfo `json:"offsets"`
+	Windows json.RawMessage      `json:"windows"`
+	// Pending is the results of closed windows which haven't been delivered.
+	Pending []aggregation.Result `json:"pending,omitempty"`
+}
+
+func newAggregator(agg *primitive.Aggregation) *aggregation.Aggregator {
+	return aggregation.New(aggregation.Config{
+		WindowType:      agg.WindowType,
+		Size:            time.Duration(agg.WindowSize) * time.Millisecond,
+		Slide:           time.Duration(agg.Slide) * time.Millisecond,
+		AllowedLateness: time.Duration(agg.AllowedLateness) * time.Millisecond,
+	})
+}
+
+// aggregateStep returns the interval between the starts of windows.
+func aggregateStep(agg *primitive.Aggregation) time.Duration {
+	if agg.WindowType == primitive.SlidingWindow && agg.Slide > 0 {
+		return time.Duration(agg.Slide) * time.Millisecond
+	}
+	return time.Duration(agg.WindowSize) * time.Millisecond
+}
+
+// aggregate accumulates the matched event into windows, the event is committed once it's in the
+// windows, and the closed windows are delivered.
+func (t *trigger) aggregate(ctx context.Context, event info.EventRecord) {
+	agg := t.config.Aggregation
+	key := orderKey(event.Event, agg.Key)
+	value := t.aggregateValue(event.Event)
+	eventTime := event.Event.Time()
+	if eventTime.IsZero() {
+		eventTime = time.Now()
+	}
+	t.aggMu.Lock()
+	added := t.aggregator.Add(key, eventTime, value)
+	t.offsetManager.EventCommit(event.OffsetInfo)
+	t.lastArrival = time.Now()
+	results := t.expireWindows()
+	t.aggMu.Unlock()
+	if !added {
+		metrics.TriggerAggregationLateEventCounter.WithLabelValues(t.subscriptionIDStr).Inc()
+		log.Debug(ctx, "drop late event of aggregation", map[string]interface{}{
+			log.KeySubscriptionID: t.subscription.ID,
+			"event":               event.Event,
+		})
+	}
+	t.emitResults(ctx, results)
+}
+
+// aggregateValue returns the numeric value of field, nil if the event hasn't one.
+func (t *trigger) aggregateValue(e *ce.Event) *float64 {
+	if t.aggField == nil {
+		return nil
+	}
+	var data interface{}
+	if err := json.Unmarshal(e.Data(), &data); err != nil {
+		return nil
+	}
+	v, err := t.aggField.Evaluate(&vContext.EventContext{Event: e, Data: data})
+	if err != nil {
+		return nil
+	}
+	var f float64
+	switch val := v.(type) {
+	case float64:
+		f = val
+	case int64:
+		f = float64(val)
+	case string:
+		if f, err = strconv.ParseFloat(val, 64); err != nil {
+			return nil
+		}
+	default:
+		return nil
+	}
+	return &f
+}
+
+// expireWindows moves the results of closed windows to pending. Caller must hold t.aggMu.
+func (t *trigger) expireWindows() []aggregation.Result {
+	results := t.aggregator.Expired()
+	for _, r := range results {
+		t.pending[t.aggregatedEventID(r)] = r
+	}
+	metrics.TriggerAggregationWindowGauge.WithLabelValues(t.subscriptionIDStr).Set(float64(t.aggregator.Len()))
+	return results
+}
+
+// emitResults delivers the results in order, the result is kept in pending if ctx is done.
+func (t *trigger) emitResults(ctx context.Context, results []aggregation.Result) {
+	for _, r := range results {
+		e := t.newAggregatedEvent(r)
+		if !t.deliverAggregatedEvent(ctx, e) {
+			return
+		}
+		t.aggMu.Lock()
+		delete(t.pending, e.ID())
+		t.aggMu.Unlock()
+	}
+}
+
+// aggregatedEventID is deterministic, so the consumer can discard the result which is delivered
+// again after recovery.
+func (t *trigger) aggregatedEventID(r aggregation.Result) string {
+	return fmt.Sprintf("%s:%s:%d", t.subscriptionIDStr, r.Key, r.Start.UnixMilli())
+}
+
+func (t *trigger) newAggregatedEvent(r aggregation.Result) *ce.Event {
+	agg := t.config.Aggregation
+	e := ce.NewEvent()
+	e.SetID(t.aggregatedEventID(r))
+	e.SetSource(aggregationEventSource + t.subscriptionIDStr)
+	eventType := agg.Type
+	if eventType == "" {
+		eventType = defaultAggregationEventType
+	}
+	e.SetType(eventType)
+	if r.Key != "" {
+		e.SetSubject(r.Key)
+	}
+	e.SetTime(r.End)
+	data := map[string]interface{}{
+		"key":          r.Key,
+		"window_start": r.Start.UTC().Format(time.RFC3339Nano),
+		"window_end":   r.End.UTC().Format(time.RFC3339Nano),
+	}
+	for _, fn := range agg.Functions {
+		data[string(fn)] = r.Value(fn)
+	}
+	_ = e.SetData(ce.ApplicationJSON, data)
+	return &e
+}
+
+// deliverAggregatedEvent sends the event to sink or writes it to the target eventbus, the
+// failed event goes to retry or dead letter like the others. It returns false if ctx is done.
+func (t *trigger) deliverAggregatedEvent(ctx context.Context, e *ce.Event) bool {
+	if t.targetEventWriter == nil {
+		d, ok := t.acquireDelivery(ctx)
+		if !ok {
+			return false
+		}
+		t.processEvent(ctx, info.EventRecord{Event: e}, d)
+		return true
+	}
+	sendEvent := e
+	if transformer := t.getTransformer(); transformer != nil {
+		transformed := e.Clone()
+		if err := transformer.Execute(&transformed); err != nil {
+			t.writeFailEvent(ctx, e, NoNeedRetryCode, err)
+			return true
+		}
+		sendEvent = &transformed
+	}
+	var writeAttempt int
+	for {
+		writeAttempt++
+		_, err := t.targetEventWriter.AppendOne(ctx, sendEvent)
+		if err == nil {
+			metrics.TriggerPushEventCounter.WithLabelValues(t.subscriptionIDStr, metrics.LabelValuePushEventSuccess).Inc()
+			return true
+		}
+		log.Info(ctx, "write aggregated event error", map[string]interface{}{
+			log.KeyError:          err,
+			log.KeySubscriptionID: t.subscription.ID,
+			"attempt":             writeAttempt,
+			"event":               sendEvent,
+		})
+		if ctx.Err() != nil {
+			return false
+		}
+		if writeAttempt >= t.config.MaxWriteAttempt {
+			metrics.TriggerPushEventCounter.WithLabelValues(t.subscriptionIDStr, metrics.LabelValuePushEventFail).Inc()
+			t.writeEventToDeadLetter(ctx, e, "WriteTargetEventbusFailed", err.Error())
+			metrics.TriggerDeadLetterEventCounter.WithLabelValues(t.subscriptionIDStr).Inc()
+			return true
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+// runAggregation delivers the pending results restored from checkpoint, and closes the windows
+// when no event arrives, since the watermark only moves forward with events.
+func (t *trigger) runAggregation(ctx context.Context) {
+	t.emitResults(ctx, t.pendingResults())
+	step := aggregateStep(t.config.Aggregation)
+	ticker := time.NewTicker(step)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			t.aggMu.Lock()
+			var results []aggregation.Result
+			if idle := time.Since(t.lastArrival); idle >= step {
+				t.aggregator.Idle(idle)
+				results = t.expireWindows()
+			}
+			t.aggMu.Unlock()
+			t.emitResults(ctx, results)
+		}
+	}
+}
+
+func (t *trigger) pendingResults() []aggregation.Result {
+	t.aggMu.Lock()
+	defer t.aggMu.Unlock()
+	results := make([]aggregation.Result, 0, len(t.pending))
+	for _, r := range t.pending {
+		results = append(results, r)
+	}
+	sortResults(results)
+	return results
+}
+
+func sortResults(results []aggregation.Result) {
+	sort.Slice(results, func(i, j int) bool {
+		if !results[i].End.Equal(results[j].End) {
+			return results[i].End.Before(results[j].End)
+		}
+		return results[i].Key < results[j].Key
+	})
+}
+
+// makeCheckpoint encodes the windows with the offsets they cover, nil if it isn't an aggregation
+// subscription.
+func (t *trigger) makeCheckpoint() ([]byte, error) {
+	if t.aggregator == nil {
+		return nil, nil
+	}
+	t.aggMu.Lock()
+	defer t.aggMu.Unlock()
+	windows, err := t.aggregator.Snapshot()
+	if err != nil {
+		return nil, err
+	}
+	offsets := t.offsetManager.GetCommit()
+	// keep the checkpoint unchanged if nothing happens.
+	sort.Slice(offsets, func(i, j int) bool {
+		return offsets[i].EventLogID < offsets[j].EventLogID
+	})
+	cp := checkpoint{
+		Offsets: offsets,
+		Windows: windows,
+	}
+	for _, r := range t.pending {
+		cp.Pending = append(cp.Pending, r)
+	}
+	sortResults(cp.Pending)
+	return json.Marshal(cp)
+}
+
+// restoreCheckpoint rebuilds the windows from the checkpoint of subscription, and the events are
+// read from the offsets of checkpoint.
+func (t *trigger) restoreCheckpoint() error {
+	if t.aggregator == nil {
+		return nil
+	}
+	t.aggMu.Lock()
+	defer t.aggMu.Unlock()
+	t.aggregator.Reset()
+	t.pending = make(map[string]aggregation.Result)
+	t.lastArrival = time.Now()
+	if len(t.subscription.Checkpoint) == 0 {
+		return nil
+	}
+	var cp checkpoint
+	if err := json.Unmarshal(t.subscription.Checkpoint, &cp); err != nil {
+		return err
+	}
+	if err := t.aggregator.Restore(cp.Windows); err != nil {
+		return err
+	}
+	for _, r := range cp.Pending {
+		t.pending[t.aggregatedEventID(r)] = r
+	}
+	offsets := make(map[vanus.ID]int, len(t.subscription.Offsets))
+	for i, o := range t.subscription.Offsets {
+		offsets[o.EventLogID] = i
+	}
+	for _, o := range cp.Offsets {
+		if i, ok := offsets[o.EventLogID]; ok {
+			t.subscription.Offsets[i] = o
+			continue
+		}
+		t.subscription.Offsets = append(t.subscription.Offsets, o)
+	}
+	// the offsets of checkpoint take precedence over the committed ones.
+	t.offsetManager.Clear()
+	return nil
+}
+
+// GetCheckpoint returns the state of aggregation subscription, nil for others.
+func (t *trigger) GetCheckpoint(ctx context.Context) []byte {
+	cp, err := t.makeCheckpoint()
+	if err != nil {
+		log.Warning(ctx, "make aggregation checkpoint error", map[string]interface{}{
+			log.KeySubscriptionID: t.subscription.ID,
+			log.KeyError:          err,
+		})
+		return nil
+	}
+	return cp
+}
@@ -18,6 +18,10 @@ import (
 	"time"
 
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/transform/arg"
+	"github.com/linkall-labs/vanus/internal/trigger/breaker"
+	"github.com/linkall-labs/vanus/internal/trigger/concurrency"
+	"github.com/linkall-labs/vanus/observability/metrics"
 
 	"go.uber.org/ratelimit"
 )
@@ -27,6 +31,8 @@ const (
 	defaultFilterProcessSize = 2
 	defaultDeliveryTimeout   = 5 * time.Second
 	defaultMaxWriteAttempt   = 3
+	defaultBatchTimeout      = 100 * time.Millisecond
+	defaultLagReportInterval = 15 * time.Second
 )
 
 type Config struct {
@@ -39,6 +45,14 @@ type Config struct {
 	DeadLetterEventbus string
 	MaxWriteAttempt    int
 	Ordered            bool
+	RetryPolicy        *primitive.RetryPolicy
+	MaxBatchSize       int
+	BatchTimeout       time.Duration
+	LagReportInterval  time.Duration
+	CircuitBreaker     *primitive.CircuitBreaker
+	MaxConcurrency     uint32
+	OrderKey           string
+	Aggregation        *primitive.Aggregation
 }
 
 func defaultConfig() Config {
@@ -49,6 +63,7 @@ func defaultConfig() Config {
 		DeliveryTimeout:    defaultDeliveryTimeout,
 		DeadLetterEventbus: primitive.DeadLetterEventbusName,
 		MaxWriteAttempt:    defaultMaxWriteAttempt,
+		LagReportInterval:  defaultLagReportInterval,
 	}
 	return c
 }
@@ -99,6 +114,17 @@ func WithOrdered(ordered bool) Option {
 	}
 }
 
+// WithOrderKey enables delivering the events with the same value of key in order, empty means
+// disabled.
+func WithOrderKey(key string) Option {
+	return func(t *trigger) {
+		t.config.OrderKey = key
+		if key != "" {
+			t.config.FilterProcessSize = 1
+		}
+	}
+}
+
 func WithRateLimit(rateLimit uint32) Option {
 	return func(t *trigger) {
 		t.config.RateLimit = rateLimit
@@ -124,3 +150,68 @@ func WithDeadLetterEventbus(eventbus string) Option {
 		t.config.DeadLetterEventbus = eventbus
 	}
 }
+
+func WithRetryPolicy(policy *primitive.RetryPolicy) Option {
+	return func(t *trigger) {
+		t.config.RetryPolicy = policy
+	}
+}
+
+func WithBatch(size, timeout uint32) Option {
+	return func(t *trigger) {
+		t.config.MaxBatchSize = int(size)
+		if timeout == 0 {
+			t.config.BatchTimeout = defaultBatchTimeout
+			return
+		}
+		t.config.BatchTimeout = time.Duration(timeout) * time.Millisecond
+	}
+}
+
+// WithCircuitBreaker replaces the circuit breaker of sink with a closed one, nil means disabled.
+func WithCircuitBreaker(cb *primitive.CircuitBreaker) Option {
+	return func(t *trigger) {
+		t.config.CircuitBreaker = cb
+		if cb == nil {
+			t.breaker = nil
+			return
+		}
+		t.breaker = breaker.New(breaker.Config{
+			FailureRatio:   cb.FailureRatio,
+			MinRequests:    int(cb.MinRequests),
+			Window:         time.Duration(cb.Window) * time.Millisecond,
+			OpenDuration:   time.Duration(cb.OpenDuration) * time.Millisecond,
+			HalfOpenTrials: int(cb.HalfOpenTrials),
+		}, t.onBreakerStateChange)
+		metrics.TriggerCircuitBreakerState.WithLabelValues(t.subscriptionIDStr).Set(float64(breaker.StateClosed))
+	}
+}
+
+// WithMaxConcurrency replaces the in-flight limiter of unordered delivery with a new one whose
+// limit adapts to the sink under max, 0 means the default.
+func WithMaxConcurrency(max uint32) Option {
+	return func(t *trigger) {
+		t.config.MaxConcurrency = max
+		t.limiter = concurrency.New(concurrency.Config{
+			MaxLimit: int(max),
+		}, t.onConcurrencyLimitChange)
+	}
+}
+
+// WithAggregation accumulates the matched events into windows and delivers the aggregated events
+// instead, nil means disabled. The aggregation of subscription can't be changed.
+func WithAggregation(agg *primitive.Aggregation) Option {
+	return func(t *trigger) {
+		t.config.Aggregation = agg
+		if agg == nil {
+			t.aggregator, t.aggField = nil, nil
+			return
+		}
+		t.config.FilterProcessSize = 1
+		t.aggregator = newAggregator(agg)
+		t.aggField = nil
+		if agg.Field != "" {
+			t.aggField, _ = arg.NewArg(agg.Field)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/linkall-labs/vanus/internal/trigger/info"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/observability/metrics"
+)
+
+// keyedDispatcher delivers the events with the same order key serially and the events with
+// different keys in parallel, each key which has pending events owns a goroutine.
+//
+// A key holds at most keyPending slots of the shared budget, its later events are parked in the
+// parked budget, so a key retrying in place doesn't stall the other keys until the parked budget
+// is exhausted too.
+type keyedDispatcher struct {
+	process func(ctx context.Context, event info.EventRecord)
+	// pending bounds the number of events which are dispatched but not processed.
+	pending chan struct{}
+	// parked bounds the number of events which are queued behind the keyPending events of key.
+	parked     chan struct{}
+	keyPending int
+	mu         sync.Mutex
+	// queues contains the events of the keys whose goroutine is running, the head of queue is
+	// the event in processing.
+	queues map[string][]keyedEvent
+	wg     sync.WaitGroup
+}
+
+type keyedEvent struct {
+	info.EventRecord
+	// slots is the budget which the event holds.
+	slots chan struct{}
+}
+
+func newKeyedDispatcher(size int, process func(ctx context.Context, event info.EventRecord)) *keyedDispatcher {
+	if size <= 0 {
+		size = defaultBufferSize
+	}
+	keyPending := size / 4
+	if keyPending == 0 {
+		keyPending = 1
+	}
+	return &keyedDispatcher{
+		process:    process,
+		pending:    make(chan struct{}, size),
+		parked:     make(chan struct{}, size),
+		keyPending: keyPending,
+		queues:     make(map[string][]keyedEvent),
+	}
+}
+
+// dispatch waits until the number of pending events is under the limit, it returns false if ctx
+// is done.
+func (d *keyedDispatcher) dispatch(ctx context.Context, key string, event info.EventRecord) bool {
+	d.mu.Lock()
+	queued := len(d.queues[key])
+	d.mu.Unlock()
+	// the queue only grows here, so the key never holds more than keyPending shared slots.
+	slots := d.pending
+	if queued >= d.keyPending {
+		slots = d.parked
+	}
+	select {
+	case <-ctx.Done():
+		return false
+	case slots <- struct{}{}:
+	}
+	d.mu.Lock()
+	queue, running := d.queues[key]
+	d.queues[key] = append(queue, keyedEvent{EventRecord: event, slots: slots})
+	d.mu.Unlock()
+	if !running {
+		d.wg.Add(1)
+		go d.run(ctx, key)
+	}
+	return true
+}
+
+func (d *keyedDispatcher) run(ctx context.Context, key string) {
+	defer d.wg.Done()
+	for {
+		d.mu.Lock()
+		queue := d.queues[key]
+		if len(queue) == 0 || ctx.Err() != nil {
+			delete(d.queues, key)
+			d.mu.Unlock()
+			return
+		}
+		event := queue[0]
+		d.mu.Unlock()
+		d.process(ctx, event.EventRecord)
+		d.mu.Lock()
+		d.queues[key] = d.queues[key][1:]
+		d.mu.Unlock()
+		<-event.slots
+	}
+}
+
+// wait waits for all goroutines exit, the events in queue are discarded if ctx is done.
+func (d *keyedDispatcher) wait() {
+	d.wg.Wait()
+}
+
+// orderKey returns the value of the attribute or extension, the events without key are ordered
+// together.
+func orderKey(e *ce.Event, key string) string {
+	switch key {
+	case "id":
+		return e.ID()
+	case "source":
+		return e.Source()
+	case "type":
+		return e.Type()
+	case "subject":
+		return e.Subject()
+	case "datacontenttype":
+		return e.DataContentType()
+	case "dataschema":
+		return e.DataSchema()
+	}
+	v, ok := e.Extensions()[key]
+	if !ok {
+		return ""
+	}
+	s, err := types.Format(v)
+	if err != nil {
+		return ""
+	}
+	return s
+}
+
+// processKeyedEvent sends the event until success, the failed event is retried in place so the
+// later events of the same key wait for it, it's written to dead letter when it can't be
+// recovered by retrying or the attempts are exhausted.
+func (t *trigger) processKeyedEvent(ctx context.Context, event info.EventRecord) {
+	var attempts int32
+	for {
+		d, ok := t.acquireDelivery(ctx)
+		if !ok {
+			// not committed, the event is redelivered after the trigger restarts.
+			return
+		}
+		r := t.sendEvent(ctx, event.Event)
+		t.finishDelivery(d, r)
+		if r.Err == nil {
+			metrics.TriggerPushEventCounter.WithLabelValues(t.subscriptionIDStr, metrics.LabelValuePushEventSuccess).Inc()
+			break
+		}
+		metrics.TriggerPushEventCounter.WithLabelValues(t.subscriptionIDStr, metrics.LabelValuePushEventFail).Inc()
+		attempts++
+		needRetry, reason := isShouldRetry(r.StatusCode)
+		if needRetry && attempts > t.getConfig().MaxRetryAttempts {
+			needRetry, reason = false, "MaxDeliveryAttemptExceeded"
+		}
+		log.Info(ctx, "send keyed event fail", map[string]interface{}{
+			log.KeyError: r.Err,
+			"attempts":   attempts,
+			"retry":      needRetry,
+			"event":      event.Event,
+		})
+		if !needRetry {
+			t.writeEventToDeadLetter(ctx, event.Event, reason, r.Err.Error())
+			metrics.TriggerDeadLetterEventCounter.WithLabelValues(t.subscriptionIDStr).Inc()
+			break
+		}
+		metrics.TriggerRetryEventCounter.WithLabelValues(t.subscriptionIDStr).Inc()
+		delay := calDeliveryTime(t.getConfig().RetryPolicy, attempts)
+		if r.RetryAfter > delay {
+			delay = r.RetryAfter
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+	t.offsetManager.EventCommit(event.OffsetInfo)
+}
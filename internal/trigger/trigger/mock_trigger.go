@@ -50,6 +50,20 @@ func (mr *MockTriggerMockRecorder) Change(ctx, subscription interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Change", reflect.TypeOf((*MockTrigger)(nil).Change), ctx, subscription)
 }
 
+// GetCheckpoint mocks base method.
+func (m *MockTrigger) GetCheckpoint(ctx context.Context) []byte {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCheckpoint", ctx)
+	ret0, _ := ret[0].([]byte)
+	return ret0
+}
+
+// GetCheckpoint indicates an expected call of GetCheckpoint.
+func (mr *MockTriggerMockRecorder) GetCheckpoint(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCheckpoint", reflect.TypeOf((*MockTrigger)(nil).GetCheckpoint), ctx)
+}
+
 // GetOffsets mocks base method.
 func (m *MockTrigger) GetOffsets(ctx context.Context) info.ListOffsetInfo {
 	m.ctrl.T.Helper()
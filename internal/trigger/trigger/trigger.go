@@ -17,6 +17,8 @@ package trigger
 
 import (
 	"context"
+	"errors"
+	nethttp "net/http"
 	"reflect"
 	"sync"
 	"time"
@@ -26,8 +28,12 @@ import (
 	"github.com/linkall-labs/vanus/client/pkg/api"
 	"github.com/linkall-labs/vanus/internal/primitive"
 	pInfo "github.com/linkall-labs/vanus/internal/primitive/info"
+	"github.com/linkall-labs/vanus/internal/primitive/transform/arg"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/trigger/aggregation"
+	"github.com/linkall-labs/vanus/internal/trigger/breaker"
 	"github.com/linkall-labs/vanus/internal/trigger/client"
+	"github.com/linkall-labs/vanus/internal/trigger/concurrency"
 	"github.com/linkall-labs/vanus/internal/trigger/filter"
 	"github.com/linkall-labs/vanus/internal/trigger/info"
 	"github.com/linkall-labs/vanus/internal/trigger/offset"
@@ -36,6 +42,7 @@ import (
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/observability/metrics"
 	"github.com/linkall-labs/vanus/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/ratelimit"
 )
 
@@ -57,6 +64,7 @@ type Trigger interface {
 	Stop(ctx context.Context) error
 	Change(ctx context.Context, subscription *primitive.Subscription) error
 	GetOffsets(ctx context.Context) pInfo.ListOffsetInfo
+	GetCheckpoint(ctx context.Context) []byte
 	ResetOffsetToTimestamp(ctx context.Context, timestamp int64) (pInfo.ListOffsetInfo, error)
 }
 
@@ -73,12 +81,22 @@ type trigger struct {
 	filter        filter.Filter
 	transformer   *transform.Transformer
 	rateLimiter   ratelimit.Limiter
+	breaker       *breaker.Breaker
+	limiter       *concurrency.Limiter
 	config        Config
 
+	aggregator  *aggregation.Aggregator
+	aggField    arg.Arg
+	aggMu       sync.Mutex
+	pending     map[string]aggregation.Result
+	lastArrival time.Time
+
 	retryEventCh     chan info.EventRecord
 	retryEventReader reader.Reader
 	timerEventWriter api.BusWriter
 	dlEventWriter    api.BusWriter
+	// targetEventWriter writes the aggregated events to the target eventbus instead of sink.
+	targetEventWriter api.BusWriter
 
 	state State
 	stop  context.CancelFunc
@@ -101,6 +119,9 @@ func NewTrigger(subscription *primitive.Subscription, opts ...Option) Trigger {
 	if t.rateLimiter == nil {
 		t.rateLimiter = ratelimit.NewUnlimited()
 	}
+	if t.limiter == nil {
+		t.applyOptions(WithMaxConcurrency(0))
+	}
 	return t
 }
 
@@ -128,6 +149,7 @@ func (t *trigger) changeTarget(sink primitive.URI,
 	eventCli := newEventClient(sink, protocol, credential)
 	t.lock.Lock()
 	defer t.lock.Unlock()
+	closeEventClient(t.eventCli)
 	t.eventCli = eventCli
 	t.subscription.Sink = sink
 	t.subscription.Protocol = protocol
@@ -135,6 +157,18 @@ func (t *trigger) changeTarget(sink primitive.URI,
 	return nil
 }
 
+func (t *trigger) getBreaker() *breaker.Breaker {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.breaker
+}
+
+func (t *trigger) getLimiter() *concurrency.Limiter {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.limiter
+}
+
 func (t *trigger) getFilter() filter.Filter {
 	t.lock.RLock()
 	defer t.lock.RUnlock()
@@ -175,6 +209,22 @@ func (t *trigger) changeConfig(config primitive.SubscriptionConfig) {
 	if config.GetMaxRetryAttempts() != t.subscription.Config.GetMaxRetryAttempts() {
 		t.applyOptions(WithMaxRetryAttempts(config.GetMaxRetryAttempts()))
 	}
+	if !reflect.DeepEqual(config.RetryPolicy, t.subscription.Config.RetryPolicy) {
+		t.applyOptions(WithRetryPolicy(config.RetryPolicy))
+	}
+	if config.MaxBatchSize != t.subscription.Config.MaxBatchSize ||
+		config.BatchTimeout != t.subscription.Config.BatchTimeout {
+		t.applyOptions(WithBatch(config.MaxBatchSize, config.BatchTimeout))
+	}
+	if !reflect.DeepEqual(config.CircuitBreaker, t.subscription.Config.CircuitBreaker) {
+		t.applyOptions(WithCircuitBreaker(config.CircuitBreaker))
+		if config.CircuitBreaker == nil {
+			t.clearBreakerState()
+		}
+	}
+	if config.MaxConcurrency != t.subscription.Config.MaxConcurrency {
+		t.applyOptions(WithMaxConcurrency(config.MaxConcurrency))
+	}
 	t.subscription.Config = config
 }
 
@@ -188,7 +238,13 @@ func (t *trigger) eventArrived(ctx context.Context, event info.EventRecord) erro
 	}
 }
 
-func (t *trigger) sendEvent(ctx context.Context, e *ce.Event) (int, error) {
+// sendResult is the result of a request to sink with its latency.
+type sendResult struct {
+	client.Result
+	latency time.Duration
+}
+
+func (t *trigger) sendEvent(ctx context.Context, e *ce.Event) sendResult {
 	var err error
 	transformer := t.getTransformer()
 	sendEvent := *e
@@ -199,7 +255,7 @@ func (t *trigger) sendEvent(ctx context.Context, e *ce.Event) (int, error) {
 		err = transformer.Execute(&sendEvent)
 		metrics.TriggerTransformCostSecond.WithLabelValues(t.subscriptionIDStr).Observe(time.Since(startTime).Seconds())
 		if err != nil {
-			return -1, err
+			return sendResult{Result: client.Result{StatusCode: -1, Err: err}}
 		}
 	}
 	timeoutCtx, cancel := context.WithTimeout(ctx, t.getConfig().DeliveryTimeout)
@@ -207,10 +263,11 @@ func (t *trigger) sendEvent(ctx context.Context, e *ce.Event) (int, error) {
 	t.rateLimiter.Take()
 	startTime := time.Now()
 	r := t.getClient().Send(timeoutCtx, sendEvent)
+	latency := time.Since(startTime)
 	if r == client.Success {
-		metrics.TriggerPushEventTime.WithLabelValues(t.subscriptionIDStr).Observe(time.Since(startTime).Seconds())
+		metrics.TriggerPushEventTime.WithLabelValues(t.subscriptionIDStr).Observe(latency.Seconds())
 	}
-	return r.StatusCode, r.Err
+	return sendResult{Result: r, latency: latency}
 }
 
 func (t *trigger) runRetryEventFilter(ctx context.Context) {
@@ -233,6 +290,12 @@ func (t *trigger) runRetryEventFilter(ctx context.Context) {
 				t.offsetManager.EventCommit(event.OffsetInfo)
 				continue
 			}
+			if t.aggregator != nil {
+				// the aggregated event doesn't match the filter of origin events.
+				t.sendCh <- event
+				metrics.TriggerFilterMatchRetryEventCounter.WithLabelValues(t.subscriptionIDStr).Inc()
+				continue
+			}
 			startTime := time.Now()
 			res := filter.Run(t.getFilter(), *event.Event)
 			metrics.TriggerFilterCostSecond.WithLabelValues(t.subscriptionIDStr).Observe(time.Since(startTime).Seconds())
@@ -263,41 +326,272 @@ func (t *trigger) runEventFilter(ctx context.Context) {
 				t.offsetManager.EventCommit(event.OffsetInfo)
 				continue
 			}
-			t.sendCh <- event
 			metrics.TriggerFilterMatchEventCounter.WithLabelValues(t.subscriptionIDStr).Inc()
+			if t.aggregator != nil {
+				t.aggregate(ctx, event)
+				continue
+			}
+			t.sendCh <- event
 		}
 	}
 }
 
 func (t *trigger) runEventSend(ctx context.Context) {
+	var (
+		batch   []info.EventRecord
+		timer   *time.Timer
+		timeout <-chan time.Time
+	)
+	flush := func() {
+		if timer != nil {
+			timer.Stop()
+			timer, timeout = nil, nil
+		}
+		if len(batch) == 0 {
+			return
+		}
+		events := batch
+		batch = nil
+		d, ok := t.acquireDelivery(ctx)
+		if !ok {
+			return
+		}
+		t.dispatch(ctx, func(ctx context.Context) {
+			t.processBatch(ctx, events, d)
+		})
+	}
+	var keyed *keyedDispatcher
+	if config := t.getConfig(); config.OrderKey != "" {
+		keyed = newKeyedDispatcher(config.BufferSize, t.processKeyedEvent)
+		defer keyed.wait()
+	}
 	for {
 		select {
 		case <-ctx.Done():
 			return
+		case <-timeout:
+			flush()
 		case event, ok := <-t.sendCh:
 			if !ok {
 				return
 			}
-			if t.config.Ordered {
-				t.processEvent(ctx, event)
-			} else {
-				go func(event info.EventRecord) {
-					t.processEvent(ctx, event)
-				}(event)
+			if keyed != nil {
+				if !keyed.dispatch(ctx, orderKey(event.Event, t.getConfig().OrderKey), event) {
+					return
+				}
+				continue
+			}
+			config := t.getConfig()
+			if !t.batchEnabled(config) {
+				flush()
+				d, ok := t.acquireDelivery(ctx)
+				if !ok {
+					return
+				}
+				t.dispatch(ctx, func(ctx context.Context) {
+					t.processEvent(ctx, event, d)
+				})
+				continue
+			}
+			batch = append(batch, event)
+			if len(batch) >= config.MaxBatchSize {
+				flush()
+			} else if timer == nil {
+				timer = time.NewTimer(config.BatchTimeout)
+				timeout = timer.C
 			}
 		}
 	}
 }
 
-func (t *trigger) processEvent(ctx context.Context, event info.EventRecord) {
-	code, err := t.sendEvent(ctx, event.Event)
+// runLagReport exports the lag of subscription periodically.
+func (t *trigger) runLagReport(ctx context.Context) {
+	ticker := time.NewTicker(t.getConfig().LagReportInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			t.reportLag(ctx)
+		}
+	}
+}
+
+func (t *trigger) reportLag(ctx context.Context) {
+	eventbus := t.subscription.EventBus
+	lags, err := offset.CalculateLag(ctx, t.client.Eventbus(ctx, eventbus), t.offsetManager.GetCommit())
+	if err != nil {
+		log.Warning(ctx, "calculate subscription lag failed", map[string]interface{}{
+			log.KeySubscriptionID: t.subscription.ID,
+			log.KeyError:          err,
+		})
+		return
+	}
+	now := time.Now()
+	for _, lag := range lags {
+		labels := []string{t.subscriptionIDStr, eventbus, lag.EventlogID.String()}
+		metrics.TriggerLagGauge.WithLabelValues(labels...).Set(float64(lag.Lag))
+		var age float64
+		if !lag.OldestUnconsumedTime.IsZero() {
+			age = now.Sub(lag.OldestUnconsumedTime).Seconds()
+		}
+		metrics.TriggerLagSecond.WithLabelValues(labels...).Set(age)
+	}
+}
+
+func (t *trigger) clearLag() {
+	labels := prometheus.Labels{metrics.LabelTrigger: t.subscriptionIDStr}
+	metrics.TriggerLagGauge.DeletePartialMatch(labels)
+	metrics.TriggerLagSecond.DeletePartialMatch(labels)
+}
+
+// delivery is the permission to send a request to sink, finishDelivery must be called with the
+// result of request.
+type delivery struct {
+	report func(success bool)
+	// token is nil in ordered mode, which has only one request in flight.
+	token *concurrency.Token
+}
+
+// acquireDelivery waits until there is a free slot in the in-flight window of unordered delivery
+// and the circuit breaker allows delivering to sink, it returns false if ctx is done. The send
+// loop is blocked while waiting, so the readers stop reading once the buffers are full.
+func (t *trigger) acquireDelivery(ctx context.Context) (delivery, bool) {
+	var d delivery
+	if !t.getConfig().Ordered {
+		token, ok := t.getLimiter().Acquire(ctx)
+		if !ok {
+			return d, false
+		}
+		metrics.TriggerInFlightRequests.WithLabelValues(t.subscriptionIDStr).Inc()
+		d.token = token
+	}
+	report, ok := t.acquireBreaker(ctx)
+	if !ok {
+		t.cancelDelivery(d)
+		return d, false
+	}
+	d.report = report
+	return d, true
+}
+
+func (t *trigger) finishDelivery(d delivery, r sendResult) {
+	d.report(!isSinkFailure(r.StatusCode, r.Err))
+	if d.token == nil {
+		return
+	}
+	d.token.Release(concurrencyOutcome(r), r.latency, r.RetryAfter)
+	metrics.TriggerInFlightRequests.WithLabelValues(t.subscriptionIDStr).Dec()
+}
+
+// cancelDelivery returns the slot of the delivery which doesn't send any request.
+func (t *trigger) cancelDelivery(d delivery) {
+	if d.token == nil {
+		return
+	}
+	d.token.Release(concurrency.OutcomeIgnore, 0, 0)
+	metrics.TriggerInFlightRequests.WithLabelValues(t.subscriptionIDStr).Dec()
+}
+
+// concurrencyOutcome returns whether the result shows the sink is overloaded.
+func concurrencyOutcome(r sendResult) concurrency.Outcome {
+	switch r.StatusCode {
+	case client.ErrDeliveryTimeout, nethttp.StatusTooManyRequests, nethttp.StatusServiceUnavailable:
+		return concurrency.OutcomeDropped
+	}
+	if r.Err != nil {
+		return concurrency.OutcomeIgnore
+	}
+	return concurrency.OutcomeSuccess
+}
+
+func (t *trigger) onConcurrencyLimitChange(limit int) {
+	metrics.TriggerConcurrencyLimit.WithLabelValues(t.subscriptionIDStr).Set(float64(limit))
+}
+
+func (t *trigger) clearConcurrencyState() {
+	metrics.TriggerConcurrencyLimit.DeleteLabelValues(t.subscriptionIDStr)
+	metrics.TriggerInFlightRequests.DeleteLabelValues(t.subscriptionIDStr)
+}
+
+// acquireBreaker waits until the circuit breaker allows delivering to sink, it returns false if
+// ctx is done. The send loop is blocked while the breaker is open, and the events won't burn
+// retry attempts.
+func (t *trigger) acquireBreaker(ctx context.Context) (func(success bool), bool) {
+	for {
+		b := t.getBreaker()
+		if b == nil {
+			return func(bool) {}, true
+		}
+		report, wait := b.Allow()
+		if report != nil {
+			return report, true
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, false
+		case <-timer.C:
+		}
+	}
+}
+
+func (t *trigger) onBreakerStateChange(from, to breaker.State) {
+	metrics.TriggerCircuitBreakerState.WithLabelValues(t.subscriptionIDStr).Set(float64(to))
+	metrics.TriggerCircuitBreakerTransitionCounter.WithLabelValues(t.subscriptionIDStr, to.String()).Inc()
+	log.Info(context.Background(), "sink circuit breaker state changed", map[string]interface{}{
+		log.KeySubscriptionID: t.subscription.ID,
+		"from":                from.String(),
+		"to":                  to.String(),
+	})
+}
+
+func (t *trigger) clearBreakerState() {
+	metrics.TriggerCircuitBreakerState.DeleteLabelValues(t.subscriptionIDStr)
+	metrics.TriggerCircuitBreakerTransitionCounter.DeletePartialMatch(
+		prometheus.Labels{metrics.LabelTrigger: t.subscriptionIDStr})
+}
+
+// isSinkFailure returns true if the delivery failed for the sink is unavailable, the event which
+// is rejected by sink or failed to transform doesn't count.
+func isSinkFailure(code int, err error) bool {
+	if err == nil {
+		return false
+	}
+	needRetry, _ := isShouldRetry(code)
+	return needRetry
+}
+
+func (t *trigger) dispatch(ctx context.Context, process func(ctx context.Context)) {
+	if t.getConfig().Ordered {
+		process(ctx)
+		return
+	}
+	go process(ctx)
+}
+
+// batchEnabled returns true if the subscription enables batch and the sink supports batch delivery.
+func (t *trigger) batchEnabled(config Config) bool {
+	if config.MaxBatchSize <= 1 {
+		return false
+	}
+	_, ok := t.getClient().(client.BatchSender)
+	return ok
+}
+
+func (t *trigger) processEvent(ctx context.Context, event info.EventRecord, d delivery) {
+	r := t.sendEvent(ctx, event.Event)
+	t.finishDelivery(d, r)
+	code, err := r.StatusCode, r.Err
 	if err != nil {
 		metrics.TriggerPushEventCounter.WithLabelValues(t.subscriptionIDStr, metrics.LabelValuePushEventFail).Inc()
 		log.Info(ctx, "send event fail", map[string]interface{}{
 			log.KeyError: err,
 			"event":      event.Event,
 		})
-		if t.config.Ordered {
+		if t.getConfig().Ordered {
 			// ordered event no need retry direct into dead letter
 			code = NoNeedRetryCode
 		}
@@ -310,6 +604,92 @@ func (t *trigger) processEvent(ctx context.Context, event info.EventRecord) {
 	}
 	t.offsetManager.EventCommit(event.OffsetInfo)
 }
+
+// processBatch sends events to sink in one request, the offsets are committed only after
+// sink acked, the failed batch is split into single event for retry or dead letter.
+func (t *trigger) processBatch(ctx context.Context, batch []info.EventRecord, d delivery) {
+	defer func() {
+		for _, event := range batch {
+			t.offsetManager.EventCommit(event.OffsetInfo)
+		}
+	}()
+	origins, events := t.transformBatch(ctx, batch)
+	if len(events) == 0 {
+		d.report(true)
+		t.cancelDelivery(d)
+		return
+	}
+	r := t.sendBatch(ctx, events)
+	t.finishDelivery(d, r)
+	code, err := r.StatusCode, r.Err
+	if err != nil {
+		metrics.TriggerPushEventCounter.WithLabelValues(t.subscriptionIDStr, metrics.LabelValuePushEventFail).
+			Add(float64(len(events)))
+		log.Info(ctx, "send batch event fail", map[string]interface{}{
+			log.KeyError: err,
+			"count":      len(events),
+		})
+		if t.getConfig().Ordered {
+			code = NoNeedRetryCode
+		}
+		for _, e := range origins {
+			t.writeFailEvent(ctx, e, code, err)
+		}
+		return
+	}
+	metrics.TriggerPushEventCounter.WithLabelValues(t.subscriptionIDStr, metrics.LabelValuePushEventSuccess).
+		Add(float64(len(events)))
+}
+
+// transformBatch returns the origin events and the events to send, the event which failed to
+// transform is written to dead letter directly.
+func (t *trigger) transformBatch(ctx context.Context, batch []info.EventRecord) ([]*ce.Event, []*ce.Event) {
+	transformer := t.getTransformer()
+	origins := make([]*ce.Event, 0, len(batch))
+	events := make([]*ce.Event, 0, len(batch))
+	for _, record := range batch {
+		if transformer == nil {
+			origins = append(origins, record.Event)
+			events = append(events, record.Event)
+			continue
+		}
+		// transform will chang event which lost origin event
+		sendEvent := record.Event.Clone()
+		startTime := time.Now()
+		err := transformer.Execute(&sendEvent)
+		metrics.TriggerTransformCostSecond.WithLabelValues(t.subscriptionIDStr).Observe(time.Since(startTime).Seconds())
+		if err != nil {
+			t.writeFailEvent(ctx, record.Event, NoNeedRetryCode, err)
+			continue
+		}
+		origins = append(origins, record.Event)
+		events = append(events, &sendEvent)
+	}
+	return origins, events
+}
+
+func (t *trigger) sendBatch(ctx context.Context, events []*ce.Event) sendResult {
+	sender, ok := t.getClient().(client.BatchSender)
+	if !ok {
+		return sendResult{Result: client.Result{
+			StatusCode: NoNeedRetryCode,
+			Err:        errors.New("the sink doesn't support batch delivery"),
+		}}
+	}
+	timeoutCtx, cancel := context.WithTimeout(ctx, t.getConfig().DeliveryTimeout)
+	defer cancel()
+	for range events {
+		t.rateLimiter.Take()
+	}
+	startTime := time.Now()
+	r := sender.SendBatch(timeoutCtx, events)
+	latency := time.Since(startTime)
+	if r == client.Success {
+		metrics.TriggerPushEventTime.WithLabelValues(t.subscriptionIDStr).Observe(latency.Seconds())
+	}
+	return sendResult{Result: r, latency: latency}
+}
+
 func (t *trigger) writeFailEvent(ctx context.Context, e *ce.Event, code int, sendErr error) {
 	needRetry, reason := isShouldRetry(code)
 	ec, _ := e.Context.(*ce.EventContextV1)
@@ -348,7 +728,7 @@ func (t *trigger) writeEventToRetry(ctx context.Context, e *ce.Event, attempts i
 	ec, _ := e.Context.(*ce.EventContextV1)
 	attempts++
 	ec.Extensions[primitive.XVanusRetryAttempts] = attempts
-	delayTime := calDeliveryTime(attempts)
+	delayTime := calDeliveryTime(t.getConfig().RetryPolicy, attempts)
 	ec.Extensions[primitive.XVanusDeliveryTime] = ce.Timestamp{Time: time.Now().Add(delayTime).UTC()}.Format(time.RFC3339)
 	ec.Extensions[primitive.XVanusSubscriptionID] = t.subscriptionIDStr
 	ec.Extensions[primitive.XVanusEventbus] = primitive.RetryEventbusName
@@ -382,6 +762,9 @@ func (t *trigger) writeEventToRetry(ctx context.Context, e *ce.Event, attempts i
 
 func (t *trigger) writeEventToDeadLetter(ctx context.Context, e *ce.Event, reason, errorMsg string) {
 	ec, _ := e.Context.(*ce.EventContextV1)
+	if ec.Extensions == nil {
+		ec.Extensions = make(map[string]interface{})
+	}
 	delete(ec.Extensions, primitive.XVanusEventbus)
 	ec.Extensions[primitive.XVanusSubscriptionID] = t.subscriptionIDStr
 	ec.Extensions[primitive.LastDeliveryTime] = ce.Timestamp{Time: time.Now().UTC()}.Format(time.RFC3339)
@@ -468,6 +851,12 @@ func (t *trigger) Init(ctx context.Context) error {
 
 	t.timerEventWriter = t.client.Eventbus(ctx, primitive.TimerEventbusName).Writer()
 	t.dlEventWriter = t.client.Eventbus(ctx, t.config.DeadLetterEventbus).Writer()
+	if agg := t.config.Aggregation; agg != nil && agg.TargetEventbus != "" {
+		t.targetEventWriter = t.client.Eventbus(ctx, agg.TargetEventbus).Writer()
+	}
+	if err := t.restoreCheckpoint(); err != nil {
+		return err
+	}
 	t.eventCh = make(chan info.EventRecord, t.config.BufferSize)
 	t.sendCh = make(chan info.EventRecord, t.config.BufferSize)
 	t.reader = reader.NewReader(t.getReaderConfig(), t.eventCh)
@@ -492,6 +881,10 @@ func (t *trigger) Start(ctx context.Context) error {
 	// retry event
 	_ = t.retryEventReader.Start()
 	t.wg.StartWithContext(ctx, t.runRetryEventFilter)
+	t.wg.StartWithContext(ctx, t.runLagReport)
+	if t.aggregator != nil {
+		t.wg.StartWithContext(ctx, t.runAggregation)
+	}
 	t.state = TriggerRunning
 	log.Info(ctx, "trigger started", map[string]interface{}{
 		log.KeySubscriptionID: t.subscription.ID,
@@ -510,6 +903,15 @@ func (t *trigger) Stop(ctx context.Context) error {
 	t.reader.Close()
 	t.retryEventReader.Close()
 	t.wg.Wait()
+	if t.aggregator != nil {
+		// restart from the latest windows rather than the ones at creation.
+		t.subscription.Checkpoint = t.GetCheckpoint(ctx)
+		metrics.TriggerAggregationWindowGauge.DeleteLabelValues(t.subscriptionIDStr)
+	}
+	t.clearLag()
+	t.clearBreakerState()
+	t.clearConcurrencyState()
+	closeEventClient(t.getClient())
 	close(t.eventCh)
 	close(t.sendCh)
 	close(t.retryEventCh)
@@ -547,7 +949,14 @@ func (t *trigger) ResetOffsetToTimestamp(ctx context.Context, timestamp int64) (
 		return nil, err
 	}
 	t.subscription.Offsets = offsets
+	t.subscription.Checkpoint = nil
 	t.offsetManager.Clear()
+	if t.aggregator != nil {
+		t.aggMu.Lock()
+		t.aggregator.Reset()
+		t.pending = make(map[string]aggregation.Result)
+		t.aggMu.Unlock()
+	}
 	return offsets, nil
 }
 
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"net/http"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -32,6 +33,7 @@ import (
 	"github.com/linkall-labs/vanus/internal/primitive"
 	pInfo "github.com/linkall-labs/vanus/internal/primitive/info"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/trigger/breaker"
 	"github.com/linkall-labs/vanus/internal/trigger/client"
 	"github.com/linkall-labs/vanus/internal/trigger/info"
 	"github.com/linkall-labs/vanus/internal/trigger/reader"
@@ -70,6 +72,21 @@ func TestTrigger_Options(t *testing.T) {
 		So(tg.config.DeadLetterEventbus, ShouldEqual, primitive.DeadLetterEventbusName)
 		WithDeadLetterEventbus("test_eb")(tg)
 		So(tg.config.DeadLetterEventbus, ShouldEqual, "test_eb")
+		WithBatch(10, 0)(tg)
+		So(tg.config.MaxBatchSize, ShouldEqual, 10)
+		So(tg.config.BatchTimeout, ShouldEqual, defaultBatchTimeout)
+		WithBatch(10, 20)(tg)
+		So(tg.config.BatchTimeout, ShouldEqual, 20*time.Millisecond)
+		WithCircuitBreaker(&primitive.CircuitBreaker{})(tg)
+		So(tg.breaker, ShouldNotBeNil)
+		WithCircuitBreaker(nil)(tg)
+		So(tg.breaker, ShouldBeNil)
+		WithOrderKey("partitionkey")(tg)
+		So(tg.config.OrderKey, ShouldEqual, "partitionkey")
+		So(tg.config.FilterProcessSize, ShouldEqual, 1)
+		WithMaxConcurrency(5)(tg)
+		So(tg.config.MaxConcurrency, ShouldEqual, 5)
+		So(tg.limiter.Limit(), ShouldEqual, 5)
 	})
 }
 
@@ -211,6 +228,375 @@ func TestTriggerRunEventSend(t *testing.T) {
 	})
 }
 
+func TestTriggerCircuitBreaker(t *testing.T) {
+	Convey("test sink circuit breaker", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		cli := client.NewMockEventClient(ctrl)
+		ctx, cancel := context.WithCancel(context.Background())
+		id := vanus.NewTestID()
+		tg := NewTrigger(makeSubscription(id), WithControllers([]string{"test"}), WithOrdered(true),
+			WithCircuitBreaker(&primitive.CircuitBreaker{
+				MinRequests:    2,
+				Window:         10000,
+				OpenDuration:   300,
+				HalfOpenTrials: 1,
+			})).(*trigger)
+		mockClient := eb.NewMockClient(ctrl)
+		mockEventbus := api.NewMockEventbus(ctrl)
+		mockBusWriter := api.NewMockBusWriter(ctrl)
+		mockBusReader := api.NewMockBusReader(ctrl)
+		mockClient.EXPECT().Eventbus(gomock.Any(), gomock.Any()).AnyTimes().Return(mockEventbus)
+		mockEventbus.EXPECT().Writer().AnyTimes().Return(mockBusWriter)
+		mockEventbus.EXPECT().Reader().AnyTimes().Return(mockBusReader)
+		mockBusWriter.EXPECT().AppendOne(gomock.Any(), gomock.Any()).AnyTimes().Return("", nil)
+		tg.client = mockClient
+		_ = tg.Init(ctx)
+		tg.eventCli = cli
+		tg.dlEventWriter = mockBusWriter
+		var sent int64
+		var fail atomic.Value
+		fail.Store(true)
+		cli.EXPECT().Send(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+			func(_ context.Context, _ ...ce.Event) client.Result {
+				atomic.AddInt64(&sent, 1)
+				if fail.Load().(bool) {
+					return client.Result{StatusCode: 500, Err: fmt.Errorf("unavailable")}
+				}
+				return client.Success
+			})
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tg.runEventSend(ctx)
+		}()
+		for i := 0; i < 4; i++ {
+			tg.sendCh <- makeEventRecord("test")
+		}
+		time.Sleep(100 * time.Millisecond)
+		// the breaker opens after 2 failures, the rest are held.
+		So(atomic.LoadInt64(&sent), ShouldEqual, 2)
+		So(tg.breaker.State(), ShouldEqual, breaker.StateOpen)
+
+		fail.Store(false)
+		time.Sleep(400 * time.Millisecond)
+		// the trial succeeds and the breaker closes.
+		So(atomic.LoadInt64(&sent), ShouldEqual, 4)
+		So(tg.breaker.State(), ShouldEqual, breaker.StateClosed)
+		cancel()
+		wg.Wait()
+	})
+}
+
+func TestTriggerConcurrencyLimit(t *testing.T) {
+	Convey("test adaptive concurrency limit of unordered delivery", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		cli := client.NewMockEventClient(ctrl)
+		ctx, cancel := context.WithCancel(context.Background())
+		id := vanus.NewTestID()
+		tg := NewTrigger(makeSubscription(id), WithControllers([]string{"test"}),
+			WithMaxConcurrency(4)).(*trigger)
+		mockClient := eb.NewMockClient(ctrl)
+		mockEventbus := api.NewMockEventbus(ctrl)
+		mockBusWriter := api.NewMockBusWriter(ctrl)
+		mockBusReader := api.NewMockBusReader(ctrl)
+		mockClient.EXPECT().Eventbus(gomock.Any(), gomock.Any()).AnyTimes().Return(mockEventbus)
+		mockEventbus.EXPECT().Writer().AnyTimes().Return(mockBusWriter)
+		mockEventbus.EXPECT().Reader().AnyTimes().Return(mockBusReader)
+		mockBusWriter.EXPECT().AppendOne(gomock.Any(), gomock.Any()).AnyTimes().Return("", nil)
+		tg.client = mockClient
+		_ = tg.Init(ctx)
+		tg.eventCli = cli
+		tg.timerEventWriter = mockBusWriter
+		tg.dlEventWriter = mockBusWriter
+		var sent int64
+		release := make(chan client.Result)
+		cli.EXPECT().Send(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+			func(_ context.Context, _ ...ce.Event) client.Result {
+				atomic.AddInt64(&sent, 1)
+				return <-release
+			})
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tg.runEventSend(ctx)
+		}()
+		for i := 0; i < 10; i++ {
+			tg.sendCh <- makeEventRecord("test")
+		}
+		time.Sleep(100 * time.Millisecond)
+		// the in-flight requests are bounded by the window.
+		So(atomic.LoadInt64(&sent), ShouldEqual, 4)
+		So(tg.limiter.InFlight(), ShouldEqual, 4)
+
+		// the sink is overloaded and asks to wait.
+		release <- client.Result{
+			StatusCode: http.StatusTooManyRequests,
+			Err:        fmt.Errorf("too many requests"),
+			RetryAfter: time.Second,
+		}
+		time.Sleep(100 * time.Millisecond)
+		So(tg.limiter.Limit(), ShouldEqual, 2)
+		So(atomic.LoadInt64(&sent), ShouldEqual, 4)
+		for i := 0; i < 3; i++ {
+			release <- client.Success
+		}
+		time.Sleep(100 * time.Millisecond)
+		// no more request is sent during retry after.
+		So(atomic.LoadInt64(&sent), ShouldEqual, 4)
+		So(tg.limiter.InFlight(), ShouldEqual, 0)
+		limit := tg.limiter.Limit()
+		So(limit, ShouldEqual, 2)
+		time.Sleep(time.Second)
+		So(atomic.LoadInt64(&sent), ShouldEqual, 4+limit)
+		for i := 0; i < limit; i++ {
+			release <- client.Success
+		}
+		cancel()
+		wg.Wait()
+	})
+}
+
+func TestTriggerKeyedEventSend(t *testing.T) {
+	Convey("test key-ordered event send", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		cli := client.NewMockEventClient(ctrl)
+		ctx, cancel := context.WithCancel(context.Background())
+		id := vanus.NewTestID()
+		tg := NewTrigger(makeSubscription(id), WithControllers([]string{"test"}),
+			WithOrderKey("partitionkey"), WithMaxRetryAttempts(2),
+			WithRetryPolicy(&primitive.RetryPolicy{
+				Strategy:     primitive.RetryStrategyFixed,
+				InitialDelay: 50,
+			})).(*trigger)
+		So(tg.config.FilterProcessSize, ShouldEqual, 1)
+		mockClient := eb.NewMockClient(ctrl)
+		mockEventbus := api.NewMockEventbus(ctrl)
+		mockBusWriter := api.NewMockBusWriter(ctrl)
+		mockBusReader := api.NewMockBusReader(ctrl)
+		mockClient.EXPECT().Eventbus(gomock.Any(), gomock.Any()).AnyTimes().Return(mockEventbus)
+		mockEventbus.EXPECT().Writer().AnyTimes().Return(mockBusWriter)
+		mockEventbus.EXPECT().Reader().AnyTimes().Return(mockBusReader)
+		tg.client = mockClient
+		_ = tg.Init(ctx)
+		tg.eventCli = cli
+		tg.timerEventWriter = mockBusWriter
+		tg.dlEventWriter = mockBusWriter
+		var (
+			lock     sync.Mutex
+			sent     = map[string][]string{}
+			failures = map[string]int{"a1": 1, "c1": 10}
+		)
+		cli.EXPECT().Send(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+			func(_ context.Context, events ...ce.Event) client.Result {
+				lock.Lock()
+				defer lock.Unlock()
+				e := events[0]
+				key := orderKey(&e, "partitionkey")
+				sent[key] = append(sent[key], e.ID())
+				if failures[e.ID()] > 0 {
+					failures[e.ID()]--
+					return client.Result{StatusCode: 500, Err: fmt.Errorf("500 error")}
+				}
+				return client.Success
+			})
+		var deadLetters []string
+		mockBusWriter.EXPECT().AppendOne(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+			func(_ context.Context, e *ce.Event, _ ...api.WriteOption) (string, error) {
+				lock.Lock()
+				defer lock.Unlock()
+				deadLetters = append(deadLetters, e.ID())
+				return "", nil
+			})
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tg.runEventSend(ctx)
+		}()
+		for _, v := range []struct{ id, key string }{
+			{"a1", "a"}, {"b1", "b"}, {"a2", "a"}, {"b2", "b"}, {"c1", "c"}, {"c2", "c"}, {"n1", ""},
+		} {
+			record := makeEventRecord("test")
+			record.Event.SetID(v.id)
+			if v.key != "" {
+				record.Event.SetExtension("partitionkey", v.key)
+			}
+			tg.sendCh <- record
+		}
+		time.Sleep(20 * time.Millisecond)
+		lock.Lock()
+		// the failed key waits for retry and doesn't block other keys.
+		So(sent["a"], ShouldResemble, []string{"a1"})
+		So(sent["b"], ShouldResemble, []string{"b1", "b2"})
+		So(sent[""], ShouldResemble, []string{"n1"})
+		lock.Unlock()
+		time.Sleep(300 * time.Millisecond)
+		lock.Lock()
+		// the failed event is retried in place before the later events of same key.
+		So(sent["a"], ShouldResemble, []string{"a1", "a1", "a2"})
+		// the event is dead-lettered after the retry attempts are exhausted.
+		So(sent["c"], ShouldResemble, []string{"c1", "c1", "c1", "c2"})
+		So(deadLetters, ShouldResemble, []string{"c1"})
+		lock.Unlock()
+		cancel()
+		wg.Wait()
+	})
+}
+
+func TestTriggerKeyedEventSendWithBlockedKey(t *testing.T) {
+	Convey("test key-ordered event send with a key retrying in place", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		cli := client.NewMockEventClient(ctrl)
+		ctx, cancel := context.WithCancel(context.Background())
+		id := vanus.NewTestID()
+		tg := NewTrigger(makeSubscription(id), WithControllers([]string{"test"}),
+			WithOrderKey("partitionkey"), WithBufferSize(8), WithMaxRetryAttempts(1000),
+			WithRetryPolicy(&primitive.RetryPolicy{
+				Strategy:     primitive.RetryStrategyFixed,
+				InitialDelay: 10,
+			})).(*trigger)
+		mockClient := eb.NewMockClient(ctrl)
+		mockEventbus := api.NewMockEventbus(ctrl)
+		mockBusWriter := api.NewMockBusWriter(ctrl)
+		mockBusReader := api.NewMockBusReader(ctrl)
+		mockClient.EXPECT().Eventbus(gomock.Any(), gomock.Any()).AnyTimes().Return(mockEventbus)
+		mockEventbus.EXPECT().Writer().AnyTimes().Return(mockBusWriter)
+		mockEventbus.EXPECT().Reader().AnyTimes().Return(mockBusReader)
+		tg.client = mockClient
+		_ = tg.Init(ctx)
+		tg.eventCli = cli
+		tg.timerEventWriter = mockBusWriter
+		tg.dlEventWriter = mockBusWriter
+		var (
+			lock sync.Mutex
+			sent = map[string]int{}
+		)
+		cli.EXPECT().Send(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+			func(_ context.Context, events ...ce.Event) client.Result {
+				lock.Lock()
+				defer lock.Unlock()
+				e := events[0]
+				key := orderKey(&e, "partitionkey")
+				sent[key]++
+				if key == "bad" {
+					return client.Result{StatusCode: 500, Err: fmt.Errorf("500 error")}
+				}
+				return client.Success
+			})
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tg.runEventSend(ctx)
+		}()
+		send := func(key string, n int) {
+			for i := 0; i < n; i++ {
+				record := makeEventRecord("test")
+				record.Event.SetExtension("partitionkey", key)
+				tg.sendCh <- record
+			}
+		}
+		// the events of failed key are more than the shared budget, it holds 2 shared slots and
+		// 7 parked slots.
+		send("bad", 9)
+		send("good", 20)
+		time.Sleep(100 * time.Millisecond)
+		lock.Lock()
+		So(sent["good"], ShouldEqual, 20)
+		So(sent["bad"], ShouldBeGreaterThan, 1)
+		lock.Unlock()
+		send("good", 20)
+		time.Sleep(100 * time.Millisecond)
+		lock.Lock()
+		So(sent["good"], ShouldEqual, 40)
+		lock.Unlock()
+		cancel()
+		wg.Wait()
+	})
+}
+
+type mockBatchClient struct {
+	*client.MockEventClient
+	*client.MockBatchSender
+}
+
+func TestTriggerRunBatchEventSend(t *testing.T) {
+	Convey("test batch event send", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ctx := context.Background()
+		id := vanus.NewTestID()
+		tg := NewTrigger(makeSubscription(id), WithControllers([]string{"test"}),
+			WithBatch(4, 50)).(*trigger)
+		mockClient := eb.NewMockClient(ctrl)
+		mockEventbus := api.NewMockEventbus(ctrl)
+		mockBusWriter := api.NewMockBusWriter(ctrl)
+		mockBusReader := api.NewMockBusReader(ctrl)
+		mockClient.EXPECT().Eventbus(gomock.Any(), gomock.Any()).AnyTimes().Return(mockEventbus)
+		mockEventbus.EXPECT().Writer().AnyTimes().Return(mockBusWriter)
+		mockEventbus.EXPECT().Reader().AnyTimes().Return(mockBusReader)
+		tg.client = mockClient
+		_ = tg.Init(ctx)
+		tg.dlEventWriter = mockBusWriter
+		tg.timerEventWriter = mockBusWriter
+		sender := client.NewMockBatchSender(ctrl)
+		tg.eventCli = &mockBatchClient{
+			MockEventClient: client.NewMockEventClient(ctrl),
+			MockBatchSender: sender,
+		}
+		run := func(size int) {
+			for i := 0; i < size; i++ {
+				tg.sendCh <- makeEventRecord("test")
+			}
+			var wg sync.WaitGroup
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				tg.runEventSend(ctx)
+			}()
+			time.Sleep(200 * time.Millisecond)
+			close(tg.sendCh)
+			wg.Wait()
+		}
+		Convey("test batch by size and timeout", func() {
+			var mutex sync.Mutex
+			var sizes []int
+			sender.EXPECT().SendBatch(gomock.Any(), gomock.Any()).Times(3).DoAndReturn(
+				func(_ context.Context, events []*ce.Event) client.Result {
+					mutex.Lock()
+					defer mutex.Unlock()
+					sizes = append(sizes, len(events))
+					return client.Success
+				})
+			run(10)
+			So(sizes, ShouldHaveLength, 3)
+			So(sizes[0]+sizes[1]+sizes[2], ShouldEqual, 10)
+			So(sizes, ShouldContain, 2)
+		})
+		Convey("test failed batch split into retry", func() {
+			sender.EXPECT().SendBatch(gomock.Any(), gomock.Any()).Times(1).Return(client.Result{
+				StatusCode: 500, Err: fmt.Errorf("500 error"),
+			})
+			var retryCount int64
+			mockBusWriter.EXPECT().AppendOne(gomock.Any(), gomock.Any()).Times(3).DoAndReturn(
+				func(_ context.Context, e *ce.Event, _ ...api.WriteOption) (string, error) {
+					if e.Extensions()[primitive.XVanusEventbus] == primitive.RetryEventbusName {
+						atomic.AddInt64(&retryCount, 1)
+					}
+					return "", nil
+				})
+			run(3)
+			So(atomic.LoadInt64(&retryCount), ShouldEqual, 3)
+		})
+	})
+}
+
 func TestTriggerRateLimit(t *testing.T) {
 	Convey("test rate limit", t, func() {
 		ctrl := gomock.NewController(t)
@@ -253,7 +639,7 @@ func testSendEvent(tg *trigger) int64 {
 				if !ok {
 					return
 				}
-				_, _ = tg.sendEvent(ctx, event)
+				_ = tg.sendEvent(ctx, event)
 				atomic.AddInt64(&c, 1)
 			}
 		}
@@ -265,6 +651,133 @@ func testSendEvent(tg *trigger) int64 {
 	return c
 }
 
+func TestTriggerAggregation(t *testing.T) {
+	Convey("test aggregation", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		cli := client.NewMockEventClient(ctrl)
+		ctx := context.Background()
+		id := vanus.NewTestID()
+		sub := makeSubscription(id)
+		sub.Aggregation = &primitive.Aggregation{
+			WindowType: primitive.TumblingWindow,
+			WindowSize: 1000,
+			Key:        "subject",
+			Field:      "$.data.value",
+			Functions:  []primitive.AggregateFunction{primitive.AggregateCount, primitive.AggregateSum},
+		}
+		tg := NewTrigger(sub, WithControllers([]string{"test"}),
+			WithAggregation(sub.Aggregation)).(*trigger)
+		So(tg.config.FilterProcessSize, ShouldEqual, 1)
+		mockClient := eb.NewMockClient(ctrl)
+		mockEventbus := api.NewMockEventbus(ctrl)
+		mockBusWriter := api.NewMockBusWriter(ctrl)
+		mockBusReader := api.NewMockBusReader(ctrl)
+		mockClient.EXPECT().Eventbus(gomock.Any(), gomock.Any()).AnyTimes().Return(mockEventbus)
+		mockEventbus.EXPECT().Writer().AnyTimes().Return(mockBusWriter)
+		mockEventbus.EXPECT().Reader().AnyTimes().Return(mockBusReader)
+		tg.client = mockClient
+		So(tg.Init(ctx), ShouldBeNil)
+		tg.eventCli = cli
+		var sent []ce.Event
+		cli.EXPECT().Send(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+			func(_ context.Context, e ce.Event) client.Result {
+				sent = append(sent, e)
+				return client.Success
+			})
+
+		base := time.Unix(1000, 0)
+		arrive := func(subject string, offset time.Duration, value int) {
+			record := makeEventRecord("test")
+			record.Event.SetSubject(subject)
+			record.Event.SetTime(base.Add(offset))
+			_ = record.Event.SetData(ce.ApplicationJSON, map[string]int{"value": value})
+			_ = tg.eventArrived(ctx, record)
+		}
+		arrive("a", 0, 1)
+		arrive("a", 100*time.Millisecond, 2)
+		arrive("b", 200*time.Millisecond, 5)
+		arrive("a", 1500*time.Millisecond, 4)
+		close(tg.eventCh)
+		tg.runEventFilter(ctx)
+
+		So(sent, ShouldHaveLength, 2)
+		So(sent[0].ID(), ShouldEqual, fmt.Sprintf("%s:a:%d", id, base.UnixMilli()))
+		So(sent[0].Type(), ShouldEqual, defaultAggregationEventType)
+		So(sent[0].Subject(), ShouldEqual, "a")
+		So(string(sent[0].Data()), ShouldContainSubstring, `"count":2`)
+		So(string(sent[0].Data()), ShouldContainSubstring, `"sum":3`)
+		So(sent[1].Subject(), ShouldEqual, "b")
+		So(string(sent[1].Data()), ShouldContainSubstring, `"sum":5`)
+		So(tg.pending, ShouldBeEmpty)
+
+		Convey("restore from checkpoint", func() {
+			cp := tg.GetCheckpoint(ctx)
+			So(cp, ShouldNotBeNil)
+			sub2 := makeSubscription(id)
+			sub2.Aggregation = sub.Aggregation
+			sub2.Checkpoint = cp
+			tg2 := NewTrigger(sub2, WithControllers([]string{"test"}),
+				WithAggregation(sub2.Aggregation)).(*trigger)
+			tg2.client = mockClient
+			So(tg2.Init(ctx), ShouldBeNil)
+			So(tg2.aggregator.Len(), ShouldEqual, 1)
+			tg2.aggregator.Idle(time.Second)
+			results := tg2.aggregator.Expired()
+			So(results, ShouldHaveLength, 1)
+			So(results[0].Value(primitive.AggregateSum), ShouldEqual, 4)
+		})
+
+		Convey("reset offset drops the windows", func() {
+			r := reader.NewMockReader(ctrl)
+			tg.reader = r
+			r.EXPECT().GetOffsetByTimestamp(gomock.Any(), gomock.Any()).Return(pInfo.ListOffsetInfo{}, nil)
+			_, err := tg.ResetOffsetToTimestamp(ctx, time.Now().Unix())
+			So(err, ShouldBeNil)
+			So(tg.aggregator.Len(), ShouldEqual, 0)
+		})
+	})
+
+	Convey("test aggregation to target eventbus", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ctx := context.Background()
+		sub := makeSubscription(vanus.NewTestID())
+		sub.Sink = ""
+		sub.Aggregation = &primitive.Aggregation{
+			WindowType:     primitive.TumblingWindow,
+			WindowSize:     1000,
+			Functions:      []primitive.AggregateFunction{primitive.AggregateCount},
+			Type:           "test.count",
+			TargetEventbus: "target",
+		}
+		tg := NewTrigger(sub, WithControllers([]string{"test"}),
+			WithAggregation(sub.Aggregation)).(*trigger)
+		So(tg.Init(ctx), ShouldBeNil)
+		So(tg.targetEventWriter, ShouldNotBeNil)
+		mockTargetWriter := api.NewMockBusWriter(ctrl)
+		tg.targetEventWriter = mockTargetWriter
+		var written []*ce.Event
+		mockTargetWriter.EXPECT().AppendOne(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, e *ce.Event, _ ...api.WriteOption) (string, error) {
+				written = append(written, e)
+				return "", nil
+			})
+
+		base := time.Unix(1000, 0)
+		for _, offset := range []time.Duration{0, 10 * time.Millisecond, time.Second} {
+			record := makeEventRecord("test")
+			record.Event.SetTime(base.Add(offset))
+			_ = tg.eventArrived(ctx, record)
+		}
+		close(tg.eventCh)
+		tg.runEventFilter(ctx)
+		So(written, ShouldHaveLength, 1)
+		So(written[0].Type(), ShouldEqual, "test.count")
+		So(string(written[0].Data()), ShouldContainSubstring, `"count":2`)
+	})
+}
+
 func makeSubscription(id vanus.ID) *primitive.Subscription {
 	return &primitive.Subscription{
 		ID:      id,
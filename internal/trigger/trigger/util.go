@@ -16,7 +16,9 @@ package trigger
 
 import (
 	"fmt"
+	"io"
 	"math"
+	"math/rand"
 	"strconv"
 	"time"
 
@@ -34,11 +36,25 @@ func newEventClient(sink primitive.URI,
 	case primitive.GCloudFunctions:
 		_credential, _ := credential.(*primitive.GCloudSinkCredential)
 		return client.NewGCloudFunctionClient(string(sink), _credential.CredentialJSON)
+	case primitive.KafkaProtocol:
+		_credential, _ := credential.(*primitive.KafkaSinkCredential)
+		return client.NewKafkaClient(string(sink), _credential)
+	case primitive.GRPCProtocol:
+		_credential, _ := credential.(*primitive.TLSSinkCredential)
+		return client.NewGRPCClient(string(sink), _credential)
 	default:
 		return client.NewHTTPClient(string(sink))
 	}
 }
 
+// closeEventClient releases the connections held by the client, e.g. the producer of kafka and
+// the connection pool of gRPC.
+func closeEventClient(cli client.EventClient) {
+	if c, ok := cli.(io.Closer); ok {
+		_ = c.Close()
+	}
+}
+
 const NoNeedRetryCode = -1
 
 func isShouldRetry(statusCode int) (bool, string) {
@@ -56,7 +72,54 @@ func isShouldRetry(statusCode int) (bool, string) {
 	}
 }
 
-func calDeliveryTime(attempts int32) time.Duration {
+const (
+	defaultRetryMaxDelay           = time.Hour
+	defaultRetryLinearMultiplier   = 1
+	defaultRetryExponentMultiplier = 2
+)
+
+// calDeliveryTime returns the delay of the attempts-th retry, the default backoff is used if policy is nil.
+func calDeliveryTime(policy *primitive.RetryPolicy, attempts int32) time.Duration {
+	if policy == nil || policy.Strategy == primitive.RetryStrategyDefault {
+		return calDefaultDeliveryTime(attempts)
+	}
+	if attempts < 1 {
+		attempts = 1
+	}
+	initial := float64(policy.InitialDelay) * float64(time.Millisecond)
+	var delay float64
+	switch policy.Strategy {
+	case primitive.RetryStrategyLinear:
+		multiplier := policy.Multiplier
+		if multiplier <= 0 {
+			multiplier = defaultRetryLinearMultiplier
+		}
+		delay = initial * (1 + float64(attempts-1)*multiplier)
+	case primitive.RetryStrategyExponential:
+		multiplier := policy.Multiplier
+		if multiplier <= 0 {
+			multiplier = defaultRetryExponentMultiplier
+		}
+		delay = initial * math.Pow(multiplier, float64(attempts-1))
+	default:
+		delay = initial
+	}
+	if policy.Jitter > 0 {
+		// random in [delay*(1-jitter), delay*(1+jitter)]
+		delay += delay * policy.Jitter * (2*rand.Float64() - 1) //nolint:gosec // no need crypto random
+	}
+	// clamp after jitter, so the delay never exceeds max delay.
+	maxDelay := defaultRetryMaxDelay
+	if policy.MaxDelay > 0 {
+		maxDelay = time.Duration(policy.MaxDelay) * time.Millisecond
+	}
+	if delay > float64(maxDelay) {
+		delay = float64(maxDelay)
+	}
+	return time.Duration(delay)
+}
+
+func calDefaultDeliveryTime(attempts int32) time.Duration {
 	var v int
 	switch {
 	case attempts >= 10:
@@ -53,25 +53,66 @@ func TestIsShouldRetry(t *testing.T) {
 
 func TestCalDeliveryTime(t *testing.T) {
 	Convey("test cal delivery time", t, func() {
-		d := calDeliveryTime(1)
+		d := calDeliveryTime(nil, 1)
 		So(d, ShouldEqual, time.Second)
-		d = calDeliveryTime(2)
+		d = calDeliveryTime(nil, 2)
 		So(d, ShouldEqual, time.Second*5)
-		d = calDeliveryTime(3)
+		d = calDeliveryTime(nil, 3)
 		So(d, ShouldEqual, time.Second*10)
-		d = calDeliveryTime(4)
+		d = calDeliveryTime(nil, 4)
 		So(d, ShouldEqual, time.Second*30)
-		d = calDeliveryTime(5)
+		d = calDeliveryTime(nil, 5)
 		So(d, ShouldEqual, time.Second*60)
-		d = calDeliveryTime(6)
+		d = calDeliveryTime(nil, 6)
 		So(d, ShouldEqual, time.Second*120)
-		d = calDeliveryTime(7)
+		d = calDeliveryTime(nil, 7)
 		So(d, ShouldEqual, time.Second*240)
-		d = calDeliveryTime(8)
+		d = calDeliveryTime(nil, 8)
 		So(d, ShouldEqual, time.Second*480)
-		d = calDeliveryTime(9)
+		d = calDeliveryTime(nil, 9)
 		So(d, ShouldEqual, time.Second*960)
-		d = calDeliveryTime(10)
+		d = calDeliveryTime(nil, 10)
 		So(d, ShouldEqual, time.Second*3600)
 	})
+
+	Convey("test cal delivery time with retry policy", t, func() {
+		Convey("fixed", func() {
+			policy := &primitive.RetryPolicy{Strategy: primitive.RetryStrategyFixed, InitialDelay: 100}
+			So(calDeliveryTime(policy, 1), ShouldEqual, 100*time.Millisecond)
+			So(calDeliveryTime(policy, 5), ShouldEqual, 100*time.Millisecond)
+		})
+		Convey("linear", func() {
+			policy := &primitive.RetryPolicy{Strategy: primitive.RetryStrategyLinear, InitialDelay: 100}
+			So(calDeliveryTime(policy, 1), ShouldEqual, 100*time.Millisecond)
+			So(calDeliveryTime(policy, 3), ShouldEqual, 300*time.Millisecond)
+			policy.Multiplier = 0.5
+			So(calDeliveryTime(policy, 3), ShouldEqual, 200*time.Millisecond)
+		})
+		Convey("exponential", func() {
+			policy := &primitive.RetryPolicy{Strategy: primitive.RetryStrategyExponential,
+				InitialDelay: 1000, MaxDelay: 5000}
+			So(calDeliveryTime(policy, 1), ShouldEqual, time.Second)
+			So(calDeliveryTime(policy, 3), ShouldEqual, 4*time.Second)
+			So(calDeliveryTime(policy, 4), ShouldEqual, 5*time.Second)
+			policy.Multiplier = 3
+			So(calDeliveryTime(policy, 2), ShouldEqual, 3*time.Second)
+		})
+		Convey("jitter", func() {
+			policy := &primitive.RetryPolicy{Strategy: primitive.RetryStrategyFixed,
+				InitialDelay: 1000, Jitter: 0.2}
+			for i := 0; i < 100; i++ {
+				d := calDeliveryTime(policy, 1)
+				So(d, ShouldBeBetweenOrEqual, 800*time.Millisecond, 1200*time.Millisecond)
+			}
+		})
+		Convey("jitter with capped exponential", func() {
+			policy := &primitive.RetryPolicy{Strategy: primitive.RetryStrategyExponential,
+				InitialDelay: 1000, MaxDelay: 5000, Jitter: 0.2}
+			for i := 0; i < 100; i++ {
+				So(calDeliveryTime(policy, 3), ShouldBeBetweenOrEqual, 3200*time.Millisecond, 4800*time.Millisecond)
+				So(calDeliveryTime(policy, 4), ShouldEqual, 5*time.Second)
+				So(calDeliveryTime(policy, 10), ShouldEqual, 5*time.Second)
+			}
+		})
+	})
 }
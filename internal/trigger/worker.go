@@ -30,9 +30,9 @@ import (
 	"github.com/linkall-labs/vanus/observability/metrics"
 	"github.com/linkall-labs/vanus/pkg/cluster"
 	"github.com/linkall-labs/vanus/pkg/errors"
+	"github.com/linkall-labs/vanus/pkg/util/tlsconfig"
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 type Worker interface {
@@ -75,7 +75,7 @@ func NewWorker(config Config) Worker {
 
 	m := &worker{
 		config:     config,
-		ctrl:       cluster.NewClusterController(config.ControllerAddr, insecure.NewCredentials()),
+		ctrl:       cluster.NewClusterController(config.ControllerAddr, tlsconfig.ClientCredentials()),
 		triggerMap: make(map[vanus.ID]trigger.Trigger),
 		newTrigger: trigger.NewTrigger,
 	}
@@ -288,6 +288,7 @@ func (w *worker) getAllSubscriptionInfo(ctx context.Context) []*metapb.Subscript
 		subInfos = append(subInfos, &metapb.SubscriptionInfo{
 			SubscriptionId: uint64(id),
 			Offsets:        convert.ToPbOffsetInfos(t.GetOffsets(ctx)),
+			Checkpoint:     t.GetCheckpoint(ctx),
 		})
 	}
 	return subInfos
@@ -300,6 +301,12 @@ func (w *worker) getTriggerOptions(subscription *primitive.Subscription) []trigg
 		trigger.WithDeliveryTimeout(config.DeliveryTimeout),
 		trigger.WithMaxRetryAttempts(config.GetMaxRetryAttempts()),
 		trigger.WithDeadLetterEventbus(config.DeadLetterEventbus),
-		trigger.WithOrdered(config.OrderedEvent))
+		trigger.WithOrdered(config.OrderedEvent),
+		trigger.WithOrderKey(config.OrderKey),
+		trigger.WithRetryPolicy(config.RetryPolicy),
+		trigger.WithBatch(config.MaxBatchSize, config.BatchTimeout),
+		trigger.WithCircuitBreaker(config.CircuitBreaker),
+		trigger.WithMaxConcurrency(config.MaxConcurrency),
+		trigger.WithAggregation(subscription.Aggregation))
 	return opts
 }
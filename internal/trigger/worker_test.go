@@ -214,6 +214,7 @@ func TestWorker_Stop(t *testing.T) {
 		tg.EXPECT().Stop(gomock.Any()).AnyTimes().Return(nil)
 		offsets := info.ListOffsetInfo{{EventLogID: vanus.NewTestID(), Offset: uint64(100)}}
 		tg.EXPECT().GetOffsets(gomock.Any()).AnyTimes().Return(offsets)
+		tg.EXPECT().GetCheckpoint(gomock.Any()).AnyTimes().Return(nil)
 		triggerClient.EXPECT().CommitOffset(gomock.Any(), gomock.Any()).Return(nil, nil)
 		err = m.Stop(ctx)
 		So(err, ShouldBeNil)
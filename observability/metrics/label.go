@@ -23,7 +23,9 @@ const (
 	LabelTriggerWorker = "trigger_worker"
 	LabelTrigger       = "trigger"
 	LabelResult        = "result"
+	LabelState         = "state"
 	LabelBlock         = "block"
+	LabelCompression   = "compression"
 
 	LabelTimer = "timer"
 )
@@ -36,6 +38,8 @@ const (
 	LabelSegmentDeletedBecauseExpired      = "segment_expired"
 	LabelSegmentDeletedBecauseCreateFailed = "segment_create_failed"
 	LabelSegmentDeletedBecauseDeleted      = "segment_deleted"
+	LabelSegmentDeletedBecauseOversize     = "segment_oversize"
+	LabelSegmentDeletedBecauseOverCount    = "segment_over_count"
 )
 
 const (
@@ -53,6 +53,14 @@ func RegisterTriggerMetrics() {
 	prometheus.MustRegister(TriggerDeadLetterEventAppendSecond)
 	prometheus.MustRegister(TriggerPushEventCounter)
 	prometheus.MustRegister(TriggerPushEventTime)
+	prometheus.MustRegister(TriggerLagGauge)
+	prometheus.MustRegister(TriggerLagSecond)
+	prometheus.MustRegister(TriggerCircuitBreakerState)
+	prometheus.MustRegister(TriggerCircuitBreakerTransitionCounter)
+	prometheus.MustRegister(TriggerConcurrencyLimit)
+	prometheus.MustRegister(TriggerInFlightRequests)
+	prometheus.MustRegister(TriggerAggregationLateEventCounter)
+	prometheus.MustRegister(TriggerAggregationWindowGauge)
 }
 
 func RegisterTimerMetrics() {
@@ -71,6 +79,9 @@ func RegisterSegmentServerMetrics() {
 	prometheus.MustRegister(WriteThroughputCounterVec)
 	prometheus.MustRegister(ReadTPSCounterVec)
 	prometheus.MustRegister(ReadThroughputCounterVec)
+	prometheus.MustRegister(CompressionInputByteCounterVec)
+	prometheus.MustRegister(CompressionOutputByteCounterVec)
+	prometheus.MustRegister(CompressionRatioHistogramVec)
 }
 
 func registerGoRuntimeMetrics() {
@@ -47,6 +47,28 @@ var (
 		Help:      "Total bytes for reading",
 	}, []string{LabelVolume, LabelBlock})
 
+	CompressionInputByteCounterVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfSegmentServer,
+		Name:      "compression_input_byte_count",
+		Help:      "Total bytes of event data before compressing",
+	}, []string{LabelCompression})
+
+	CompressionOutputByteCounterVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfSegmentServer,
+		Name:      "compression_output_byte_count",
+		Help:      "Total bytes of event data after compressing",
+	}, []string{LabelCompression})
+
+	CompressionRatioHistogramVec = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfSegmentServer,
+		Name:      "compression_ratio",
+		Help:      "The ratio of event data size before compressing to after of each append",
+		Buckets:   []float64{1, 1.5, 2, 3, 4, 6, 8, 10, 15, 20},
+	}, []string{LabelCompression})
+
 	WALEntryWriteCounter = prometheus.NewCounter(prometheus.CounterOpts{
 		Namespace: namespace,
 		Subsystem: moduleOfSegmentServer,
@@ -102,4 +102,60 @@ var (
 		Name:      "push_event_rt",
 		Help:      "The rt of trigger push event",
 	}, []string{LabelTrigger})
+
+	TriggerLagGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfTriggerWorker,
+		Name:      "lag_event_number",
+		Help:      "The number of events which the trigger hasn't consumed",
+	}, []string{LabelTrigger, LabelEventbus, LabelEventlog})
+
+	TriggerLagSecond = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfTriggerWorker,
+		Name:      "lag_second",
+		Help:      "The age of the oldest event which the trigger hasn't consumed",
+	}, []string{LabelTrigger, LabelEventbus, LabelEventlog})
+
+	TriggerCircuitBreakerState = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfTriggerWorker,
+		Name:      "circuit_breaker_state",
+		Help:      "The state of sink circuit breaker, 0 is closed, 1 is open and 2 is half-open",
+	}, []string{LabelTrigger})
+
+	TriggerCircuitBreakerTransitionCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfTriggerWorker,
+		Name:      "circuit_breaker_transition_total",
+		Help:      "The number of times the sink circuit breaker changed to the state",
+	}, []string{LabelTrigger, LabelState})
+
+	TriggerConcurrencyLimit = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfTriggerWorker,
+		Name:      "concurrency_limit",
+		Help:      "The adaptive limit of in-flight requests to sink",
+	}, []string{LabelTrigger})
+
+	TriggerInFlightRequests = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfTriggerWorker,
+		Name:      "inflight_requests",
+		Help:      "The number of in-flight requests to sink",
+	}, []string{LabelTrigger})
+
+	TriggerAggregationLateEventCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfTriggerWorker,
+		Name:      "aggregation_late_event_number",
+		Help:      "The number of events dropped for their windows were closed",
+	}, []string{LabelTrigger})
+
+	TriggerAggregationWindowGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfTriggerWorker,
+		Name:      "aggregation_open_windows",
+		Help:      "The number of open windows of aggregation",
+	}, []string{LabelTrigger})
 )
@@ -95,3 +95,111 @@ func (ec *eventbusClient) UpdateEventBus(ctx context.Context, in *ctrlpb.UpdateE
 	}
 	return out, nil
 }
+
+func (ec *eventbusClient) JoinConsumerGroup(ctx context.Context, in *ctrlpb.JoinConsumerGroupRequest, opts ...grpc.CallOption) (*ctrlpb.ConsumerGroupAssignment, error) {
+	out := new(ctrlpb.ConsumerGroupAssignment)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/JoinConsumerGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) ConsumerGroupHeartbeat(ctx context.Context, in *ctrlpb.ConsumerGroupHeartbeatRequest, opts ...grpc.CallOption) (*ctrlpb.ConsumerGroupAssignment, error) {
+	out := new(ctrlpb.ConsumerGroupAssignment)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/ConsumerGroupHeartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) LeaveConsumerGroup(ctx context.Context, in *ctrlpb.LeaveConsumerGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/LeaveConsumerGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) CommitConsumerGroupOffset(ctx context.Context, in *ctrlpb.CommitConsumerGroupOffsetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/CommitConsumerGroupOffset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) CreateScheduledEvent(ctx context.Context, in *metapb.ScheduledEvent, opts ...grpc.CallOption) (*metapb.ScheduledEvent, error) {
+	out := new(metapb.ScheduledEvent)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/CreateScheduledEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) GetScheduledEvent(ctx context.Context, in *ctrlpb.GetScheduledEventRequest, opts ...grpc.CallOption) (*metapb.ScheduledEvent, error) {
+	out := new(metapb.ScheduledEvent)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/GetScheduledEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) DeleteScheduledEvent(ctx context.Context, in *ctrlpb.DeleteScheduledEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/DeleteScheduledEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) ListScheduledEvent(ctx context.Context, in *ctrlpb.ListScheduledEventRequest, opts ...grpc.CallOption) (*ctrlpb.ListScheduledEventResponse, error) {
+	out := new(ctrlpb.ListScheduledEventResponse)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/ListScheduledEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) RegisterSchema(ctx context.Context, in *ctrlpb.RegisterSchemaRequest, opts ...grpc.CallOption) (*metapb.Schema, error) {
+	out := new(metapb.Schema)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/RegisterSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) GetSchema(ctx context.Context, in *ctrlpb.GetSchemaRequest, opts ...grpc.CallOption) (*metapb.Schema, error) {
+	out := new(metapb.Schema)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/GetSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) ListSchema(ctx context.Context, in *ctrlpb.ListSchemaRequest, opts ...grpc.CallOption) (*ctrlpb.ListSchemaResponse, error) {
+	out := new(ctrlpb.ListSchemaResponse)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/ListSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) DeleteSchema(ctx context.Context, in *ctrlpb.DeleteSchemaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/DeleteSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -154,6 +154,26 @@ func (sc *segmentClient) QuerySegmentRouteInfo(ctx context.Context, in *ctrlpb.Q
 	return out, nil
 }
 
+func (sc *segmentClient) DrainVolume(ctx context.Context, in *ctrlpb.DrainVolumeRequest,
+	opts ...grpc.CallOption) (*ctrlpb.VolumeDrainStatus, error) {
+	out := new(ctrlpb.VolumeDrainStatus)
+	err := sc.cc.invoke(ctx, "/linkall.vanus.controller.SegmentController/DrainVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (sc *segmentClient) GetVolumeDrainStatus(ctx context.Context, in *ctrlpb.GetVolumeDrainStatusRequest,
+	opts ...grpc.CallOption) (*ctrlpb.VolumeDrainStatus, error) {
+	out := new(ctrlpb.VolumeDrainStatus)
+	err := sc.cc.invoke(ctx, "/linkall.vanus.controller.SegmentController/GetVolumeDrainStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (sc *segmentClient) SegmentHeartbeat(_ context.Context, _ ...grpc.CallOption) (ctrlpb.SegmentController_SegmentHeartbeatClient, error) {
 	//TODO implement me
 	panic("unsupported method, please use controller.RegisterHeartbeat")
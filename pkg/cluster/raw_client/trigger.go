@@ -192,6 +192,26 @@ func (tc *triggerClient) CommitOffset(ctx context.Context, in *ctrlpb.CommitOffs
 	return out, nil
 }
 
+func (tc *triggerClient) CreateDeadLetterOperation(ctx context.Context, in *metapb.DeadLetterOperation,
+	opts ...grpc.CallOption) (*metapb.DeadLetterOperation, error) {
+	out := new(metapb.DeadLetterOperation)
+	err := tc.cc.invoke(ctx, "/linkall.vanus.controller.TriggerController/CreateDeadLetterOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (tc *triggerClient) ListDeadLetterOperation(ctx context.Context, in *ctrlpb.ListDeadLetterOperationRequest,
+	opts ...grpc.CallOption) (*ctrlpb.ListDeadLetterOperationResponse, error) {
+	out := new(ctrlpb.ListDeadLetterOperationResponse)
+	err := tc.cc.invoke(ctx, "/linkall.vanus.controller.TriggerController/ListDeadLetterOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (tc *triggerClient) TriggerWorkerHeartbeat(_ context.Context,
 	_ ...grpc.CallOption) (ctrlpb.TriggerController_TriggerWorkerHeartbeatClient, error) {
 	panic("unsupported method, please use controller.RegisterHeartbeat")
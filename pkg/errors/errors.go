@@ -75,6 +75,7 @@ const (
 	ErrorCode_TRY_AGAIN               ErrorCode = 9608
 	ErrorCode_NO_ENDPOINT             ErrorCode = 9609
 	ErrorCode_CLOSED                  ErrorCode = 9610
+	ErrorCode_LEARNER_NOT_CAUGHT_UP   ErrorCode = 9611
 
 	// ErrorCode_NOT_LEADER 97xx
 	ErrorCode_NOT_LEADER           ErrorCode = 9700
@@ -86,6 +87,8 @@ const (
 	// ErrorCode_OTHERS 99xx
 	ErrorCode_RESOURCE_EXHAUSTED  ErrorCode = 9901
 	ErrorCode_RESOURCE_CAN_NOT_OP ErrorCode = 9902
+	ErrorCode_UNAUTHENTICATED     ErrorCode = 9903
+	ErrorCode_PERMISSION_DENIED   ErrorCode = 9904
 )
 
 var (
@@ -117,6 +120,7 @@ var (
 	ErrTryAgain              = New("try again").WithGRPCCode(ErrorCode_TRY_AGAIN)
 	ErrNoEndpoint            = New("no endpoint").WithGRPCCode(ErrorCode_NO_ENDPOINT)
 	ErrClosed                = New("closed").WithGRPCCode(ErrorCode_CLOSED)
+	ErrLearnerNotCaughtUp    = New("the learner hasn't caught up").WithGRPCCode(ErrorCode_LEARNER_NOT_CAUGHT_UP)
 
 	// INTERNAL
 	ErrInternal               = New("internal error").WithGRPCCode(ErrorCode_INTERNAL)
@@ -159,4 +163,10 @@ var (
 
 	// RESOURCE_CAN_NOT_OP
 	ErrResourceCanNotOp = New("resource can not operation").WithGRPCCode(ErrorCode_RESOURCE_CAN_NOT_OP)
+
+	// UNAUTHENTICATED
+	ErrUnauthenticated = New("unauthenticated").WithGRPCCode(ErrorCode_UNAUTHENTICATED)
+
+	// PERMISSION_DENIED
+	ErrPermissionDenied = New("permission denied").WithGRPCCode(ErrorCode_PERMISSION_DENIED)
 )
@@ -0,0 +1,51 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlsconfig
+
+import (
+	"time"
+)
+
+const (
+	defaultReloadInterval = 10 * time.Second
+)
+
+// Config is the TLS configuration of the gRPC links between components, the same certificate
+// is used both as server and client.
+type Config struct {
+	Enable   bool   `yaml:"enable"`
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+	// CAFile is used to verify the certificate of peer, the system roots are used if it's empty.
+	CAFile string `yaml:"ca_file"`
+	// ServerName overrides the name which is used to verify the certificate of server, the host
+	// of the dialed address by default.
+	ServerName string `yaml:"server_name"`
+	// VerifyPeer enables mutual TLS, the server requires and verifies the certificate of client.
+	VerifyPeer bool `yaml:"verify_peer"`
+	// AllowedIdentities are the patterns of the peer identity, which is the common name, the DNS
+	// names or the URIs of the peer certificate. Any peer is allowed if it's empty. The server
+	// only checks the identity of client when VerifyPeer is enabled.
+	AllowedIdentities []string `yaml:"allowed_identities"`
+	// ReloadInterval is the minimal interval to check whether the files have changed.
+	ReloadInterval time.Duration `yaml:"reload_interval"`
+}
+
+func (c Config) reloadInterval() time.Duration {
+	if c.ReloadInterval <= 0 {
+		return defaultReloadInterval
+	}
+	return c.ReloadInterval
+}
@@ -0,0 +1,283 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlsconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var (
+	serverCredentials = insecure.NewCredentials()
+	clientCredentials = insecure.NewCredentials()
+)
+
+// Init sets up the credentials of the process, the links are plaintext if it isn't called or
+// the TLS is disabled.
+func Init(cfg Config) error {
+	server, err := NewServerCredentials(cfg)
+	if err != nil {
+		return err
+	}
+	client, err := NewClientCredentials(cfg)
+	if err != nil {
+		return err
+	}
+	serverCredentials = server
+	clientCredentials = client
+	return nil
+}
+
+// ServerCredentials returns the credentials used by the gRPC servers of the process.
+func ServerCredentials() credentials.TransportCredentials {
+	return serverCredentials
+}
+
+// ClientCredentials returns the credentials used to dial the other components.
+func ClientCredentials() credentials.TransportCredentials {
+	return clientCredentials
+}
+
+func NewServerCredentials(cfg Config) (credentials.TransportCredentials, error) {
+	if !cfg.Enable {
+		return insecure.NewCredentials(), nil
+	}
+	if cfg.CertFile == "" || cfg.KeyFile == "" {
+		return nil, errors.ErrInvalidArgument.WithMessage("the certificate and key are required by TLS server")
+	}
+	if cfg.VerifyPeer && cfg.CAFile == "" {
+		return nil, errors.ErrInvalidArgument.WithMessage("the CA is required to verify the client certificate")
+	}
+	store, err := newCertStore(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &reloadableCredentials{store: store, server: true}, nil
+}
+
+func NewClientCredentials(cfg Config) (credentials.TransportCredentials, error) {
+	if !cfg.Enable {
+		return insecure.NewCredentials(), nil
+	}
+	if (cfg.CertFile == "") != (cfg.KeyFile == "") {
+		return nil, errors.ErrInvalidArgument.WithMessage("the certificate and key must be set together")
+	}
+	store, err := newCertStore(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &reloadableCredentials{store: store, serverName: cfg.ServerName}, nil
+}
+
+// certStore holds the certificate and CA, which are reloaded if the files have changed.
+type certStore struct {
+	cfg       Config
+	mutex     sync.Mutex
+	cert      *tls.Certificate
+	pool      *x509.CertPool
+	modTimes  map[string]time.Time
+	lastCheck time.Time
+}
+
+func newCertStore(cfg Config) (*certStore, error) {
+	s := &certStore{cfg: cfg}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *certStore) files() []string {
+	var files []string
+	for _, f := range []string{s.cfg.CertFile, s.cfg.KeyFile, s.cfg.CAFile} {
+		if f != "" {
+			files = append(files, f)
+		}
+	}
+	return files
+}
+
+func (s *certStore) load() error {
+	modTimes := make(map[string]time.Time)
+	for _, f := range s.files() {
+		info, err := os.Stat(f)
+		if err != nil {
+			return errors.ErrInvalidArgument.WithMessage("stat TLS file failed").Wrap(err)
+		}
+		modTimes[f] = info.ModTime()
+	}
+	var cert *tls.Certificate
+	if s.cfg.CertFile != "" {
+		c, err := tls.LoadX509KeyPair(s.cfg.CertFile, s.cfg.KeyFile)
+		if err != nil {
+			return errors.ErrInvalidArgument.WithMessage("load certificate failed").Wrap(err)
+		}
+		cert = &c
+	}
+	var pool *x509.CertPool
+	if s.cfg.CAFile != "" {
+		data, err := os.ReadFile(s.cfg.CAFile)
+		if err != nil {
+			return errors.ErrInvalidArgument.WithMessage("read CA file failed").Wrap(err)
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return errors.ErrInvalidArgument.WithMessage("no valid certificate in CA file")
+		}
+	}
+	s.cert, s.pool, s.modTimes = cert, pool, modTimes
+	s.lastCheck = time.Now()
+	return nil
+}
+
+// get returns the current certificate and CA, the files are reloaded if any of them has changed
+// since the last check. The old ones are kept if the reloading failed.
+func (s *certStore) get() (*tls.Certificate, *x509.CertPool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if time.Since(s.lastCheck) >= s.cfg.reloadInterval() {
+		s.lastCheck = time.Now()
+		if s.changed() {
+			if err := s.load(); err != nil {
+				log.Warning(context.Background(), "reload TLS files failed", map[string]interface{}{
+					log.KeyError: err,
+				})
+			} else {
+				log.Info(context.Background(), "TLS files have been reloaded", nil)
+			}
+		}
+	}
+	return s.cert, s.pool
+}
+
+func (s *certStore) changed() bool {
+	for _, f := range s.files() {
+		info, err := os.Stat(f)
+		if err != nil {
+			// the file may be being replaced, check it next time.
+			continue
+		}
+		if !info.ModTime().Equal(s.modTimes[f]) {
+			return true
+		}
+	}
+	return false
+}
+
+// verifyIdentity checks the identity of peer certificate, which has been verified by the CA.
+func (s *certStore) verifyIdentity(cs tls.ConnectionState) error {
+	if len(s.cfg.AllowedIdentities) == 0 {
+		return nil
+	}
+	if len(cs.PeerCertificates) == 0 {
+		return fmt.Errorf("the peer has no certificate")
+	}
+	for _, id := range identities(cs.PeerCertificates[0]) {
+		for _, pattern := range s.cfg.AllowedIdentities {
+			if ok, _ := path.Match(pattern, id); ok {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("the identity of peer isn't allowed")
+}
+
+func identities(cert *x509.Certificate) []string {
+	ids := make([]string, 0, 1+len(cert.DNSNames)+len(cert.URIs))
+	if cert.Subject.CommonName != "" {
+		ids = append(ids, cert.Subject.CommonName)
+	}
+	ids = append(ids, cert.DNSNames...)
+	for _, u := range cert.URIs {
+		ids = append(ids, u.String())
+	}
+	return ids
+}
+
+func (s *certStore) serverConfig() *tls.Config {
+	cert, pool := s.get()
+	c := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{*cert},
+	}
+	// The identity of client is only known when its certificate is required.
+	if s.cfg.VerifyPeer {
+		c.ClientAuth = tls.RequireAndVerifyClientCert
+		c.ClientCAs = pool
+		c.VerifyConnection = s.verifyIdentity
+	}
+	return c
+}
+
+func (s *certStore) clientConfig(serverName string) *tls.Config {
+	cert, pool := s.get()
+	c := &tls.Config{
+		MinVersion:       tls.VersionTLS12,
+		ServerName:       serverName,
+		RootCAs:          pool,
+		VerifyConnection: s.verifyIdentity,
+	}
+	if cert != nil {
+		c.Certificates = []tls.Certificate{*cert}
+	}
+	return c
+}
+
+// reloadableCredentials builds the TLS configuration for every handshake, so that the reloaded
+// certificate and CA take effect for the new connections.
+type reloadableCredentials struct {
+	store      *certStore
+	server     bool
+	serverName string
+}
+
+func (c *reloadableCredentials) ClientHandshake(ctx context.Context, authority string,
+	rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return credentials.NewTLS(c.store.clientConfig(c.serverName)).ClientHandshake(ctx, authority, rawConn)
+}
+
+func (c *reloadableCredentials) ServerHandshake(rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return credentials.NewTLS(c.store.serverConfig()).ServerHandshake(rawConn)
+}
+
+func (c *reloadableCredentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{
+		SecurityProtocol: "tls",
+		SecurityVersion:  "1.2",
+		ServerName:       c.serverName,
+	}
+}
+
+func (c *reloadableCredentials) Clone() credentials.TransportCredentials {
+	clone := *c
+	return &clone
+}
+
+func (c *reloadableCredentials) OverrideServerName(serverName string) error {
+	c.serverName = serverName
+	return nil
+}
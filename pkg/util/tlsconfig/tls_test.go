@@ -0,0 +1,258 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlsconfig
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	dir  string
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "vanus-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, _ := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	cert, _ := x509.ParseCertificate(der)
+	ca := &testCA{cert: cert, key: key, dir: t.TempDir()}
+	writePEM(t, ca.path("ca.pem"), "CERTIFICATE", der)
+	return ca
+}
+
+func (ca *testCA) path(name string) string {
+	return filepath.Join(ca.dir, name)
+}
+
+// issue writes the certificate and key of name, and returns the paths of them.
+func (ca *testCA) issue(t *testing.T, name string, serial int64) (string, string) {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, _ := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	keyDER, _ := x509.MarshalECPrivateKey(key)
+	certFile, keyFile := ca.path(name+".pem"), ca.path(name+"-key.pem")
+	writePEM(t, certFile, "CERTIFICATE", der)
+	writePEM(t, keyFile, "EC PRIVATE KEY", keyDER)
+	return certFile, keyFile
+}
+
+func writePEM(t *testing.T, file, typ string, der []byte) {
+	data := pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der})
+	if err := os.WriteFile(file, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func startServer(t *testing.T, creds credentials.TransportCredentials) (string, func()) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer(grpc.Creds(creds))
+	healthpb.RegisterHealthServer(srv, health.NewServer())
+	go func() {
+		_ = srv.Serve(listener)
+	}()
+	return listener.Addr().String(), srv.Stop
+}
+
+func check(addr string, creds credentials.TransportCredentials) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	return err
+}
+
+func TestCredentials(t *testing.T) {
+	Convey("test TLS credentials", t, func() {
+		ca := newTestCA(t)
+		serverCert, serverKey := ca.issue(t, "controller", 2)
+		clientCert, clientKey := ca.issue(t, "store", 3)
+		serverCreds, err := NewServerCredentials(Config{
+			Enable:            true,
+			CertFile:          serverCert,
+			KeyFile:           serverKey,
+			CAFile:            ca.path("ca.pem"),
+			VerifyPeer:        true,
+			AllowedIdentities: []string{"store", "trigger"},
+		})
+		So(err, ShouldBeNil)
+		addr, stop := startServer(t, serverCreds)
+		defer stop()
+
+		Convey("test mutual TLS", func() {
+			creds, err := NewClientCredentials(Config{
+				Enable:            true,
+				CertFile:          clientCert,
+				KeyFile:           clientKey,
+				CAFile:            ca.path("ca.pem"),
+				AllowedIdentities: []string{"controller"},
+			})
+			So(err, ShouldBeNil)
+			So(check(addr, creds), ShouldBeNil)
+		})
+
+		Convey("test client without certificate", func() {
+			creds, err := NewClientCredentials(Config{Enable: true, CAFile: ca.path("ca.pem")})
+			So(err, ShouldBeNil)
+			So(check(addr, creds), ShouldNotBeNil)
+		})
+
+		Convey("test identity isn't allowed", func() {
+			otherCert, otherKey := ca.issue(t, "gateway", 4)
+			creds, err := NewClientCredentials(Config{
+				Enable:   true,
+				CertFile: otherCert,
+				KeyFile:  otherKey,
+				CAFile:   ca.path("ca.pem"),
+			})
+			So(err, ShouldBeNil)
+			So(check(addr, creds), ShouldNotBeNil)
+
+			// the server identity is checked by client as well.
+			creds, err = NewClientCredentials(Config{
+				Enable:            true,
+				CertFile:          clientCert,
+				KeyFile:           clientKey,
+				CAFile:            ca.path("ca.pem"),
+				AllowedIdentities: []string{"store"},
+			})
+			So(err, ShouldBeNil)
+			So(check(addr, creds), ShouldNotBeNil)
+		})
+
+		Convey("test untrusted server", func() {
+			other := newTestCA(t)
+			creds, err := NewClientCredentials(Config{
+				Enable:   true,
+				CertFile: clientCert,
+				KeyFile:  clientKey,
+				CAFile:   other.path("ca.pem"),
+			})
+			So(err, ShouldBeNil)
+			So(check(addr, creds), ShouldNotBeNil)
+		})
+	})
+}
+
+func TestCredentials_WithoutVerifyPeer(t *testing.T) {
+	Convey("test allowed identities without verifying peer", t, func() {
+		ca := newTestCA(t)
+		serverCert, serverKey := ca.issue(t, "controller", 2)
+		serverCreds, err := NewServerCredentials(Config{
+			Enable:            true,
+			CertFile:          serverCert,
+			KeyFile:           serverKey,
+			AllowedIdentities: []string{"store"},
+		})
+		So(err, ShouldBeNil)
+		addr, stop := startServer(t, serverCreds)
+		defer stop()
+
+		// the client sends no certificate, the allowed identities of server don't apply.
+		creds, err := NewClientCredentials(Config{Enable: true, CAFile: ca.path("ca.pem")})
+		So(err, ShouldBeNil)
+		So(check(addr, creds), ShouldBeNil)
+	})
+}
+
+func TestCertStore_Reload(t *testing.T) {
+	Convey("test reload certificate", t, func() {
+		ca := newTestCA(t)
+		certFile, keyFile := ca.issue(t, "store", 2)
+		s, err := newCertStore(Config{
+			Enable:         true,
+			CertFile:       certFile,
+			KeyFile:        keyFile,
+			ReloadInterval: time.Millisecond,
+		})
+		So(err, ShouldBeNil)
+		cert, _ := s.get()
+		old := cert.Certificate[0]
+
+		// the files are replaced by a new certificate.
+		_, _ = ca.issue(t, "store", 3)
+		modTime := time.Now().Add(time.Minute)
+		So(os.Chtimes(certFile, modTime, modTime), ShouldBeNil)
+		So(os.Chtimes(keyFile, modTime, modTime), ShouldBeNil)
+		time.Sleep(2 * time.Millisecond)
+		cert, _ = s.get()
+		So(cert.Certificate[0], ShouldNotResemble, old)
+
+		// the old certificate is kept if the new one is invalid.
+		current := cert.Certificate[0]
+		So(os.WriteFile(certFile, []byte("invalid"), 0o600), ShouldBeNil)
+		modTime = modTime.Add(time.Minute)
+		So(os.Chtimes(certFile, modTime, modTime), ShouldBeNil)
+		time.Sleep(2 * time.Millisecond)
+		cert, _ = s.get()
+		So(cert.Certificate[0], ShouldResemble, current)
+	})
+}
+
+func TestNewCredentials_Disabled(t *testing.T) {
+	Convey("test disabled TLS", t, func() {
+		creds, err := NewServerCredentials(Config{})
+		So(err, ShouldBeNil)
+		So(creds.Info().SecurityProtocol, ShouldEqual, "insecure")
+		_, err = NewServerCredentials(Config{Enable: true})
+		So(err, ShouldNotBeNil)
+		_, err = NewClientCredentials(Config{Enable: true, CertFile: "cert.pem"})
+		So(err, ShouldNotBeNil)
+	})
+}
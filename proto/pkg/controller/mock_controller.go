@@ -121,6 +121,46 @@ func (m *MockEventBusControllerClient) EXPECT() *MockEventBusControllerClientMoc
 	return m.recorder
 }
 
+// CommitConsumerGroupOffset mocks base method.
+func (m *MockEventBusControllerClient) CommitConsumerGroupOffset(ctx context.Context, in *CommitConsumerGroupOffsetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CommitConsumerGroupOffset", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitConsumerGroupOffset indicates an expected call of CommitConsumerGroupOffset.
+func (mr *MockEventBusControllerClientMockRecorder) CommitConsumerGroupOffset(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitConsumerGroupOffset", reflect.TypeOf((*MockEventBusControllerClient)(nil).CommitConsumerGroupOffset), varargs...)
+}
+
+// ConsumerGroupHeartbeat mocks base method.
+func (m *MockEventBusControllerClient) ConsumerGroupHeartbeat(ctx context.Context, in *ConsumerGroupHeartbeatRequest, opts ...grpc.CallOption) (*ConsumerGroupAssignment, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConsumerGroupHeartbeat", varargs...)
+	ret0, _ := ret[0].(*ConsumerGroupAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumerGroupHeartbeat indicates an expected call of ConsumerGroupHeartbeat.
+func (mr *MockEventBusControllerClientMockRecorder) ConsumerGroupHeartbeat(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumerGroupHeartbeat", reflect.TypeOf((*MockEventBusControllerClient)(nil).ConsumerGroupHeartbeat), varargs...)
+}
+
 // CreateEventBus mocks base method.
 func (m *MockEventBusControllerClient) CreateEventBus(ctx context.Context, in *CreateEventBusRequest, opts ...grpc.CallOption) (*meta.EventBus, error) {
 	m.ctrl.T.Helper()
@@ -141,6 +181,26 @@ func (mr *MockEventBusControllerClientMockRecorder) CreateEventBus(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventBus", reflect.TypeOf((*MockEventBusControllerClient)(nil).CreateEventBus), varargs...)
 }
 
+// CreateScheduledEvent mocks base method.
+func (m *MockEventBusControllerClient) CreateScheduledEvent(ctx context.Context, in *meta.ScheduledEvent, opts ...grpc.CallOption) (*meta.ScheduledEvent, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateScheduledEvent", varargs...)
+	ret0, _ := ret[0].(*meta.ScheduledEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateScheduledEvent indicates an expected call of CreateScheduledEvent.
+func (mr *MockEventBusControllerClientMockRecorder) CreateScheduledEvent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScheduledEvent", reflect.TypeOf((*MockEventBusControllerClient)(nil).CreateScheduledEvent), varargs...)
+}
+
 // CreateSystemEventBus mocks base method.
 func (m *MockEventBusControllerClient) CreateSystemEventBus(ctx context.Context, in *CreateEventBusRequest, opts ...grpc.CallOption) (*meta.EventBus, error) {
 	m.ctrl.T.Helper()
@@ -181,6 +241,46 @@ func (mr *MockEventBusControllerClientMockRecorder) DeleteEventBus(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventBus", reflect.TypeOf((*MockEventBusControllerClient)(nil).DeleteEventBus), varargs...)
 }
 
+// DeleteScheduledEvent mocks base method.
+func (m *MockEventBusControllerClient) DeleteScheduledEvent(ctx context.Context, in *DeleteScheduledEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteScheduledEvent", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteScheduledEvent indicates an expected call of DeleteScheduledEvent.
+func (mr *MockEventBusControllerClientMockRecorder) DeleteScheduledEvent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScheduledEvent", reflect.TypeOf((*MockEventBusControllerClient)(nil).DeleteScheduledEvent), varargs...)
+}
+
+// DeleteSchema mocks base method.
+func (m *MockEventBusControllerClient) DeleteSchema(ctx context.Context, in *DeleteSchemaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteSchema", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSchema indicates an expected call of DeleteSchema.
+func (mr *MockEventBusControllerClientMockRecorder) DeleteSchema(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSchema", reflect.TypeOf((*MockEventBusControllerClient)(nil).DeleteSchema), varargs...)
+}
+
 // GetEventBus mocks base method.
 func (m *MockEventBusControllerClient) GetEventBus(ctx context.Context, in *meta.EventBus, opts ...grpc.CallOption) (*meta.EventBus, error) {
 	m.ctrl.T.Helper()
@@ -201,6 +301,86 @@ func (mr *MockEventBusControllerClientMockRecorder) GetEventBus(ctx, in interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventBus", reflect.TypeOf((*MockEventBusControllerClient)(nil).GetEventBus), varargs...)
 }
 
+// GetScheduledEvent mocks base method.
+func (m *MockEventBusControllerClient) GetScheduledEvent(ctx context.Context, in *GetScheduledEventRequest, opts ...grpc.CallOption) (*meta.ScheduledEvent, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetScheduledEvent", varargs...)
+	ret0, _ := ret[0].(*meta.ScheduledEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduledEvent indicates an expected call of GetScheduledEvent.
+func (mr *MockEventBusControllerClientMockRecorder) GetScheduledEvent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledEvent", reflect.TypeOf((*MockEventBusControllerClient)(nil).GetScheduledEvent), varargs...)
+}
+
+// GetSchema mocks base method.
+func (m *MockEventBusControllerClient) GetSchema(ctx context.Context, in *GetSchemaRequest, opts ...grpc.CallOption) (*meta.Schema, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSchema", varargs...)
+	ret0, _ := ret[0].(*meta.Schema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchema indicates an expected call of GetSchema.
+func (mr *MockEventBusControllerClientMockRecorder) GetSchema(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchema", reflect.TypeOf((*MockEventBusControllerClient)(nil).GetSchema), varargs...)
+}
+
+// JoinConsumerGroup mocks base method.
+func (m *MockEventBusControllerClient) JoinConsumerGroup(ctx context.Context, in *JoinConsumerGroupRequest, opts ...grpc.CallOption) (*ConsumerGroupAssignment, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "JoinConsumerGroup", varargs...)
+	ret0, _ := ret[0].(*ConsumerGroupAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinConsumerGroup indicates an expected call of JoinConsumerGroup.
+func (mr *MockEventBusControllerClientMockRecorder) JoinConsumerGroup(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinConsumerGroup", reflect.TypeOf((*MockEventBusControllerClient)(nil).JoinConsumerGroup), varargs...)
+}
+
+// LeaveConsumerGroup mocks base method.
+func (m *MockEventBusControllerClient) LeaveConsumerGroup(ctx context.Context, in *LeaveConsumerGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LeaveConsumerGroup", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LeaveConsumerGroup indicates an expected call of LeaveConsumerGroup.
+func (mr *MockEventBusControllerClientMockRecorder) LeaveConsumerGroup(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveConsumerGroup", reflect.TypeOf((*MockEventBusControllerClient)(nil).LeaveConsumerGroup), varargs...)
+}
+
 // ListEventBus mocks base method.
 func (m *MockEventBusControllerClient) ListEventBus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListEventbusResponse, error) {
 	m.ctrl.T.Helper()
@@ -221,6 +401,66 @@ func (mr *MockEventBusControllerClientMockRecorder) ListEventBus(ctx, in interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventBus", reflect.TypeOf((*MockEventBusControllerClient)(nil).ListEventBus), varargs...)
 }
 
+// ListScheduledEvent mocks base method.
+func (m *MockEventBusControllerClient) ListScheduledEvent(ctx context.Context, in *ListScheduledEventRequest, opts ...grpc.CallOption) (*ListScheduledEventResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListScheduledEvent", varargs...)
+	ret0, _ := ret[0].(*ListScheduledEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScheduledEvent indicates an expected call of ListScheduledEvent.
+func (mr *MockEventBusControllerClientMockRecorder) ListScheduledEvent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScheduledEvent", reflect.TypeOf((*MockEventBusControllerClient)(nil).ListScheduledEvent), varargs...)
+}
+
+// ListSchema mocks base method.
+func (m *MockEventBusControllerClient) ListSchema(ctx context.Context, in *ListSchemaRequest, opts ...grpc.CallOption) (*ListSchemaResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSchema", varargs...)
+	ret0, _ := ret[0].(*ListSchemaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSchema indicates an expected call of ListSchema.
+func (mr *MockEventBusControllerClientMockRecorder) ListSchema(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchema", reflect.TypeOf((*MockEventBusControllerClient)(nil).ListSchema), varargs...)
+}
+
+// RegisterSchema mocks base method.
+func (m *MockEventBusControllerClient) RegisterSchema(ctx context.Context, in *RegisterSchemaRequest, opts ...grpc.CallOption) (*meta.Schema, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RegisterSchema", varargs...)
+	ret0, _ := ret[0].(*meta.Schema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterSchema indicates an expected call of RegisterSchema.
+func (mr *MockEventBusControllerClientMockRecorder) RegisterSchema(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSchema", reflect.TypeOf((*MockEventBusControllerClient)(nil).RegisterSchema), varargs...)
+}
+
 // UpdateEventBus mocks base method.
 func (m *MockEventBusControllerClient) UpdateEventBus(ctx context.Context, in *UpdateEventBusRequest, opts ...grpc.CallOption) (*meta.EventBus, error) {
 	m.ctrl.T.Helper()
@@ -264,6 +504,36 @@ func (m *MockEventBusControllerServer) EXPECT() *MockEventBusControllerServerMoc
 	return m.recorder
 }
 
+// CommitConsumerGroupOffset mocks base method.
+func (m *MockEventBusControllerServer) CommitConsumerGroupOffset(arg0 context.Context, arg1 *CommitConsumerGroupOffsetRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitConsumerGroupOffset", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitConsumerGroupOffset indicates an expected call of CommitConsumerGroupOffset.
+func (mr *MockEventBusControllerServerMockRecorder) CommitConsumerGroupOffset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitConsumerGroupOffset", reflect.TypeOf((*MockEventBusControllerServer)(nil).CommitConsumerGroupOffset), arg0, arg1)
+}
+
+// ConsumerGroupHeartbeat mocks base method.
+func (m *MockEventBusControllerServer) ConsumerGroupHeartbeat(arg0 context.Context, arg1 *ConsumerGroupHeartbeatRequest) (*ConsumerGroupAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumerGroupHeartbeat", arg0, arg1)
+	ret0, _ := ret[0].(*ConsumerGroupAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumerGroupHeartbeat indicates an expected call of ConsumerGroupHeartbeat.
+func (mr *MockEventBusControllerServerMockRecorder) ConsumerGroupHeartbeat(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumerGroupHeartbeat", reflect.TypeOf((*MockEventBusControllerServer)(nil).ConsumerGroupHeartbeat), arg0, arg1)
+}
+
 // CreateEventBus mocks base method.
 func (m *MockEventBusControllerServer) CreateEventBus(arg0 context.Context, arg1 *CreateEventBusRequest) (*meta.EventBus, error) {
 	m.ctrl.T.Helper()
@@ -279,6 +549,21 @@ func (mr *MockEventBusControllerServerMockRecorder) CreateEventBus(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventBus", reflect.TypeOf((*MockEventBusControllerServer)(nil).CreateEventBus), arg0, arg1)
 }
 
+// CreateScheduledEvent mocks base method.
+func (m *MockEventBusControllerServer) CreateScheduledEvent(arg0 context.Context, arg1 *meta.ScheduledEvent) (*meta.ScheduledEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScheduledEvent", arg0, arg1)
+	ret0, _ := ret[0].(*meta.ScheduledEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateScheduledEvent indicates an expected call of CreateScheduledEvent.
+func (mr *MockEventBusControllerServerMockRecorder) CreateScheduledEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScheduledEvent", reflect.TypeOf((*MockEventBusControllerServer)(nil).CreateScheduledEvent), arg0, arg1)
+}
+
 // CreateSystemEventBus mocks base method.
 func (m *MockEventBusControllerServer) CreateSystemEventBus(arg0 context.Context, arg1 *CreateEventBusRequest) (*meta.EventBus, error) {
 	m.ctrl.T.Helper()
@@ -309,6 +594,36 @@ func (mr *MockEventBusControllerServerMockRecorder) DeleteEventBus(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventBus", reflect.TypeOf((*MockEventBusControllerServer)(nil).DeleteEventBus), arg0, arg1)
 }
 
+// DeleteScheduledEvent mocks base method.
+func (m *MockEventBusControllerServer) DeleteScheduledEvent(arg0 context.Context, arg1 *DeleteScheduledEventRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteScheduledEvent", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteScheduledEvent indicates an expected call of DeleteScheduledEvent.
+func (mr *MockEventBusControllerServerMockRecorder) DeleteScheduledEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScheduledEvent", reflect.TypeOf((*MockEventBusControllerServer)(nil).DeleteScheduledEvent), arg0, arg1)
+}
+
+// DeleteSchema mocks base method.
+func (m *MockEventBusControllerServer) DeleteSchema(arg0 context.Context, arg1 *DeleteSchemaRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSchema", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSchema indicates an expected call of DeleteSchema.
+func (mr *MockEventBusControllerServerMockRecorder) DeleteSchema(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSchema", reflect.TypeOf((*MockEventBusControllerServer)(nil).DeleteSchema), arg0, arg1)
+}
+
 // GetEventBus mocks base method.
 func (m *MockEventBusControllerServer) GetEventBus(arg0 context.Context, arg1 *meta.EventBus) (*meta.EventBus, error) {
 	m.ctrl.T.Helper()
@@ -324,6 +639,66 @@ func (mr *MockEventBusControllerServerMockRecorder) GetEventBus(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventBus", reflect.TypeOf((*MockEventBusControllerServer)(nil).GetEventBus), arg0, arg1)
 }
 
+// GetScheduledEvent mocks base method.
+func (m *MockEventBusControllerServer) GetScheduledEvent(arg0 context.Context, arg1 *GetScheduledEventRequest) (*meta.ScheduledEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledEvent", arg0, arg1)
+	ret0, _ := ret[0].(*meta.ScheduledEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduledEvent indicates an expected call of GetScheduledEvent.
+func (mr *MockEventBusControllerServerMockRecorder) GetScheduledEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledEvent", reflect.TypeOf((*MockEventBusControllerServer)(nil).GetScheduledEvent), arg0, arg1)
+}
+
+// GetSchema mocks base method.
+func (m *MockEventBusControllerServer) GetSchema(arg0 context.Context, arg1 *GetSchemaRequest) (*meta.Schema, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchema", arg0, arg1)
+	ret0, _ := ret[0].(*meta.Schema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchema indicates an expected call of GetSchema.
+func (mr *MockEventBusControllerServerMockRecorder) GetSchema(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchema", reflect.TypeOf((*MockEventBusControllerServer)(nil).GetSchema), arg0, arg1)
+}
+
+// JoinConsumerGroup mocks base method.
+func (m *MockEventBusControllerServer) JoinConsumerGroup(arg0 context.Context, arg1 *JoinConsumerGroupRequest) (*ConsumerGroupAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinConsumerGroup", arg0, arg1)
+	ret0, _ := ret[0].(*ConsumerGroupAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinConsumerGroup indicates an expected call of JoinConsumerGroup.
+func (mr *MockEventBusControllerServerMockRecorder) JoinConsumerGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinConsumerGroup", reflect.TypeOf((*MockEventBusControllerServer)(nil).JoinConsumerGroup), arg0, arg1)
+}
+
+// LeaveConsumerGroup mocks base method.
+func (m *MockEventBusControllerServer) LeaveConsumerGroup(arg0 context.Context, arg1 *LeaveConsumerGroupRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveConsumerGroup", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LeaveConsumerGroup indicates an expected call of LeaveConsumerGroup.
+func (mr *MockEventBusControllerServerMockRecorder) LeaveConsumerGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveConsumerGroup", reflect.TypeOf((*MockEventBusControllerServer)(nil).LeaveConsumerGroup), arg0, arg1)
+}
+
 // ListEventBus mocks base method.
 func (m *MockEventBusControllerServer) ListEventBus(arg0 context.Context, arg1 *emptypb.Empty) (*ListEventbusResponse, error) {
 	m.ctrl.T.Helper()
@@ -339,6 +714,51 @@ func (mr *MockEventBusControllerServerMockRecorder) ListEventBus(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventBus", reflect.TypeOf((*MockEventBusControllerServer)(nil).ListEventBus), arg0, arg1)
 }
 
+// ListScheduledEvent mocks base method.
+func (m *MockEventBusControllerServer) ListScheduledEvent(arg0 context.Context, arg1 *ListScheduledEventRequest) (*ListScheduledEventResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScheduledEvent", arg0, arg1)
+	ret0, _ := ret[0].(*ListScheduledEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScheduledEvent indicates an expected call of ListScheduledEvent.
+func (mr *MockEventBusControllerServerMockRecorder) ListScheduledEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScheduledEvent", reflect.TypeOf((*MockEventBusControllerServer)(nil).ListScheduledEvent), arg0, arg1)
+}
+
+// ListSchema mocks base method.
+func (m *MockEventBusControllerServer) ListSchema(arg0 context.Context, arg1 *ListSchemaRequest) (*ListSchemaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSchema", arg0, arg1)
+	ret0, _ := ret[0].(*ListSchemaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSchema indicates an expected call of ListSchema.
+func (mr *MockEventBusControllerServerMockRecorder) ListSchema(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchema", reflect.TypeOf((*MockEventBusControllerServer)(nil).ListSchema), arg0, arg1)
+}
+
+// RegisterSchema mocks base method.
+func (m *MockEventBusControllerServer) RegisterSchema(arg0 context.Context, arg1 *RegisterSchemaRequest) (*meta.Schema, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterSchema", arg0, arg1)
+	ret0, _ := ret[0].(*meta.Schema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterSchema indicates an expected call of RegisterSchema.
+func (mr *MockEventBusControllerServerMockRecorder) RegisterSchema(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSchema", reflect.TypeOf((*MockEventBusControllerServer)(nil).RegisterSchema), arg0, arg1)
+}
+
 // UpdateEventBus mocks base method.
 func (m *MockEventBusControllerServer) UpdateEventBus(arg0 context.Context, arg1 *UpdateEventBusRequest) (*meta.EventBus, error) {
 	m.ctrl.T.Helper()
@@ -493,6 +913,46 @@ func (m *MockSegmentControllerClient) EXPECT() *MockSegmentControllerClientMockR
 	return m.recorder
 }
 
+// DrainVolume mocks base method.
+func (m *MockSegmentControllerClient) DrainVolume(ctx context.Context, in *DrainVolumeRequest, opts ...grpc.CallOption) (*VolumeDrainStatus, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DrainVolume", varargs...)
+	ret0, _ := ret[0].(*VolumeDrainStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DrainVolume indicates an expected call of DrainVolume.
+func (mr *MockSegmentControllerClientMockRecorder) DrainVolume(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DrainVolume", reflect.TypeOf((*MockSegmentControllerClient)(nil).DrainVolume), varargs...)
+}
+
+// GetVolumeDrainStatus mocks base method.
+func (m *MockSegmentControllerClient) GetVolumeDrainStatus(ctx context.Context, in *GetVolumeDrainStatusRequest, opts ...grpc.CallOption) (*VolumeDrainStatus, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVolumeDrainStatus", varargs...)
+	ret0, _ := ret[0].(*VolumeDrainStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeDrainStatus indicates an expected call of GetVolumeDrainStatus.
+func (mr *MockSegmentControllerClientMockRecorder) GetVolumeDrainStatus(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeDrainStatus", reflect.TypeOf((*MockSegmentControllerClient)(nil).GetVolumeDrainStatus), varargs...)
+}
+
 // QuerySegmentRouteInfo mocks base method.
 func (m *MockSegmentControllerClient) QuerySegmentRouteInfo(ctx context.Context, in *QuerySegmentRouteInfoRequest, opts ...grpc.CallOption) (*QuerySegmentRouteInfoResponse, error) {
 	m.ctrl.T.Helper()
@@ -773,6 +1233,36 @@ func (m *MockSegmentControllerServer) EXPECT() *MockSegmentControllerServerMockR
 	return m.recorder
 }
 
+// DrainVolume mocks base method.
+func (m *MockSegmentControllerServer) DrainVolume(arg0 context.Context, arg1 *DrainVolumeRequest) (*VolumeDrainStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DrainVolume", arg0, arg1)
+	ret0, _ := ret[0].(*VolumeDrainStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DrainVolume indicates an expected call of DrainVolume.
+func (mr *MockSegmentControllerServerMockRecorder) DrainVolume(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DrainVolume", reflect.TypeOf((*MockSegmentControllerServer)(nil).DrainVolume), arg0, arg1)
+}
+
+// GetVolumeDrainStatus mocks base method.
+func (m *MockSegmentControllerServer) GetVolumeDrainStatus(arg0 context.Context, arg1 *GetVolumeDrainStatusRequest) (*VolumeDrainStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeDrainStatus", arg0, arg1)
+	ret0, _ := ret[0].(*VolumeDrainStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeDrainStatus indicates an expected call of GetVolumeDrainStatus.
+func (mr *MockSegmentControllerServerMockRecorder) GetVolumeDrainStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeDrainStatus", reflect.TypeOf((*MockSegmentControllerServer)(nil).GetVolumeDrainStatus), arg0, arg1)
+}
+
 // QuerySegmentRouteInfo mocks base method.
 func (m *MockSegmentControllerServer) QuerySegmentRouteInfo(arg0 context.Context, arg1 *QuerySegmentRouteInfoRequest) (*QuerySegmentRouteInfoResponse, error) {
 	m.ctrl.T.Helper()
@@ -1039,6 +1529,26 @@ func (mr *MockTriggerControllerClientMockRecorder) CommitOffset(ctx, in interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitOffset", reflect.TypeOf((*MockTriggerControllerClient)(nil).CommitOffset), varargs...)
 }
 
+// CreateDeadLetterOperation mocks base method.
+func (m *MockTriggerControllerClient) CreateDeadLetterOperation(ctx context.Context, in *meta.DeadLetterOperation, opts ...grpc.CallOption) (*meta.DeadLetterOperation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateDeadLetterOperation", varargs...)
+	ret0, _ := ret[0].(*meta.DeadLetterOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDeadLetterOperation indicates an expected call of CreateDeadLetterOperation.
+func (mr *MockTriggerControllerClientMockRecorder) CreateDeadLetterOperation(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeadLetterOperation", reflect.TypeOf((*MockTriggerControllerClient)(nil).CreateDeadLetterOperation), varargs...)
+}
+
 // CreateSubscription mocks base method.
 func (m *MockTriggerControllerClient) CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error) {
 	m.ctrl.T.Helper()
@@ -1099,6 +1609,26 @@ func (mr *MockTriggerControllerClientMockRecorder) GetSubscription(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscription", reflect.TypeOf((*MockTriggerControllerClient)(nil).GetSubscription), varargs...)
 }
 
+// ListDeadLetterOperation mocks base method.
+func (m *MockTriggerControllerClient) ListDeadLetterOperation(ctx context.Context, in *ListDeadLetterOperationRequest, opts ...grpc.CallOption) (*ListDeadLetterOperationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDeadLetterOperation", varargs...)
+	ret0, _ := ret[0].(*ListDeadLetterOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeadLetterOperation indicates an expected call of ListDeadLetterOperation.
+func (mr *MockTriggerControllerClientMockRecorder) ListDeadLetterOperation(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetterOperation", reflect.TypeOf((*MockTriggerControllerClient)(nil).ListDeadLetterOperation), varargs...)
+}
+
 // ListSubscription mocks base method.
 func (m *MockTriggerControllerClient) ListSubscription(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSubscriptionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1394,6 +1924,21 @@ func (mr *MockTriggerControllerServerMockRecorder) CommitOffset(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitOffset", reflect.TypeOf((*MockTriggerControllerServer)(nil).CommitOffset), arg0, arg1)
 }
 
+// CreateDeadLetterOperation mocks base method.
+func (m *MockTriggerControllerServer) CreateDeadLetterOperation(arg0 context.Context, arg1 *meta.DeadLetterOperation) (*meta.DeadLetterOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeadLetterOperation", arg0, arg1)
+	ret0, _ := ret[0].(*meta.DeadLetterOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDeadLetterOperation indicates an expected call of CreateDeadLetterOperation.
+func (mr *MockTriggerControllerServerMockRecorder) CreateDeadLetterOperation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeadLetterOperation", reflect.TypeOf((*MockTriggerControllerServer)(nil).CreateDeadLetterOperation), arg0, arg1)
+}
+
 // CreateSubscription mocks base method.
 func (m *MockTriggerControllerServer) CreateSubscription(arg0 context.Context, arg1 *CreateSubscriptionRequest) (*meta.Subscription, error) {
 	m.ctrl.T.Helper()
@@ -1439,6 +1984,21 @@ func (mr *MockTriggerControllerServerMockRecorder) GetSubscription(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscription", reflect.TypeOf((*MockTriggerControllerServer)(nil).GetSubscription), arg0, arg1)
 }
 
+// ListDeadLetterOperation mocks base method.
+func (m *MockTriggerControllerServer) ListDeadLetterOperation(arg0 context.Context, arg1 *ListDeadLetterOperationRequest) (*ListDeadLetterOperationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeadLetterOperation", arg0, arg1)
+	ret0, _ := ret[0].(*ListDeadLetterOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeadLetterOperation indicates an expected call of ListDeadLetterOperation.
+func (mr *MockTriggerControllerServerMockRecorder) ListDeadLetterOperation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetterOperation", reflect.TypeOf((*MockTriggerControllerServer)(nil).ListDeadLetterOperation), arg0, arg1)
+}
+
 // ListSubscription mocks base method.
 func (m *MockTriggerControllerServer) ListSubscription(arg0 context.Context, arg1 *emptypb.Empty) (*ListSubscriptionResponse, error) {
 	m.ctrl.T.Helper()
@@ -76,6 +76,26 @@ func (mr *MockSegmentServerClientMockRecorder) AppendToBlock(ctx, in interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendToBlock", reflect.TypeOf((*MockSegmentServerClient)(nil).AppendToBlock), varargs...)
 }
 
+// ChangeMembership mocks base method.
+func (m *MockSegmentServerClient) ChangeMembership(ctx context.Context, in *ChangeMembershipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ChangeMembership", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeMembership indicates an expected call of ChangeMembership.
+func (mr *MockSegmentServerClientMockRecorder) ChangeMembership(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeMembership", reflect.TypeOf((*MockSegmentServerClient)(nil).ChangeMembership), varargs...)
+}
+
 // CreateBlock mocks base method.
 func (m *MockSegmentServerClient) CreateBlock(ctx context.Context, in *CreateBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -196,6 +216,46 @@ func (mr *MockSegmentServerClientMockRecorder) RemoveBlock(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBlock", reflect.TypeOf((*MockSegmentServerClient)(nil).RemoveBlock), varargs...)
 }
 
+// SetBlockCompression mocks base method.
+func (m *MockSegmentServerClient) SetBlockCompression(ctx context.Context, in *SetBlockCompressionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetBlockCompression", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetBlockCompression indicates an expected call of SetBlockCompression.
+func (mr *MockSegmentServerClientMockRecorder) SetBlockCompression(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBlockCompression", reflect.TypeOf((*MockSegmentServerClient)(nil).SetBlockCompression), varargs...)
+}
+
+// SetBlockSegment mocks base method.
+func (m *MockSegmentServerClient) SetBlockSegment(ctx context.Context, in *SetBlockSegmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetBlockSegment", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetBlockSegment indicates an expected call of SetBlockSegment.
+func (mr *MockSegmentServerClientMockRecorder) SetBlockSegment(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBlockSegment", reflect.TypeOf((*MockSegmentServerClient)(nil).SetBlockSegment), varargs...)
+}
+
 // Start mocks base method.
 func (m *MockSegmentServerClient) Start(ctx context.Context, in *StartSegmentServerRequest, opts ...grpc.CallOption) (*StartSegmentServerResponse, error) {
 	m.ctrl.T.Helper()
@@ -309,6 +369,21 @@ func (mr *MockSegmentServerServerMockRecorder) AppendToBlock(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendToBlock", reflect.TypeOf((*MockSegmentServerServer)(nil).AppendToBlock), arg0, arg1)
 }
 
+// ChangeMembership mocks base method.
+func (m *MockSegmentServerServer) ChangeMembership(arg0 context.Context, arg1 *ChangeMembershipRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeMembership", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeMembership indicates an expected call of ChangeMembership.
+func (mr *MockSegmentServerServerMockRecorder) ChangeMembership(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeMembership", reflect.TypeOf((*MockSegmentServerServer)(nil).ChangeMembership), arg0, arg1)
+}
+
 // CreateBlock mocks base method.
 func (m *MockSegmentServerServer) CreateBlock(arg0 context.Context, arg1 *CreateBlockRequest) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -399,6 +474,36 @@ func (mr *MockSegmentServerServerMockRecorder) RemoveBlock(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBlock", reflect.TypeOf((*MockSegmentServerServer)(nil).RemoveBlock), arg0, arg1)
 }
 
+// SetBlockCompression mocks base method.
+func (m *MockSegmentServerServer) SetBlockCompression(arg0 context.Context, arg1 *SetBlockCompressionRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBlockCompression", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetBlockCompression indicates an expected call of SetBlockCompression.
+func (mr *MockSegmentServerServerMockRecorder) SetBlockCompression(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBlockCompression", reflect.TypeOf((*MockSegmentServerServer)(nil).SetBlockCompression), arg0, arg1)
+}
+
+// SetBlockSegment mocks base method.
+func (m *MockSegmentServerServer) SetBlockSegment(arg0 context.Context, arg1 *SetBlockSegmentRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBlockSegment", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetBlockSegment indicates an expected call of SetBlockSegment.
+func (mr *MockSegmentServerServerMockRecorder) SetBlockSegment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBlockSegment", reflect.TypeOf((*MockSegmentServerServer)(nil).SetBlockSegment), arg0, arg1)
+}
+
 // Start mocks base method.
 func (m *MockSegmentServerServer) Start(arg0 context.Context, arg1 *StartSegmentServerRequest) (*StartSegmentServerResponse, error) {
 	m.ctrl.T.Helper()
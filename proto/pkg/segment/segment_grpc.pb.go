@@ -28,8 +28,11 @@ type SegmentServerClient interface {
 	CreateBlock(ctx context.Context, in *CreateBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RemoveBlock(ctx context.Context, in *RemoveBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetBlockInfo(ctx context.Context, in *GetBlockInfoRequest, opts ...grpc.CallOption) (*GetBlockInfoResponse, error)
+	SetBlockCompression(ctx context.Context, in *SetBlockCompressionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetBlockSegment(ctx context.Context, in *SetBlockSegmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ActivateSegment(ctx context.Context, in *ActivateSegmentRequest, opts ...grpc.CallOption) (*ActivateSegmentResponse, error)
 	InactivateSegment(ctx context.Context, in *InactivateSegmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ChangeMembership(ctx context.Context, in *ChangeMembershipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	AppendToBlock(ctx context.Context, in *AppendToBlockRequest, opts ...grpc.CallOption) (*AppendToBlockResponse, error)
 	ReadFromBlock(ctx context.Context, in *ReadFromBlockRequest, opts ...grpc.CallOption) (*ReadFromBlockResponse, error)
 	LookupOffsetInBlock(ctx context.Context, in *LookupOffsetInBlockRequest, opts ...grpc.CallOption) (*LookupOffsetInBlockResponse, error)
@@ -89,6 +92,24 @@ func (c *segmentServerClient) GetBlockInfo(ctx context.Context, in *GetBlockInfo
 	return out, nil
 }
 
+func (c *segmentServerClient) SetBlockCompression(ctx context.Context, in *SetBlockCompressionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.segment.SegmentServer/SetBlockCompression", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *segmentServerClient) SetBlockSegment(ctx context.Context, in *SetBlockSegmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.segment.SegmentServer/SetBlockSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *segmentServerClient) ActivateSegment(ctx context.Context, in *ActivateSegmentRequest, opts ...grpc.CallOption) (*ActivateSegmentResponse, error) {
 	out := new(ActivateSegmentResponse)
 	err := c.cc.Invoke(ctx, "/linkall.vanus.segment.SegmentServer/ActivateSegment", in, out, opts...)
@@ -107,6 +128,15 @@ func (c *segmentServerClient) InactivateSegment(ctx context.Context, in *Inactiv
 	return out, nil
 }
 
+func (c *segmentServerClient) ChangeMembership(ctx context.Context, in *ChangeMembershipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.segment.SegmentServer/ChangeMembership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *segmentServerClient) AppendToBlock(ctx context.Context, in *AppendToBlockRequest, opts ...grpc.CallOption) (*AppendToBlockResponse, error) {
 	out := new(AppendToBlockResponse)
 	err := c.cc.Invoke(ctx, "/linkall.vanus.segment.SegmentServer/AppendToBlock", in, out, opts...)
@@ -152,8 +182,11 @@ type SegmentServerServer interface {
 	CreateBlock(context.Context, *CreateBlockRequest) (*emptypb.Empty, error)
 	RemoveBlock(context.Context, *RemoveBlockRequest) (*emptypb.Empty, error)
 	GetBlockInfo(context.Context, *GetBlockInfoRequest) (*GetBlockInfoResponse, error)
+	SetBlockCompression(context.Context, *SetBlockCompressionRequest) (*emptypb.Empty, error)
+	SetBlockSegment(context.Context, *SetBlockSegmentRequest) (*emptypb.Empty, error)
 	ActivateSegment(context.Context, *ActivateSegmentRequest) (*ActivateSegmentResponse, error)
 	InactivateSegment(context.Context, *InactivateSegmentRequest) (*emptypb.Empty, error)
+	ChangeMembership(context.Context, *ChangeMembershipRequest) (*emptypb.Empty, error)
 	AppendToBlock(context.Context, *AppendToBlockRequest) (*AppendToBlockResponse, error)
 	ReadFromBlock(context.Context, *ReadFromBlockRequest) (*ReadFromBlockResponse, error)
 	LookupOffsetInBlock(context.Context, *LookupOffsetInBlockRequest) (*LookupOffsetInBlockResponse, error)
@@ -179,12 +212,21 @@ func (UnimplementedSegmentServerServer) RemoveBlock(context.Context, *RemoveBloc
 func (UnimplementedSegmentServerServer) GetBlockInfo(context.Context, *GetBlockInfoRequest) (*GetBlockInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockInfo not implemented")
 }
+func (UnimplementedSegmentServerServer) SetBlockCompression(context.Context, *SetBlockCompressionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBlockCompression not implemented")
+}
+func (UnimplementedSegmentServerServer) SetBlockSegment(context.Context, *SetBlockSegmentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBlockSegment not implemented")
+}
 func (UnimplementedSegmentServerServer) ActivateSegment(context.Context, *ActivateSegmentRequest) (*ActivateSegmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ActivateSegment not implemented")
 }
 func (UnimplementedSegmentServerServer) InactivateSegment(context.Context, *InactivateSegmentRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InactivateSegment not implemented")
 }
+func (UnimplementedSegmentServerServer) ChangeMembership(context.Context, *ChangeMembershipRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeMembership not implemented")
+}
 func (UnimplementedSegmentServerServer) AppendToBlock(context.Context, *AppendToBlockRequest) (*AppendToBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AppendToBlock not implemented")
 }
@@ -299,6 +341,42 @@ func _SegmentServer_GetBlockInfo_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SegmentServer_SetBlockCompression_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBlockCompressionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentServerServer).SetBlockCompression(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.segment.SegmentServer/SetBlockCompression",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentServerServer).SetBlockCompression(ctx, req.(*SetBlockCompressionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SegmentServer_SetBlockSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBlockSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentServerServer).SetBlockSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.segment.SegmentServer/SetBlockSegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentServerServer).SetBlockSegment(ctx, req.(*SetBlockSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SegmentServer_ActivateSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ActivateSegmentRequest)
 	if err := dec(in); err != nil {
@@ -335,6 +413,24 @@ func _SegmentServer_InactivateSegment_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SegmentServer_ChangeMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeMembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentServerServer).ChangeMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.segment.SegmentServer/ChangeMembership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentServerServer).ChangeMembership(ctx, req.(*ChangeMembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SegmentServer_AppendToBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AppendToBlockRequest)
 	if err := dec(in); err != nil {
@@ -434,6 +530,14 @@ var SegmentServer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlockInfo",
 			Handler:    _SegmentServer_GetBlockInfo_Handler,
 		},
+		{
+			MethodName: "SetBlockCompression",
+			Handler:    _SegmentServer_SetBlockCompression_Handler,
+		},
+		{
+			MethodName: "SetBlockSegment",
+			Handler:    _SegmentServer_SetBlockSegment_Handler,
+		},
 		{
 			MethodName: "ActivateSegment",
 			Handler:    _SegmentServer_ActivateSegment_Handler,
@@ -442,6 +546,10 @@ var SegmentServer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InactivateSegment",
 			Handler:    _SegmentServer_InactivateSegment_Handler,
 		},
+		{
+			MethodName: "ChangeMembership",
+			Handler:    _SegmentServer_ChangeMembership_Handler,
+		},
 		{
 			MethodName: "AppendToBlock",
 			Handler:    _SegmentServer_AppendToBlock_Handler,
@@ -0,0 +1,251 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	"github.com/spf13/cobra"
+)
+
+func deadLetterCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deadletter sub-command ",
+		Short: "operations for the dead-lettered events of subscription",
+	}
+	cmd.AddCommand(listDeadLetterCommand())
+	cmd.AddCommand(replayDeadLetterCommand())
+	cmd.AddCommand(purgeDeadLetterCommand())
+	cmd.AddCommand(deadLetterHistoryCommand())
+	return cmd
+}
+
+func addDeadLetterFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&subscriptionIDStr, "id", "", "subscription id")
+	cmd.Flags().StringVar(&deadLetterReason, "reason", "", "only the events dead-lettered for the reason")
+	cmd.Flags().StringVar(&deadLetterStart, "start", "", "only the events dead-lettered after the time, "+
+		"format: RFC3339")
+	cmd.Flags().StringVar(&deadLetterEnd, "end", "", "only the events dead-lettered before the time, "+
+		"format: RFC3339")
+	cmd.Flags().StringVar(&deadLetterEvents, "events", "", "only the selected events, "+
+		"format: eventlogID:offset,eventlogID:offset")
+	cmd.Flags().Uint32Var(&deadLetterLimit, "limit", 0, "the maximum number of events, default 100")
+}
+
+func getDeadLetterFilter(cmd *cobra.Command) (uint64, *metapb.DeadLetterFilter) {
+	id, err := vanus.NewIDFromString(subscriptionIDStr)
+	if err != nil {
+		cmdFailedWithHelpNotice(cmd, fmt.Sprintf("invalid subscription id: %s\n", err.Error()))
+	}
+	filter := &metapb.DeadLetterFilter{Reason: deadLetterReason}
+	if deadLetterStart != "" {
+		t, err := time.Parse(time.RFC3339, deadLetterStart)
+		if err != nil {
+			cmdFailedf(cmd, "invalid start time: %s", err)
+		}
+		filter.StartTime = t.UnixMilli()
+	}
+	if deadLetterEnd != "" {
+		t, err := time.Parse(time.RFC3339, deadLetterEnd)
+		if err != nil {
+			cmdFailedf(cmd, "invalid end time: %s", err)
+		}
+		filter.EndTime = t.UnixMilli()
+	}
+	if deadLetterEvents != "" {
+		for _, s := range strings.Split(deadLetterEvents, ",") {
+			kv := strings.Split(strings.TrimSpace(s), ":")
+			if len(kv) != 2 {
+				cmdFailedf(cmd, "invalid event position: %s", s)
+			}
+			logID, err := vanus.NewIDFromString(kv[0])
+			if err != nil {
+				cmdFailedf(cmd, "invalid eventlog id: %s", err)
+			}
+			off, err := strconv.ParseUint(kv[1], 10, 64)
+			if err != nil {
+				cmdFailedf(cmd, "invalid offset: %s", err)
+			}
+			filter.Events = append(filter.Events, &metapb.EventPosition{EventlogId: logID.Uint64(), Offset: off})
+		}
+	}
+	return id.Uint64(), filter
+}
+
+func listDeadLetterCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "list the dead-lettered events of subscription",
+		Run: func(cmd *cobra.Command, args []string) {
+			id, filter := getDeadLetterFilter(cmd)
+			res, err := client.ListDeadLetterEvent(context.Background(), &proxypb.ListDeadLetterEventRequest{
+				SubscriptionId: id,
+				Filter:         filter,
+				Limit:          deadLetterLimit,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "list dead letter events failed: %s", err)
+			}
+			if IsFormatJSON(cmd) {
+				data, _ := json.Marshal(res)
+				color.Green(string(data))
+				return
+			}
+			t := table.NewWriter()
+			t.AppendHeader(table.Row{"Eventlog", "Offset", "Event ID", "Reason", "Last Delivery Error",
+				"Dead Letter Time", "Replayed"})
+			for _, e := range res.Events {
+				dlTime := "-"
+				if e.DeadLetterTime > 0 {
+					dlTime = time.UnixMilli(e.DeadLetterTime).Format(time.RFC3339)
+				}
+				t.AppendRow(table.Row{
+					vanus.NewIDFromUint64(e.Position.EventlogId).String(),
+					e.Position.Offset,
+					e.Event.Id,
+					e.Reason,
+					e.LastDeliveryError,
+					dlTime,
+					e.Replayed,
+				})
+				t.AppendSeparator()
+			}
+			t.SetColumnConfigs([]table.ColumnConfig{
+				{Number: 1, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 2, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 3, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 4, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 5, AlignHeader: text.AlignCenter, WidthMax: 60},
+				{Number: 6, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 7, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+			})
+			t.SetOutputMirror(os.Stdout)
+			t.Render()
+		},
+	}
+	addDeadLetterFilterFlags(cmd)
+	return cmd
+}
+
+func replayDeadLetterCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "deliver the dead-lettered events to the sink of subscription again",
+		Run: func(cmd *cobra.Command, args []string) {
+			id, filter := getDeadLetterFilter(cmd)
+			op, err := client.ReplayDeadLetterEvent(context.Background(), &proxypb.DeadLetterOperationRequest{
+				SubscriptionId:  id,
+				Filter:          filter,
+				Limit:           deadLetterLimit,
+				IncludeReplayed: deadLetterIncludeReplayed,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "replay dead letter events failed: %s", err)
+			}
+			printDeadLetterOperation(cmd, op)
+		},
+	}
+	addDeadLetterFilterFlags(cmd)
+	cmd.Flags().BoolVar(&deadLetterIncludeReplayed, "include-replayed", false,
+		"replay the events which have been replayed as well")
+	return cmd
+}
+
+func purgeDeadLetterCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "purge",
+		Short: "discard the dead-lettered events, they can't be listed or replayed anymore",
+		Run: func(cmd *cobra.Command, args []string) {
+			id, filter := getDeadLetterFilter(cmd)
+			op, err := client.PurgeDeadLetterEvent(context.Background(), &proxypb.DeadLetterOperationRequest{
+				SubscriptionId: id,
+				Filter:         filter,
+				Limit:          deadLetterLimit,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "purge dead letter events failed: %s", err)
+			}
+			printDeadLetterOperation(cmd, op)
+		},
+	}
+	addDeadLetterFilterFlags(cmd)
+	return cmd
+}
+
+func deadLetterHistoryCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "list the replay and purge operations of subscription",
+		Run: func(cmd *cobra.Command, args []string) {
+			id, err := vanus.NewIDFromString(subscriptionIDStr)
+			if err != nil {
+				cmdFailedWithHelpNotice(cmd, fmt.Sprintf("invalid subscription id: %s\n", err.Error()))
+			}
+			res, err := client.ListDeadLetterOperation(context.Background(), &ctrlpb.ListDeadLetterOperationRequest{
+				SubscriptionId: id.Uint64(),
+			})
+			if err != nil {
+				cmdFailedf(cmd, "list dead letter operations failed: %s", err)
+			}
+			printDeadLetterOperation(cmd, res.Operations...)
+		},
+	}
+	cmd.Flags().StringVar(&subscriptionIDStr, "id", "", "subscription id")
+	return cmd
+}
+
+func printDeadLetterOperation(cmd *cobra.Command, ops ...*metapb.DeadLetterOperation) {
+	if IsFormatJSON(cmd) {
+		data, _ := json.Marshal(ops)
+		color.Green(string(data))
+		return
+	}
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"ID", "Type", "Reason", "Event Number", "Operator", "Created_At"})
+	for _, op := range ops {
+		t.AppendRow(table.Row{
+			vanus.NewIDFromUint64(op.Id).String(),
+			op.Type.String(),
+			op.GetFilter().GetReason(),
+			len(op.Events),
+			op.Operator,
+			time.UnixMilli(op.CreatedAt).Format(time.RFC3339),
+		})
+		t.AppendSeparator()
+	}
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 1, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 2, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 3, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 4, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 5, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 6, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+	})
+	t.SetOutputMirror(os.Stdout)
+	t.Render()
+}
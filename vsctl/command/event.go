@@ -58,6 +58,7 @@ func NewEventCommand() *cobra.Command {
 	cmd.AddCommand(getEventCommand())
 	cmd.AddCommand(putEventCommand())
 	cmd.AddCommand(queryEventCommand())
+	cmd.AddCommand(scheduledEventCommand())
 	return cmd
 }
 
@@ -75,7 +76,11 @@ func putEventCommand() *cobra.Command {
 			if len(args) == 0 {
 				cmdFailedWithHelpNotice(cmd, "eventbus name can't be empty\n")
 			}
-			c, err := v2.NewClientHTTP()
+			var opts []cehttp.Option
+			if token := mustGetToken(cmd); token != "" {
+				opts = append(opts, cehttp.WithHeader("Authorization", "Bearer "+token))
+			}
+			c, err := v2.NewClientHTTP(opts...)
 			if err != nil {
 				cmdFailedf(cmd, "create ce client error: %s\n", err)
 			}
@@ -104,6 +109,8 @@ func putEventCommand() *cobra.Command {
 	cmd.Flags().StringVar(&eventDelayTime, "delay-time", "",
 		"event delay delivery time of CloudEvent, only support the unit of seconds, for example: 60")
 	cmd.Flags().StringVar(&eventType, "type", "cmd", "event type of CloudEvent")
+	cmd.Flags().StringVar(&dataSchema, "dataschema", "", "dataschema of CloudEvent, the data is validated "+
+		"if it refers to a registered schema")
 	cmd.Flags().StringVar(&eventData, "data", "", "event data of CloudEvent")
 	cmd.Flags().StringVar(&dataFile, "file", "", "the data file to send, each line represent a event "+
 		"and like [id],[source],[type],<body>")
@@ -112,6 +119,23 @@ func putEventCommand() *cobra.Command {
 	return cmd
 }
 
+func setEventData(cmd *cobra.Command, event *v2.Event) {
+	var err error
+	if strings.ToLower(dataFormat) == "json" {
+		m := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(eventData), &m); err != nil {
+			color.White(eventData)
+			cmdFailedf(cmd, "invalid format of data body: %s, err: %s", eventData, err.Error())
+		}
+		err = event.SetData(v2.ApplicationJSON, m)
+	} else {
+		err = event.SetData(v2.TextPlain, eventData)
+	}
+	if err != nil {
+		cmdFailedf(cmd, "set data failed: %s\n", err)
+	}
+}
+
 func sendOne(ctx context.Context, cmd *cobra.Command, ceClient v2.Client) {
 	event := v2.NewEvent()
 	if id == "" {
@@ -120,6 +144,9 @@ func sendOne(ctx context.Context, cmd *cobra.Command, ceClient v2.Client) {
 	event.SetID(id)
 	event.SetSource(eventSource)
 	event.SetType(eventType)
+	if dataSchema != "" {
+		event.SetDataSchema(dataSchema)
+	}
 	if eventDeliveryTime != "" {
 		// validate event delivery time
 		if _, err := time.Parse(time.RFC3339Nano, eventDeliveryTime); err != nil {
@@ -135,21 +162,7 @@ func sendOne(ctx context.Context, cmd *cobra.Command, ceClient v2.Client) {
 		timeOfRFC3339Nano := time.Now().Add(time.Duration(timeOfInt64) * time.Second).Format(time.RFC3339Nano)
 		event.SetExtension(xceVanusDeliveryTime, timeOfRFC3339Nano)
 	}
-	var err error
-	if strings.ToLower(dataFormat) == "json" {
-		m := make(map[string]interface{})
-		if err := json.Unmarshal([]byte(eventData), &m); err != nil {
-			color.White(eventData)
-			cmdFailedf(cmd, "invalid format of data body: %s, err: %s", eventData, err.Error())
-		}
-		err = event.SetData(v2.ApplicationJSON, m)
-	} else {
-		err = event.SetData(v2.TextPlain, eventData)
-	}
-
-	if err != nil {
-		cmdFailedf(cmd, "set data failed: %s\n", err)
-	}
+	setEventData(cmd, &event)
 
 	var res protocol.Result
 	var resEvent *v2.Event
@@ -17,6 +17,7 @@ package command
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"os"
 	"strings"
 	"time"
@@ -44,9 +45,11 @@ func NewEventbusCommand() *cobra.Command {
 		},
 	}
 	cmd.AddCommand(createEventbusCommand())
+	cmd.AddCommand(updateEventbusCommand())
 	cmd.AddCommand(deleteEventbusCommand())
 	cmd.AddCommand(getEventbusInfoCommand())
 	cmd.AddCommand(listEventbusInfoCommand())
+	cmd.AddCommand(schemaCommand())
 	return cmd
 }
 
@@ -58,11 +61,24 @@ func createEventbusCommand() *cobra.Command {
 			if eventbus == "" {
 				cmdFailedf(cmd, "the --name flag MUST be set")
 			}
-			_, err := client.CreateEventBus(context.Background(), &ctrlpb.CreateEventBusRequest{
+			retention, err := getRetentionPolicy(cmd)
+			if err != nil {
+				cmdFailedf(cmd, "parse retention policy failed: %s", err)
+			}
+			algorithm, err := getCompression(cmd)
+			if err != nil {
+				cmdFailedf(cmd, "parse compression failed: %s", err)
+			}
+			req := &ctrlpb.CreateEventBusRequest{
 				Name:        eventbus,
 				LogNumber:   eventlogNum,
 				Description: description,
-			})
+				Retention:   retention,
+			}
+			if algorithm != nil {
+				req.Compression = *algorithm
+			}
+			_, err = client.CreateEventBus(context.Background(), req)
 			if err != nil {
 				cmdFailedf(cmd, "create eventbus failed: %s", err)
 			}
@@ -85,9 +101,134 @@ func createEventbusCommand() *cobra.Command {
 	cmd.Flags().StringVar(&eventbus, "name", "", "eventbus name to deleting")
 	cmd.Flags().Int32Var(&eventlogNum, "eventlog", 1, "number of eventlog")
 	cmd.Flags().StringVar(&description, "description", "", "subscription description")
+	addRetentionFlags(cmd)
+	addCompressionFlag(cmd)
+	return cmd
+}
+
+func updateEventbusCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "update a eventbus",
+		Run: func(cmd *cobra.Command, args []string) {
+			if eventbus == "" {
+				cmdFailedf(cmd, "the --name flag MUST be set")
+			}
+			retention, err := getRetentionPolicy(cmd)
+			if err != nil {
+				cmdFailedf(cmd, "parse retention policy failed: %s", err)
+			}
+			algorithm, err := getCompression(cmd)
+			if err != nil {
+				cmdFailedf(cmd, "parse compression failed: %s", err)
+			}
+			_, err = client.UpdateEventBus(context.Background(), &ctrlpb.UpdateEventBusRequest{
+				Name:        eventbus,
+				Description: description,
+				Retention:   retention,
+				Compression: algorithm,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "update eventbus failed: %s", err)
+			}
+			if IsFormatJSON(cmd) {
+				data, _ := json.Marshal(map[string]interface{}{"Result": "Update Success", "EventbusService": eventbus})
+				color.Green(string(data))
+			} else {
+				t := table.NewWriter()
+				t.AppendHeader(table.Row{"Result", "EventbusService"})
+				t.AppendRow(table.Row{"Update Success", eventbus})
+				t.SetColumnConfigs([]table.ColumnConfig{
+					{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+					{Number: 2, AlignHeader: text.AlignCenter},
+				})
+				t.SetOutputMirror(os.Stdout)
+				t.Render()
+			}
+		},
+	}
+	cmd.Flags().StringVar(&eventbus, "name", "", "eventbus name to updating")
+	cmd.Flags().StringVar(&description, "description", "", "eventbus description")
+	addRetentionFlags(cmd)
+	addCompressionFlag(cmd)
 	return cmd
 }
 
+func addRetentionFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&retentionMaxAge, "retention-max-age", "",
+		"max age of events, such as 72h, the global expired time is used if not set")
+	cmd.Flags().Int64Var(&retentionMaxBytes, "retention-max-bytes", 0, "max total bytes of each eventlog")
+	cmd.Flags().Int64Var(&retentionMaxEvents, "retention-max-events", 0, "max number of events of each eventlog")
+	cmd.Flags().BoolVar(&allowDeleteUnconsumed, "allow-delete-unconsumed", false,
+		"allow deleting events which haven't been consumed by subscriptions")
+}
+
+// getRetentionPolicy returns nil if none of retention flags is set.
+func getRetentionPolicy(cmd *cobra.Command) (*metapb.RetentionPolicy, error) {
+	retention := &metapb.RetentionPolicy{
+		MaxBytes:              retentionMaxBytes,
+		MaxEvents:             retentionMaxEvents,
+		AllowDeleteUnconsumed: allowDeleteUnconsumed,
+	}
+	if retentionMaxAge != "" {
+		d, err := time.ParseDuration(retentionMaxAge)
+		if err != nil {
+			return nil, err
+		}
+		retention.MaxAge = int64(d / time.Second)
+	}
+	changed := false
+	for _, name := range []string{"retention-max-age", "retention-max-bytes",
+		"retention-max-events", "allow-delete-unconsumed"} {
+		changed = changed || cmd.Flags().Changed(name)
+	}
+	if !changed {
+		retention = nil
+	}
+	return retention, nil
+}
+
+func addCompressionFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&compression, "compression", "",
+		"algorithm which compresses the data of events in new segments, none, lz4 or zstd")
+}
+
+// getCompression returns nil if the compression flag isn't set.
+func getCompression(cmd *cobra.Command) (*metapb.CompressAlgorithm, error) {
+	if !cmd.Flags().Changed("compression") {
+		return nil, nil
+	}
+	v, ok := metapb.CompressAlgorithm_value[strings.ToUpper(compression)]
+	if !ok {
+		return nil, fmt.Errorf("unknown algorithm %s", compression)
+	}
+	algorithm := metapb.CompressAlgorithm(v)
+	return &algorithm, nil
+}
+
+func formatRetention(retention *metapb.RetentionPolicy) string {
+	if retention == nil {
+		return "default"
+	}
+	items := make([]string, 0)
+	if retention.MaxAge > 0 {
+		items = append(items, fmt.Sprintf("max_age=%s", time.Duration(retention.MaxAge)*time.Second))
+	}
+	if retention.MaxBytes > 0 {
+		items = append(items, fmt.Sprintf("max_bytes=%d", retention.MaxBytes))
+	}
+	if retention.MaxEvents > 0 {
+		items = append(items, fmt.Sprintf("max_events=%d", retention.MaxEvents))
+	}
+	if retention.AllowDeleteUnconsumed {
+		items = append(items, "allow_delete_unconsumed")
+	}
+	if len(items) == 0 {
+		return "default"
+	}
+	return strings.Join(items, "\n")
+}
+
 func deleteEventbusCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "delete <eventbus-name> ",
@@ -167,7 +308,7 @@ func getEventbusInfoCommand() *cobra.Command {
 			}
 			if !showSegment && !showBlock {
 				t.AppendHeader(table.Row{"EventbusService", "Description", "Created_At", "Updated_At",
-					"Eventlog", "Segment Number"})
+					"Eventlog", "Segment Number", "Retention", "Compression"})
 				for _, res := range busMetas {
 					for idx := 0; idx < len(res.Logs); idx++ {
 						if idx == 0 {
@@ -178,16 +319,20 @@ func getEventbusInfoCommand() *cobra.Command {
 								time.UnixMilli(res.UpdatedAt).Format(time.RFC3339),
 								formatID(res.Logs[idx].EventLogId),
 								res.Logs[idx].CurrentSegmentNumbers,
+								formatRetention(res.Retention),
+								strings.ToLower(res.Compression.String()),
 							})
 						}
 					}
 					cfgs := eventbusColConfigs()
-					cfgs = append(cfgs, table.ColumnConfig{
-						Number:      len(cfgs) + 1,
-						VAlign:      text.VAlignMiddle,
-						Align:       text.AlignCenter,
-						AlignHeader: text.AlignCenter,
-					})
+					for i := 0; i < 2; i++ {
+						cfgs = append(cfgs, table.ColumnConfig{
+							Number:      len(cfgs) + 1,
+							VAlign:      text.VAlignMiddle,
+							Align:       text.AlignCenter,
+							AlignHeader: text.AlignCenter,
+						})
+					}
 					t.SetColumnConfigs(cfgs)
 				}
 			} else {
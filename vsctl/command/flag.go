@@ -30,6 +30,8 @@ var (
 	detail            bool
 	eventID           string
 	eventCreateTime   string
+	scheduleID        uint64
+	dataSchema        string
 
 	// for both of eventbus and subscription.
 	eventbus            string
@@ -39,6 +41,7 @@ var (
 	sink                string
 	filters             string
 	transformer         string
+	aggregation         string
 	rateLimit           uint32
 	from                string
 	subscriptionIDStr   string
@@ -46,18 +49,61 @@ var (
 	subscriptionName    string
 	disableSubscription bool
 	orderedPushEvent    bool
+	pullSubscription    bool
 
 	subProtocol        string
 	sinkCredentialType string
 	sinkCredential     string
 	deliveryTimeout    uint32
 	maxRetryAttempts   int32
+	retryStrategy      string
+	retryInitialDelay  uint32
+	retryMaxDelay      uint32
+	retryMultiplier    float64
+	retryJitter        float64
+	maxBatchSize       uint32
+	maxConcurrency     uint32
+	orderKey           string
+	batchTimeout       uint32
+	visibilityTimeout  uint32
+
+	circuitBreaker        bool
+	breakerFailureRatio   float64
+	breakerMinRequests    uint32
+	breakerWindow         uint32
+	breakerOpenDuration   uint32
+	breakerHalfOpenTrials uint32
+
+	deadLetterReason string
+	deadLetterStart  string
+	deadLetterEnd    string
+	deadLetterEvents string
+	deadLetterLimit  uint32
+
+	deadLetterIncludeReplayed bool
 
 	showSegment bool
 	showBlock   bool
+
+	retentionMaxAge       string
+	retentionMaxBytes     int64
+	retentionMaxEvents    int64
+	allowDeleteUnconsumed bool
+
+	schemaType          string
+	schemaFormat        string
+	schemaFile          string
+	schemaCompatibility string
+	schemaVersion       uint32
+
+	drainWatch bool
+
+	compression string
 )
 
 const (
 	AWSCredentialType    = "aws"
 	GCloudCredentialType = "gcloud"
+	KafkaCredentialType  = "kafka"
+	TLSCredentialType    = "tls"
 )
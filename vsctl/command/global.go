@@ -22,10 +22,11 @@ import (
 	"time"
 
 	"github.com/fatih/color"
+	"github.com/linkall-labs/vanus/pkg/util/tlsconfig"
 	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials"
 )
 
 const (
@@ -33,10 +34,14 @@ const (
 )
 
 type GlobalFlags struct {
-	Endpoint   string
-	Debug      bool
-	ConfigFile string
-	Format     string
+	Endpoint    string
+	Debug       bool
+	ConfigFile  string
+	Format      string
+	Token       string
+	TLSCAFile   string
+	TLSCertFile string
+	TLSKeyFile  string
 }
 
 var (
@@ -51,7 +56,10 @@ func InitGatewayClient(cmd *cobra.Command) {
 	}
 	opts := []grpc.DialOption{
 		grpc.WithBlock(),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(mustGetTransportCredentials(cmd)),
+	}
+	if token := mustGetToken(cmd); token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(tokenCredential(token)))
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -89,6 +97,46 @@ func mustGetGatewayEndpoint(cmd *cobra.Command) string {
 	return endpoint
 }
 
+func mustGetToken(cmd *cobra.Command) string {
+	token, err := cmd.Flags().GetString("token")
+	if err != nil {
+		cmdFailedf(cmd, "get token failed: %s", err)
+	}
+	return token
+}
+
+// mustGetTransportCredentials enables TLS if any of the TLS flags is set.
+func mustGetTransportCredentials(cmd *cobra.Command) credentials.TransportCredentials {
+	cfg := tlsconfig.Config{}
+	var err error
+	if cfg.CAFile, err = cmd.Flags().GetString("tls-ca-file"); err != nil {
+		cmdFailedf(cmd, "get TLS CA file failed: %s", err)
+	}
+	if cfg.CertFile, err = cmd.Flags().GetString("tls-cert-file"); err != nil {
+		cmdFailedf(cmd, "get TLS certificate file failed: %s", err)
+	}
+	if cfg.KeyFile, err = cmd.Flags().GetString("tls-key-file"); err != nil {
+		cmdFailedf(cmd, "get TLS key file failed: %s", err)
+	}
+	cfg.Enable = cfg.CAFile != "" || cfg.CertFile != ""
+	creds, err := tlsconfig.NewClientCredentials(cfg)
+	if err != nil {
+		cmdFailedf(cmd, "init TLS failed: %s", err)
+	}
+	return creds
+}
+
+// tokenCredential sends the token as bearer token of each request.
+type tokenCredential string
+
+func (t tokenCredential) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+func (t tokenCredential) RequireTransportSecurity() bool {
+	return false
+}
+
 func IsFormatJSON(cmd *cobra.Command) bool {
 	v, err := cmd.Flags().GetString("format")
 	if err != nil {
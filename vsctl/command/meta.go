@@ -34,6 +34,7 @@ func NewClusterCommand() *cobra.Command {
 		},
 	}
 	cmd.AddCommand(controllerCommand())
+	cmd.AddCommand(volumeCommand())
 	return cmd
 }
 
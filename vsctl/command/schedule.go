@@ -0,0 +1,205 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strconv"
+	"time"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/fatih/color"
+	"github.com/google/uuid"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/linkall-labs/vanus/internal/convert"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	"github.com/spf13/cobra"
+)
+
+func scheduledEventCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule sub-command ",
+		Short: "operations for the scheduled events",
+	}
+	cmd.AddCommand(createScheduledEventCommand())
+	cmd.AddCommand(listScheduledEventCommand())
+	cmd.AddCommand(cancelScheduledEventCommand())
+	return cmd
+}
+
+func createScheduledEventCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create <eventbus-name> ",
+		Short: "schedule a event to be delivered to eventbus at the delivery time",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				cmdFailedWithHelpNotice(cmd, "eventbus name can't be empty\n")
+			}
+			req := &proxypb.ScheduleEventRequest{Eventbus: args[0]}
+			switch {
+			case eventDeliveryTime != "":
+				t, err := time.Parse(time.RFC3339Nano, eventDeliveryTime)
+				if err != nil {
+					cmdFailedf(cmd, "invalid format of delivery-time: %s\n", err)
+				}
+				if t.Before(time.Now()) {
+					cmdFailedf(cmd, "the delivery-time can't be in the past\n")
+				}
+				req.DeliveryTime = t.UnixMilli()
+			case eventDelayTime != "":
+				delay, err := strconv.ParseUint(eventDelayTime, 10, 64)
+				if err != nil || delay == 0 {
+					cmdFailedf(cmd, "invalid format of delay-time: %s\n", eventDelayTime)
+				}
+				req.Delay = uint64((time.Duration(delay) * time.Second).Milliseconds())
+			default:
+				cmdFailedf(cmd, "one of the --delivery-time and --delay-time flags MUST be set\n")
+			}
+
+			event := v2.NewEvent()
+			if id == "" {
+				id = uuid.NewString()
+			}
+			event.SetID(id)
+			event.SetSource(eventSource)
+			event.SetType(eventType)
+			if dataSchema != "" {
+				event.SetDataSchema(dataSchema)
+			}
+			setEventData(cmd, &event)
+			pe, err := convert.ToPbCloudEvent(&event)
+			if err != nil {
+				cmdFailedf(cmd, "convert event failed: %s\n", err)
+			}
+			req.Event = pe
+
+			se, err := client.ScheduleEvent(context.Background(), req)
+			if err != nil {
+				cmdFailedf(cmd, "schedule event failed: %s", err)
+			}
+			if IsFormatJSON(cmd) {
+				data, _ := json.Marshal(se)
+				color.Green(string(data))
+			} else {
+				t := table.NewWriter()
+				t.AppendHeader(table.Row{"Schedule ID", "Event ID", "Delivery Time"})
+				t.AppendRow(table.Row{se.Id, se.EventId, time.UnixMilli(se.DeliveryTime).Format(time.RFC3339)})
+				t.SetColumnConfigs([]table.ColumnConfig{
+					{Number: 1, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+					{Number: 2, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+					{Number: 3, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				})
+				t.SetOutputMirror(os.Stdout)
+				t.Render()
+			}
+		},
+	}
+	cmd.Flags().StringVar(&id, "id", "", "event id of CloudEvent")
+	cmd.Flags().StringVar(&dataFormat, "data-format", "json", "the format of event body, JSON or plain")
+	cmd.Flags().StringVar(&eventSource, "source", "cmd", "event source of CloudEvent")
+	cmd.Flags().StringVar(&eventType, "type", "cmd", "event type of CloudEvent")
+	cmd.Flags().StringVar(&dataSchema, "dataschema", "", "dataschema of CloudEvent, the data is validated "+
+		"if it refers to a registered schema")
+	cmd.Flags().StringVar(&eventData, "data", "", "event data of CloudEvent")
+	cmd.Flags().StringVar(&eventDeliveryTime, "delivery-time", "",
+		"the time to deliver the event, only support the time layout of RFC3339, for example: 2022-01-01T08:00:00Z")
+	cmd.Flags().StringVar(&eventDelayTime, "delay-time", "",
+		"the delay to deliver the event, only support the unit of seconds, for example: 60")
+	return cmd
+}
+
+func listScheduledEventCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list <eventbus-name> ",
+		Short: "list the pending scheduled events of eventbus",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				cmdFailedWithHelpNotice(cmd, "eventbus name can't be empty\n")
+			}
+			res, err := client.ListScheduledEvents(context.Background(), &proxypb.ListScheduledEventsRequest{
+				Eventbus: args[0],
+			})
+			if err != nil {
+				cmdFailedf(cmd, "list scheduled events failed: %s", err)
+			}
+			if IsFormatJSON(cmd) {
+				data, _ := json.Marshal(res)
+				color.Green(string(data))
+			} else {
+				t := table.NewWriter()
+				t.AppendHeader(table.Row{"Schedule ID", "Event ID", "Delivery Time", "Created_At"})
+				for _, se := range res.Events {
+					t.AppendRow(table.Row{
+						se.Id,
+						se.EventId,
+						time.UnixMilli(se.DeliveryTime).Format(time.RFC3339),
+						time.UnixMilli(se.CreatedAt).Format(time.RFC3339),
+					})
+					t.AppendSeparator()
+				}
+				t.SetColumnConfigs([]table.ColumnConfig{
+					{Number: 1, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+					{Number: 2, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+					{Number: 3, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+					{Number: 4, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				})
+				t.SetOutputMirror(os.Stdout)
+				t.Render()
+			}
+		},
+	}
+	return cmd
+}
+
+func cancelScheduledEventCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel <eventbus-name> ",
+		Short: "cancel a pending scheduled event",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				cmdFailedWithHelpNotice(cmd, "eventbus name can't be empty\n")
+			}
+			if scheduleID == 0 {
+				cmdFailedf(cmd, "the --id flag MUST be set")
+			}
+			_, err := client.CancelScheduledEvent(context.Background(), &proxypb.CancelScheduledEventRequest{
+				Eventbus: args[0],
+				Id:       scheduleID,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "cancel scheduled event failed: %s", err)
+			}
+			if IsFormatJSON(cmd) {
+				data, _ := json.Marshal(map[string]interface{}{"Result": "Cancel Success", "Schedule ID": scheduleID})
+				color.Green(string(data))
+			} else {
+				t := table.NewWriter()
+				t.AppendHeader(table.Row{"Result", "Schedule ID"})
+				t.AppendRow(table.Row{"Cancel Success", scheduleID})
+				t.SetColumnConfigs([]table.ColumnConfig{
+					{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+					{Number: 2, AlignHeader: text.AlignCenter},
+				})
+				t.SetOutputMirror(os.Stdout)
+				t.Render()
+			}
+		},
+	}
+	cmd.Flags().Uint64Var(&scheduleID, "id", 0, "the ID of scheduled event")
+	return cmd
+}
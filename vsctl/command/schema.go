@@ -0,0 +1,218 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	"github.com/spf13/cobra"
+)
+
+func schemaCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schema sub-command ",
+		Short: "operations for the schemas of events",
+	}
+	cmd.AddCommand(registerSchemaCommand())
+	cmd.AddCommand(getSchemaCommand())
+	cmd.AddCommand(listSchemaCommand())
+	cmd.AddCommand(deleteSchemaCommand())
+	return cmd
+}
+
+func registerSchemaCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register <eventbus-name> ",
+		Short: "register a new version of schema for an event type",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				cmdFailedWithHelpNotice(cmd, "eventbus name can't be empty\n")
+			}
+			if schemaType == "" {
+				cmdFailedf(cmd, "the --type flag MUST be set")
+			}
+			if schemaFile == "" {
+				cmdFailedf(cmd, "the --definition-file flag MUST be set")
+			}
+			format, ok := metapb.Schema_Format_value[strings.ToUpper(schemaFormat)]
+			if !ok {
+				cmdFailedf(cmd, "invalid schema format: %s", schemaFormat)
+			}
+			compatibility, ok := metapb.Schema_Compatibility_value[strings.ToUpper(schemaCompatibility)]
+			if !ok {
+				cmdFailedf(cmd, "invalid schema compatibility: %s", schemaCompatibility)
+			}
+			definition, err := os.ReadFile(schemaFile)
+			if err != nil {
+				cmdFailedf(cmd, "read definition file failed: %s", err)
+			}
+			s, err := client.RegisterSchema(context.Background(), &ctrlpb.RegisterSchemaRequest{
+				Eventbus:      args[0],
+				Type:          schemaType,
+				Format:        metapb.Schema_Format(format),
+				Definition:    string(definition),
+				Compatibility: metapb.Schema_Compatibility(compatibility),
+			})
+			if err != nil {
+				cmdFailedf(cmd, "register schema failed: %s", err)
+			}
+			printSchemas(cmd, false, s)
+		},
+	}
+	cmd.Flags().StringVar(&schemaType, "type", "", "the event type which the schema applies to")
+	cmd.Flags().StringVar(&schemaFormat, "format", "json_schema", "the format of schema, only json_schema is "+
+		"supported now")
+	cmd.Flags().StringVar(&schemaFile, "definition-file", "", "the file of schema definition")
+	cmd.Flags().StringVar(&schemaCompatibility, "compatibility", "backward", "the compatibility with the "+
+		"latest version, backward, forward, full or none")
+	return cmd
+}
+
+func getSchemaCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get <eventbus-name> ",
+		Short: "get a version of schema, the latest version is returned by default",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				cmdFailedWithHelpNotice(cmd, "eventbus name can't be empty\n")
+			}
+			if schemaType == "" {
+				cmdFailedf(cmd, "the --type flag MUST be set")
+			}
+			s, err := client.GetSchema(context.Background(), &ctrlpb.GetSchemaRequest{
+				Eventbus: args[0],
+				Type:     schemaType,
+				Version:  schemaVersion,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "get schema failed: %s", err)
+			}
+			printSchemas(cmd, true, s)
+		},
+	}
+	cmd.Flags().StringVar(&schemaType, "type", "", "the event type which the schema applies to")
+	cmd.Flags().Uint32Var(&schemaVersion, "version", 0, "the version of schema, 0 means the latest")
+	return cmd
+}
+
+func listSchemaCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list <eventbus-name> ",
+		Short: "list the schemas of eventbus",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				cmdFailedWithHelpNotice(cmd, "eventbus name can't be empty\n")
+			}
+			res, err := client.ListSchema(context.Background(), &ctrlpb.ListSchemaRequest{
+				Eventbus: args[0],
+				Type:     schemaType,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "list schemas failed: %s", err)
+			}
+			printSchemas(cmd, false, res.Schemas...)
+		},
+	}
+	cmd.Flags().StringVar(&schemaType, "type", "", "only list the schemas of the event type")
+	return cmd
+}
+
+func deleteSchemaCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <eventbus-name> ",
+		Short: "delete a version of schema, all versions are deleted by default",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				cmdFailedWithHelpNotice(cmd, "eventbus name can't be empty\n")
+			}
+			if schemaType == "" {
+				cmdFailedf(cmd, "the --type flag MUST be set")
+			}
+			_, err := client.DeleteSchema(context.Background(), &ctrlpb.DeleteSchemaRequest{
+				Eventbus: args[0],
+				Type:     schemaType,
+				Version:  schemaVersion,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "delete schema failed: %s", err)
+			}
+			if IsFormatJSON(cmd) {
+				data, _ := json.Marshal(map[string]interface{}{"Result": "Delete Success", "Type": schemaType})
+				color.Green(string(data))
+			} else {
+				t := table.NewWriter()
+				t.AppendHeader(table.Row{"Result", "Type"})
+				t.AppendRow(table.Row{"Delete Success", schemaType})
+				t.SetColumnConfigs([]table.ColumnConfig{
+					{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+					{Number: 2, AlignHeader: text.AlignCenter},
+				})
+				t.SetOutputMirror(os.Stdout)
+				t.Render()
+			}
+		},
+	}
+	cmd.Flags().StringVar(&schemaType, "type", "", "the event type which the schema applies to")
+	cmd.Flags().Uint32Var(&schemaVersion, "version", 0, "the version of schema, 0 means all versions")
+	return cmd
+}
+
+func printSchemas(cmd *cobra.Command, showDefinition bool, schemas ...*metapb.Schema) {
+	if IsFormatJSON(cmd) {
+		data, _ := json.Marshal(schemas)
+		color.Green(string(data))
+		return
+	}
+	t := table.NewWriter()
+	header := table.Row{"Type", "Version", "Format", "Compatibility", "Dataschema", "Created_At"}
+	if showDefinition {
+		header = append(header, "Definition")
+	}
+	t.AppendHeader(header)
+	for _, s := range schemas {
+		row := table.Row{
+			s.Type,
+			s.Version,
+			s.Format,
+			s.Compatibility,
+			s.Uri,
+			time.UnixMilli(s.CreatedAt).Format(time.RFC3339),
+		}
+		if showDefinition {
+			row = append(row, s.Definition)
+		}
+		t.AppendRow(row)
+		t.AppendSeparator()
+	}
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 1, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 2, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 3, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 4, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 5, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 6, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+	})
+	t.SetOutputMirror(os.Stdout)
+	t.Render()
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
@@ -33,6 +34,7 @@ import (
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	"github.com/linkall-labs/vanus/proto/pkg/meta"
 	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
 	"github.com/spf13/cobra"
 	"k8s.io/utils/strings/slices"
 )
@@ -52,6 +54,8 @@ func NewSubscriptionCommand() *cobra.Command {
 	cmd.AddCommand(deleteSubscriptionCommand())
 	cmd.AddCommand(getSubscriptionCommand())
 	cmd.AddCommand(listSubscriptionCommand())
+	cmd.AddCommand(subscriptionLagCommand())
+	cmd.AddCommand(deadLetterCommand())
 	return cmd
 }
 
@@ -63,9 +67,12 @@ func createSubscriptionCommand() *cobra.Command {
 			if eventbus == "" {
 				cmdFailedWithHelpNotice(cmd, "eventbus name can't be empty\n")
 			}
-			if sink == "" {
+			if sink == "" && !pullSubscription {
 				cmdFailedWithHelpNotice(cmd, "sink name can't be empty\n")
 			}
+			if sink != "" && pullSubscription {
+				cmdFailedWithHelpNotice(cmd, "pull subscription can't have sink\n")
+			}
 
 			var p meta.Protocol
 			switch subProtocol {
@@ -84,6 +91,16 @@ func createSubscriptionCommand() *cobra.Command {
 				if sinkCredentialType != GCloudCredentialType {
 					cmdFailedf(cmd, "protocol is aws-lambda, credential-type must be %s\n", GCloudCredentialType)
 				}
+			case "kafka":
+				p = meta.Protocol_KAFKA
+				if sinkCredentialType != "" && sinkCredentialType != KafkaCredentialType {
+					cmdFailedf(cmd, "protocol is kafka, credential-type must be %s\n", KafkaCredentialType)
+				}
+			case "grpc":
+				p = meta.Protocol_GRPC
+				if sinkCredentialType != "" && sinkCredentialType != TLSCredentialType {
+					cmdFailedf(cmd, "protocol is grpc, credential-type must be %s\n", TLSCredentialType)
+				}
 			default:
 				cmdFailedf(cmd, "protocol is invalid\n")
 			}
@@ -133,6 +150,30 @@ func createSubscriptionCommand() *cobra.Command {
 							},
 						},
 					}
+				case KafkaCredentialType:
+					var kafka *meta.KafkaCredential
+					err := json.Unmarshal([]byte(sinkCredential), &kafka)
+					if err != nil {
+						cmdFailedf(cmd, "the sink credential unmarshal json error: %s", err.Error())
+					}
+					credential = &meta.SinkCredential{
+						CredentialType: meta.SinkCredential_KAFKA,
+						Credential: &meta.SinkCredential_Kafka{
+							Kafka: kafka,
+						},
+					}
+				case TLSCredentialType:
+					var tls *meta.TLSCredential
+					err := json.Unmarshal([]byte(sinkCredential), &tls)
+					if err != nil {
+						cmdFailedf(cmd, "the sink credential unmarshal json error: %s", err.Error())
+					}
+					credential = &meta.SinkCredential{
+						CredentialType: meta.SinkCredential_TLS,
+						Credential: &meta.SinkCredential_Tls{
+							Tls: tls,
+						},
+					}
 				default:
 					cmdFailedf(cmd, "credential-type is invalid\n")
 				}
@@ -156,16 +197,52 @@ func createSubscriptionCommand() *cobra.Command {
 				trans = convert.ToPbTransformer(_transformer)
 			}
 
+			var agg *meta.Aggregation
+			if aggregation != "" {
+				var _aggregation *primitive.Aggregation
+				err := json.Unmarshal([]byte(aggregation), &_aggregation)
+				if err != nil {
+					cmdFailedf(cmd, "the aggregation invalid: %s", err)
+				}
+				switch _aggregation.WindowType {
+				case primitive.TumblingWindow, primitive.SlidingWindow:
+				default:
+					cmdFailedf(cmd, "the aggregation window_type must be tumbling or sliding\n")
+				}
+				for _, f := range _aggregation.Functions {
+					if _, ok := meta.Aggregation_Function_value[strings.ToUpper(string(f))]; !ok {
+						cmdFailedf(cmd, "the aggregation function %s is invalid, "+
+							"it must be count, sum, min, max or avg\n", f)
+					}
+				}
+				agg = convert.ToPbAggregation(_aggregation)
+			}
+
 			// subscription config
 			config := &meta.SubscriptionConfig{
-				RateLimit:       rateLimit,
-				DeliveryTimeout: deliveryTimeout,
-				OrderedEvent:    orderedPushEvent,
+				RateLimit:         rateLimit,
+				DeliveryTimeout:   deliveryTimeout,
+				OrderedEvent:      orderedPushEvent,
+				MaxBatchSize:      maxBatchSize,
+				BatchTimeout:      batchTimeout,
+				VisibilityTimeout: visibilityTimeout,
+				MaxConcurrency:    maxConcurrency,
+				OrderKey:          orderKey,
 			}
 			if maxRetryAttempts >= 0 {
 				value := uint32(maxRetryAttempts)
 				config.MaxRetryAttempts = &value
 			}
+			config.RetryPolicy = getRetryPolicy(cmd)
+			if circuitBreaker {
+				config.CircuitBreaker = &meta.CircuitBreaker{
+					FailureRatio:   breakerFailureRatio,
+					MinRequests:    breakerMinRequests,
+					Window:         breakerWindow,
+					OpenDuration:   breakerOpenDuration,
+					HalfOpenTrials: breakerHalfOpenTrials,
+				}
+			}
 			if from != "" {
 				switch from {
 				case "latest":
@@ -193,9 +270,11 @@ func createSubscriptionCommand() *cobra.Command {
 					Protocol:       p,
 					EventBus:       eventbus,
 					Transformer:    trans,
+					Aggregation:    agg,
 					Name:           subscriptionName,
 					Description:    description,
 					Disable:        disableSubscription,
+					Pull:           pullSubscription,
 				},
 			})
 			if err != nil {
@@ -208,10 +287,17 @@ func createSubscriptionCommand() *cobra.Command {
 	cmd.Flags().StringVar(&sink, "sink", "", "the event you want to send to")
 	cmd.Flags().StringVar(&filters, "filters", "", "filter event you interested, JSON format required")
 	cmd.Flags().StringVar(&transformer, "transformer", "", "transformer, JSON format required")
+	cmd.Flags().StringVar(&aggregation, "aggregation", "", "accumulate the events into windows and push "+
+		"one aggregated event per key and window, JSON format required, e.g. {\"window_type\":\"tumbling\","+
+		"\"window_size\":60000,\"key\":\"subject\",\"field\":\"$.data.amount\",\"functions\":[\"count\",\"sum\"]}, "+
+		"durations are in milliseconds, set target_eventbus to write the aggregated events to another eventbus "+
+		"instead of sink")
 	cmd.Flags().Uint32Var(&rateLimit, "rate-limit", 0, "max event number pushing to sink per second, default is 0, means unlimited")
 	cmd.Flags().StringVar(&from, "from", "", "consume events from, latest,earliest or RFC3339 format time")
-	cmd.Flags().StringVar(&subProtocol, "protocol", "http", "protocol,http or aws-lambda or gcloud-functions")
-	cmd.Flags().StringVar(&sinkCredentialType, "credential-type", "", "sink credential type: aws or gcloud")
+	cmd.Flags().StringVar(&subProtocol, "protocol", "http", "protocol,http or aws-lambda or gcloud-functions "+
+		"or kafka or grpc, the sink of kafka is kafka://broker1,broker2/topic?partition_key=attr&mode=binary|structured"+
+		", the sink of grpc is grpc://host:port or grpcs://host:port")
+	cmd.Flags().StringVar(&sinkCredentialType, "credential-type", "", "sink credential type: aws or gcloud or kafka or tls")
 	cmd.Flags().StringVar(&sinkCredential, "credential", "", "sink credential info, JSON format or @file")
 	cmd.Flags().Uint32Var(&deliveryTimeout, "delivery-timeout", 0, "event delivery to sink timeout by millisecond, default is 0, means using server-side default value: 5s")
 	cmd.Flags().Int32Var(&maxRetryAttempts, "max-retry-attempts", -1, "event delivery fail max retry attempts, default is -1, means using server-side max retry attempts: 32")
@@ -221,9 +307,66 @@ func createSubscriptionCommand() *cobra.Command {
 		"subscription (just create if disable=true)")
 	cmd.Flags().BoolVar(&orderedPushEvent, "ordered-event", false, "whether push the "+
 		"event with ordered")
+	cmd.Flags().Uint32Var(&maxBatchSize, "max-batch-size", 0, "max number of events pushing to sink "+
+		"in one request, only http protocol supported, default is 0, means no batch")
+	cmd.Flags().Uint32Var(&batchTimeout, "batch-timeout", 0, "max waiting time to fill a batch by millisecond, "+
+		"default is 0, means using server-side default value: 100ms")
+	cmd.Flags().StringVar(&orderKey, "order-key", "", "the attribute or extension whose value orders "+
+		"the events, the events with different values are pushed in parallel, it can't be used with "+
+		"--ordered-event or batch")
+	cmd.Flags().Uint32Var(&maxConcurrency, "max-concurrency", 0, "max number of in-flight requests to sink "+
+		"when not ordered, the window adapts to the sink under it, default is 0, means 1000")
+	cmd.Flags().BoolVar(&pullSubscription, "pull", false, "whether the subscription is pulled by "+
+		"consumer from gateway instead of pushing to sink")
+	cmd.Flags().Uint32Var(&visibilityTimeout, "visibility-timeout", 0, "time by millisecond which the pulled "+
+		"event is invisible before redelivery if not acked, default is 0, means using server-side default value: 30s")
+	cmd.Flags().StringVar(&retryStrategy, "retry-strategy", "", "retry backoff strategy: fixed, linear "+
+		"or exponential, default is empty, means using server-side default backoff")
+	cmd.Flags().Uint32Var(&retryInitialDelay, "retry-initial-delay", 1000, "initial delay of retry by millisecond")
+	cmd.Flags().Uint32Var(&retryMaxDelay, "retry-max-delay", 0, "max delay of retry by millisecond, "+
+		"default is 0, means 1 hour")
+	cmd.Flags().Float64Var(&retryMultiplier, "retry-multiplier", 0, "multiplier of linear or exponential "+
+		"strategy, default is 0, means 1 for linear and 2 for exponential")
+	cmd.Flags().Float64Var(&retryJitter, "retry-jitter", 0, "the ratio of random deviation of retry delay, "+
+		"range [0, 1]")
+	cmd.Flags().BoolVar(&circuitBreaker, "circuit-breaker", false, "whether stop pushing to the sink which "+
+		"keeps failing")
+	cmd.Flags().Float64Var(&breakerFailureRatio, "breaker-failure-ratio", 0, "the ratio of failed deliveries "+
+		"to open the circuit breaker, range (0, 1], default is 0, means 0.5")
+	cmd.Flags().Uint32Var(&breakerMinRequests, "breaker-min-requests", 0, "the minimum number of deliveries "+
+		"in window to open the circuit breaker, default is 0, means 20")
+	cmd.Flags().Uint32Var(&breakerWindow, "breaker-window", 0, "the window by millisecond to count the "+
+		"failed deliveries, default is 0, means 60s")
+	cmd.Flags().Uint32Var(&breakerOpenDuration, "breaker-open-duration", 0, "time by millisecond which the "+
+		"circuit breaker keeps open before trial deliveries, default is 0, means 30s")
+	cmd.Flags().Uint32Var(&breakerHalfOpenTrials, "breaker-half-open-trials", 0, "the number of trial "+
+		"deliveries to close the circuit breaker, default is 0, means 3")
 	return cmd
 }
 
+func getRetryPolicy(cmd *cobra.Command) *meta.RetryPolicy {
+	if retryStrategy == "" {
+		return nil
+	}
+	policy := &meta.RetryPolicy{
+		InitialDelay: retryInitialDelay,
+		MaxDelay:     retryMaxDelay,
+		Multiplier:   retryMultiplier,
+		Jitter:       retryJitter,
+	}
+	switch retryStrategy {
+	case "fixed":
+		policy.Strategy = meta.RetryPolicy_FIXED
+	case "linear":
+		policy.Strategy = meta.RetryPolicy_LINEAR
+	case "exponential":
+		policy.Strategy = meta.RetryPolicy_EXPONENTIAL
+	default:
+		cmdFailedf(cmd, "retry strategy is invalid, must be fixed, linear or exponential\n")
+	}
+	return policy
+}
+
 func deleteSubscriptionCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "delete",
@@ -299,6 +442,58 @@ func listSubscriptionCommand() *cobra.Command {
 	return cmd
 }
 
+func subscriptionLagCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lag",
+		Short: "get how far the subscription falls behind the eventlogs",
+		Run: func(cmd *cobra.Command, args []string) {
+			id, err := vanus.NewIDFromString(subscriptionIDStr)
+			if err != nil {
+				cmdFailedWithHelpNotice(cmd, fmt.Sprintf("invalid subscription id: %s\n", err.Error()))
+			}
+
+			res, err := client.GetSubscriptionLag(context.Background(), &proxypb.GetSubscriptionLagRequest{
+				SubscriptionId: id.Uint64(),
+			})
+			if err != nil {
+				cmdFailedf(cmd, "get subscription lag failed: %s", err)
+			}
+			if IsFormatJSON(cmd) {
+				data, _ := json.Marshal(res)
+				color.Green(string(data))
+				return
+			}
+			now := time.Now()
+			t := table.NewWriter()
+			t.AppendHeader(table.Row{"Eventlog", "Committed Offset", "Latest Offset", "Lag", "Oldest Unconsumed"})
+			for _, lag := range res.Lags {
+				oldest := "-"
+				if lag.OldestUnconsumedTime > 0 {
+					oldest = fmt.Sprintf("%s (%s ago)", time.UnixMilli(lag.OldestUnconsumedTime).Format(time.RFC3339),
+						now.Sub(time.UnixMilli(lag.OldestUnconsumedTime)).Truncate(time.Second))
+				}
+				t.AppendRow(table.Row{
+					vanus.NewIDFromUint64(lag.EventlogId).String(),
+					lag.CommittedOffset, lag.LatestOffset, lag.Lag, oldest,
+				})
+				t.AppendSeparator()
+			}
+			t.AppendFooter(table.Row{"Total", "", "", res.TotalLag, ""})
+			t.SetColumnConfigs([]table.ColumnConfig{
+				{Number: 1, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 2, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 3, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 4, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 5, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+			})
+			t.SetOutputMirror(os.Stdout)
+			t.Render()
+		},
+	}
+	cmd.Flags().StringVar(&subscriptionIDStr, "id", "", "subscription id")
+	return cmd
+}
+
 func printSubscription(cmd *cobra.Command, showNo, showFilters, showTransformer bool, data ...*metapb.Subscription) {
 	if IsFormatJSON(cmd) {
 		data, _ := json.Marshal(data)
@@ -367,6 +562,10 @@ func getSubscriptionRow(sub *meta.Subscription) []interface{} {
 		protocol = "aws-lambda"
 	case meta.Protocol_GCLOUD_FUNCTIONS:
 		protocol = "gcloud-functions"
+	case meta.Protocol_KAFKA:
+		protocol = "kafka"
+	case meta.Protocol_GRPC:
+		protocol = "grpc"
 	}
 	result = append(result, protocol)
 
@@ -0,0 +1,135 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"github.com/spf13/cobra"
+)
+
+const drainWatchInterval = 5 * time.Second
+
+func volumeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "volume sub-command",
+		Short: "operations for the volumes of store",
+	}
+	cmd.AddCommand(drainVolumeCommand())
+	cmd.AddCommand(drainStatusCommand())
+	return cmd
+}
+
+func drainVolumeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "drain <volume-id> ",
+		Short: "migrate all block replicas off the volume, so that the store can be decommissioned",
+		Run: func(cmd *cobra.Command, args []string) {
+			id := mustGetVolumeID(cmd, args)
+			status, err := client.DrainVolume(context.Background(), &ctrlpb.DrainVolumeRequest{VolumeId: id})
+			if err != nil {
+				cmdFailedf(cmd, "drain volume failed: %s", err)
+			}
+			printVolumeDrainStatus(cmd, status)
+			for drainWatch && status.State != ctrlpb.VolumeDrainStatus_DRAINED {
+				time.Sleep(drainWatchInterval)
+				status, err = client.GetVolumeDrainStatus(context.Background(),
+					&ctrlpb.GetVolumeDrainStatusRequest{VolumeId: id})
+				if err != nil {
+					cmdFailedf(cmd, "get drain status failed: %s", err)
+				}
+				printVolumeDrainStatus(cmd, status)
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&drainWatch, "watch", false, "print the progress until the volume is drained")
+	return cmd
+}
+
+func drainStatusCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "drain-status <volume-id> ",
+		Short: "get the progress of draining volume",
+		Run: func(cmd *cobra.Command, args []string) {
+			id := mustGetVolumeID(cmd, args)
+			status, err := client.GetVolumeDrainStatus(context.Background(),
+				&ctrlpb.GetVolumeDrainStatusRequest{VolumeId: id})
+			if err != nil {
+				cmdFailedf(cmd, "get drain status failed: %s", err)
+			}
+			printVolumeDrainStatus(cmd, status)
+		},
+	}
+	return cmd
+}
+
+func mustGetVolumeID(cmd *cobra.Command, args []string) uint64 {
+	if len(args) == 0 {
+		cmdFailedWithHelpNotice(cmd, "volume id can't be empty\n")
+	}
+	id, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		cmdFailedf(cmd, "invalid volume id: %s", args[0])
+	}
+	return id
+}
+
+func printVolumeDrainStatus(cmd *cobra.Command, status *ctrlpb.VolumeDrainStatus) {
+	if IsFormatJSON(cmd) {
+		data, _ := json.Marshal(status)
+		color.Green(string(data))
+		return
+	}
+	progress := "100%"
+	if status.TotalBlocks > status.RemainingBlocks {
+		progress = fmt.Sprintf("%d%%", (status.TotalBlocks-status.RemainingBlocks)*100/status.TotalBlocks)
+	} else if status.RemainingBlocks != 0 {
+		progress = "0%"
+	}
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"Volume", "State", "Progress", "Remaining_Blocks", "Total_Blocks",
+		"Migrated_Bytes", "Started_At", "Finished_At"})
+	t.AppendRow(table.Row{
+		status.VolumeId,
+		status.State,
+		progress,
+		status.RemainingBlocks,
+		status.TotalBlocks,
+		status.MigratedBytes,
+		status.StartTime,
+		status.FinishTime,
+	})
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 1, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 2, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 3, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 4, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 5, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 6, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 7, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 8, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+	})
+	t.SetOutputMirror(os.Stdout)
+	t.Render()
+}
@@ -49,6 +49,15 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&globalFlags.Format, "format", "table",
 		"the output format of vsctl, json or table")
 
+	rootCmd.PersistentFlags().StringVar(&globalFlags.Token, "token", os.Getenv("VANUS_TOKEN"),
+		"the API token or JWT to access gateway, default is the environment variable VANUS_TOKEN")
+	rootCmd.PersistentFlags().StringVar(&globalFlags.TLSCAFile, "tls-ca-file", "",
+		"the CA file to verify the certificate of gateway, TLS is enabled if it or tls-cert-file is set")
+	rootCmd.PersistentFlags().StringVar(&globalFlags.TLSCertFile, "tls-cert-file", "",
+		"the client certificate file for mutual TLS")
+	rootCmd.PersistentFlags().StringVar(&globalFlags.TLSKeyFile, "tls-key-file", "",
+		"the client key file for mutual TLS")
+
 	if os.Getenv("VANUS_GATEWAY") != "" {
 		globalFlags.Endpoint = os.Getenv("VANUS_GATEWAY")
 	}